@@ -35,6 +35,8 @@ type Options struct {
 	Upgrade         bool
 	Force           bool
 	Zero            bool
+
+	EncryptedPartitions []string
 }
 
 // Install installs Talos.
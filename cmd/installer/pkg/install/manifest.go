@@ -76,6 +76,10 @@ type Target struct {
 	// Skipped partitions should exist on the disk by the time manifest execution starts.
 	Skip bool
 
+	// Encrypted partitions are only wiped by the installer, the encrypted volume
+	// is created when the partition is mounted for the first time.
+	Encrypted bool
+
 	// set during execution
 	PartitionName string
 	Contents      *bytes.Buffer
@@ -113,6 +117,7 @@ type FileSystemType = string
 const (
 	FilesystemTypeNone FileSystemType = "none"
 	FilesystemTypeXFS  FileSystemType = "xfs"
+	FilesystemTypeExt4 FileSystemType = "ext4"
 	FilesystemTypeVFAT FileSystemType = "vfat"
 )
 
@@ -125,6 +130,8 @@ const (
 	BootSize     = 300 * MiB
 	MetaSize     = 1 * MiB
 	StateSize    = 100 * MiB
+
+	WipeHeaderSize = 1 * MiB
 )
 
 // NewManifest initializes and returns a Manifest.
@@ -275,6 +282,25 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 		stateTarget.Size = 0 // expand previous partition to cover whatever space is available
 	}
 
+	for _, label := range opts.EncryptedPartitions {
+		for _, target := range []*Target{stateTarget, ephemeralTarget} {
+			if target.Label != label {
+				continue
+			}
+
+			target.Encrypted = true
+
+			// contents of the encrypted partition can't be read by the installer,
+			// so the partition is kept as is unless it's forcefully recreated
+			target.PreserveContents = false
+			target.ExtraPreserveSources = nil
+
+			if !opts.Force {
+				target.Skip = true
+			}
+		}
+	}
+
 	for _, target := range []*Target{efiTarget, biosTarget, bootTarget, metaTarget, stateTarget, ephemeralTarget} {
 		if target == nil {
 			continue
@@ -480,7 +506,7 @@ func (m *Manifest) executeOnDevice(device Device, targets []*Target) (err error)
 		err = retry.Constant(time.Minute, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
 			e := target.Format()
 			if e != nil {
-				if strings.Contains(e.Error(), "No such file or directory") {
+				if strings.Contains(e.Error(), "No such file or directory") || errors.Is(e, os.ErrNotExist) {
 					// workaround problem with partition device not being visible immediately after partitioning
 					return retry.ExpectedError(e)
 				}
@@ -598,8 +624,16 @@ func (m *Manifest) restoreContents(targets []*Target) error {
 func (m *Manifest) SystemMountpoints() (*mount.Points, error) {
 	mountpoints := mount.NewMountPoints()
 
-	for dev := range m.Targets {
-		mp, err := mount.SystemMountPointsForDevice(dev)
+	for dev, targets := range m.Targets {
+		var skip []string
+
+		for _, target := range targets {
+			if target.Encrypted {
+				skip = append(skip, target.Label)
+			}
+		}
+
+		mp, err := mount.SystemMountPointsForDevice(dev, skip...)
 		if err != nil {
 			return nil, err
 		}
@@ -694,6 +728,12 @@ func (t *Target) Format() error {
 		return nil
 	}
 
+	if t.Encrypted {
+		log.Printf("wiping encrypted partition %q with label %q\n", t.PartitionName, t.Label)
+
+		return t.wipeHeader()
+	}
+
 	if t.FileSystemType == FilesystemTypeNone {
 		return nil
 	}
@@ -707,11 +747,29 @@ func (t *Target) Format() error {
 		return makefs.VFAT(t.PartitionName, opts...)
 	case FilesystemTypeXFS:
 		return makefs.XFS(t.PartitionName, opts...)
+	case FilesystemTypeExt4:
+		return makefs.Ext4(t.PartitionName, opts...)
 	default:
 		return fmt.Errorf("unsupported filesystem type: %q", t.FileSystemType)
 	}
 }
 
+// wipeHeader zeroes the beginning of the partition to remove any filesystem or encryption signatures.
+func (t *Target) wipeHeader() error {
+	f, err := os.OpenFile(t.PartitionName, os.O_WRONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	if _, err = f.Write(make([]byte, WipeHeaderSize)); err != nil {
+		return fmt.Errorf("error wiping partition %q: %w", t.PartitionName, err)
+	}
+
+	return f.Close()
+}
+
 // Save copies the assets to the bootloader partition.
 func (t *Target) Save() (err error) {
 	for _, asset := range t.Assets {
@@ -0,0 +1,157 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/provision"
+	"github.com/talos-systems/talos/pkg/provision/providers"
+)
+
+var addNodeCmdFlags struct {
+	nodeType string
+	count    int
+}
+
+// addCmd represents the cluster add command.
+var addCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add resources to a local docker-based or VM-based kubernetes cluster",
+	Long:  ``,
+}
+
+// addNodeCmd represents the cluster add node command.
+var addNodeCmd = &cobra.Command{
+	Use:   "node [<name>...]",
+	Short: "Add nodes to a local docker-based or VM-based kubernetes cluster",
+	Long: `Adds nodes with the specified names to the cluster created with 'talosctl cluster create'.
+
+If no names are given, --count nodes are added with the names following the cluster naming scheme.
+Nodes get IPs from the cluster network and the machine configuration of the existing node of the same type.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cli.WithContext(context.Background(), func(ctx context.Context) error {
+			return addNodes(ctx, args)
+		})
+	},
+}
+
+func addNodes(ctx context.Context, names []string) error {
+	nodeType, err := parseNodeType(addNodeCmdFlags.nodeType)
+	if err != nil {
+		return err
+	}
+
+	nanoCPUs, err := parseCPUShare()
+	if err != nil {
+		return fmt.Errorf("error parsing --cpus: %s", err)
+	}
+
+	memory := int64(clusterMemory) * 1024 * 1024
+
+	disks, err := getDisks()
+	if err != nil {
+		return err
+	}
+
+	provisioner, err := providers.Factory(ctx, provisionerName)
+	if err != nil {
+		return err
+	}
+
+	defer provisioner.Close() //nolint: errcheck
+
+	cluster, err := provisioner.Reflect(ctx, clusterName, stateDir)
+	if err != nil {
+		return err
+	}
+
+	if len(names) == 0 {
+		names = nextNodeNames(cluster.Info(), nodeType, addNodeCmdFlags.count)
+	}
+
+	nodeReqs := make(provision.NodeRequests, len(names))
+
+	for i, name := range names {
+		nodeReqs[i] = provision.NodeRequest{
+			Name:     name,
+			Type:     nodeType,
+			Memory:   memory,
+			NanoCPUs: nanoCPUs,
+			Disks:    disks,
+		}
+	}
+
+	cluster, err = provisioner.AddNodes(ctx, cluster, nodeReqs)
+	if err != nil {
+		return err
+	}
+
+	return showCluster(cluster)
+}
+
+func parseNodeType(s string) (machine.Type, error) {
+	if s == "worker" {
+		return machine.TypeJoin, nil
+	}
+
+	t, err := machine.ParseType(s)
+	if err != nil {
+		return t, err
+	}
+
+	if t != machine.TypeControlPlane && t != machine.TypeJoin {
+		return t, fmt.Errorf("only %q and %q nodes can be added to the cluster", machine.TypeControlPlane, machine.TypeJoin)
+	}
+
+	return t, nil
+}
+
+// nextNodeNames picks the names for the new nodes following the naming of 'talosctl cluster create'.
+func nextNodeNames(info provision.ClusterInfo, nodeType machine.Type, count int) []string {
+	kind := "worker"
+	if nodeType != machine.TypeJoin {
+		kind = "master"
+	}
+
+	existing := map[string]struct{}{}
+
+	for _, node := range append(append([]provision.NodeInfo(nil), info.Nodes...), info.ExtraNodes...) {
+		// docker reports container names with the leading slash
+		existing[strings.TrimPrefix(node.Name, "/")] = struct{}{}
+	}
+
+	names := make([]string, 0, count)
+
+	for i := 1; len(names) < count; i++ {
+		name := fmt.Sprintf("%s-%s-%d", info.ClusterName, kind, i)
+
+		if _, ok := existing[name]; ok {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func init() {
+	addNodeCmd.Flags().StringVar(&addNodeCmdFlags.nodeType, "type", "worker", "type of the nodes to add: controlplane or worker")
+	addNodeCmd.Flags().IntVar(&addNodeCmdFlags.count, "count", 1, "the number of nodes to add if no names are given")
+	addNodeCmd.Flags().StringVar(&clusterCpus, "cpus", "2.0", "the share of CPUs as fraction (each container/VM)")
+	addNodeCmd.Flags().IntVar(&clusterMemory, "memory", 2048, "the limit on memory usage in MB (each container/VM)")
+	addNodeCmd.Flags().IntVar(&clusterDiskSize, "disk", 6*1024, "default limit on disk size in MB (each VM)")
+	addNodeCmd.Flags().StringSliceVar(&clusterDisks, "user-disk", []string{}, "list of disks to create for each VM in format: <mount_point1>:<size1>:<mount_point2>:<size2>")
+
+	addCmd.AddCommand(addNodeCmd)
+	Cmd.AddCommand(addCmd)
+}
@@ -24,6 +24,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/mgmt/helpers"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/pkg/kubeconfig"
 	"github.com/talos-systems/talos/pkg/cli"
 	"github.com/talos-systems/talos/pkg/cluster/check"
@@ -80,6 +81,9 @@ var (
 	crashdumpOnFailure      bool
 	skipKubeconfig          bool
 	skipInjectingConfig     bool
+	configPatch             []string
+	configPatchControlPlane []string
+	configPatchWorker       []string
 )
 
 // createCmd represents the cluster up command.
@@ -283,11 +287,27 @@ func create(ctx context.Context) (err error) {
 		)
 	}
 
-	configBundle, err := bundle.NewConfigBundle(configBundleOpts...)
+	patchOptions, err := helpers.PatchOptions(configPatch, configPatchControlPlane, configPatchWorker)
 	if err != nil {
 		return err
 	}
 
+	configBundle, err := bundle.NewConfigBundle(append(configBundleOpts, patchOptions...)...)
+	if err != nil {
+		return err
+	}
+
+	if len(patchOptions) > 0 {
+		mode := runtime.ModeMetal
+		if provisionerName == "docker" {
+			mode = runtime.ModeContainer
+		}
+
+		if err = helpers.ValidateConfigs(configBundle, mode); err != nil {
+			return err
+		}
+	}
+
 	if skipInjectingConfig {
 		types := []machine.Type{machine.TypeControlPlane, machine.TypeJoin}
 
@@ -599,5 +619,8 @@ func init() {
 	createCmd.Flags().BoolVar(&crashdumpOnFailure, "crashdump", false, "print debug crashdump to stderr when cluster startup fails")
 	createCmd.Flags().BoolVar(&skipKubeconfig, "skip-kubeconfig", false, "skip merging kubeconfig from the created cluster")
 	createCmd.Flags().BoolVar(&skipInjectingConfig, "skip-injecting-config", false, "skip injecting config from embedded metadata server, write config files to current directory")
+	createCmd.Flags().StringArrayVar(&configPatch, "config-patch", nil, "patch generated machineconfigs (applied to all node types), use @file to read a patch from file")
+	createCmd.Flags().StringArrayVar(&configPatchControlPlane, "config-patch-control-plane", nil, "patch generated machineconfigs (applied to 'init' and 'controlplane' types)")
+	createCmd.Flags().StringArrayVar(&configPatchWorker, "config-patch-worker", nil, "patch generated machineconfigs (applied to 'join' type)")
 	Cmd.AddCommand(createCmd)
 }
@@ -0,0 +1,190 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/provision"
+	"github.com/talos-systems/talos/pkg/provision/access"
+	"github.com/talos-systems/talos/pkg/provision/providers"
+)
+
+var removeNodeCmdFlags struct {
+	skipEtcdLeave bool
+}
+
+// removeCmd represents the cluster remove command.
+var removeCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Remove resources from a local docker-based or VM-based kubernetes cluster",
+	Long:  ``,
+}
+
+// removeNodeCmd represents the cluster remove node command.
+var removeNodeCmd = &cobra.Command{
+	Use:   "node <name>...",
+	Short: "Remove nodes from a local docker-based or VM-based kubernetes cluster",
+	Long: `Removes nodes with the specified names from the cluster created with 'talosctl cluster create'.
+
+Control plane nodes leave the etcd cluster before being removed.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cli.WithContext(context.Background(), func(ctx context.Context) error {
+			return removeNodes(ctx, args)
+		})
+	},
+}
+
+func removeNodes(ctx context.Context, names []string) error {
+	provisioner, err := providers.Factory(ctx, provisionerName)
+	if err != nil {
+		return err
+	}
+
+	defer provisioner.Close() //nolint: errcheck
+
+	cluster, err := provisioner.Reflect(ctx, clusterName, stateDir)
+	if err != nil {
+		return err
+	}
+
+	// validate the request before any of the nodes leaves etcd cluster
+	controlPlaneNodes, err := controlPlaneNodesToRemove(cluster.Info(), names)
+	if err != nil {
+		return err
+	}
+
+	if len(controlPlaneNodes) > 0 && !removeNodeCmdFlags.skipEtcdLeave {
+		if err = leaveEtcd(ctx, cluster, controlPlaneNodes); err != nil {
+			return err
+		}
+	}
+
+	cluster, err = provisioner.RemoveNodes(ctx, cluster, names)
+	if err != nil {
+		return err
+	}
+
+	return showCluster(cluster)
+}
+
+// controlPlaneNodesToRemove checks that the nodes exist and that the cluster keeps at least one control plane node,
+// and returns the control plane nodes being removed.
+func controlPlaneNodesToRemove(info provision.ClusterInfo, names []string) ([]provision.NodeInfo, error) {
+	remove := map[string]bool{}
+
+	for _, name := range names {
+		remove[name] = false
+	}
+
+	var (
+		controlPlaneNodes                      []provision.NodeInfo
+		remainingNodes, remainingControlPlanes int
+	)
+
+	count := func(nodes []provision.NodeInfo, extra bool) {
+		for _, node := range nodes {
+			name := strings.TrimPrefix(node.Name, "/")
+			controlPlane := node.Type == machine.TypeInit || node.Type == machine.TypeControlPlane
+
+			if _, ok := remove[name]; ok {
+				remove[name] = true
+
+				if controlPlane {
+					controlPlaneNodes = append(controlPlaneNodes, node)
+				}
+
+				continue
+			}
+
+			if !extra {
+				remainingNodes++
+			}
+
+			if controlPlane {
+				remainingControlPlanes++
+			}
+		}
+	}
+
+	count(info.Nodes, false)
+	count(info.ExtraNodes, true)
+
+	for _, name := range names {
+		if !remove[name] {
+			return nil, fmt.Errorf("node %q not found in the cluster %q", name, info.ClusterName)
+		}
+	}
+
+	if remainingNodes == 0 {
+		return nil, fmt.Errorf("can't remove all the nodes of the cluster, use talosctl cluster destroy")
+	}
+
+	if len(controlPlaneNodes) > 0 && remainingControlPlanes == 0 {
+		return nil, fmt.Errorf("can't remove all the control plane nodes of the cluster")
+	}
+
+	return controlPlaneNodes, nil
+}
+
+// leaveEtcd removes control plane nodes from the etcd cluster.
+func leaveEtcd(ctx context.Context, cluster provision.Cluster, nodes []provision.NodeInfo) error {
+	cfg, err := clientconfig.Open(talosconfig)
+	if err != nil {
+		return fmt.Errorf("error opening talosconfig: %w", err)
+	}
+
+	if _, ok := cfg.Contexts[clusterName]; ok {
+		cfg.Context = clusterName
+	}
+
+	clusterAccess := access.NewAdapter(cluster, provision.WithTalosConfig(cfg))
+	defer clusterAccess.Close() //nolint: errcheck
+
+	c, err := clusterAccess.Client()
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		nodeCtx := client.WithNodes(ctx, node.PrivateIP.String())
+
+		fmt.Fprintf(os.Stdout, "removing %s from etcd cluster\n", strings.TrimPrefix(node.Name, "/"))
+
+		if _, err = c.MachineClient.EtcdForfeitLeadership(nodeCtx, &machineapi.EtcdForfeitLeadershipRequest{}); err != nil {
+			return fmt.Errorf("error forfeiting etcd leadership on %s: %w", node.PrivateIP, err)
+		}
+
+		if _, err = c.MachineClient.EtcdLeaveCluster(nodeCtx, &machineapi.EtcdLeaveClusterRequest{}); err != nil {
+			return fmt.Errorf("error leaving etcd cluster on %s: %w", node.PrivateIP, err)
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	defaultTalosConfig, err := clientconfig.GetDefaultPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to find default Talos config path: %s", err)
+	}
+
+	removeNodeCmd.Flags().StringVar(&talosconfig, "talosconfig", defaultTalosConfig, "The path to the Talos configuration file")
+	removeNodeCmd.Flags().BoolVar(&removeNodeCmdFlags.skipEtcdLeave, "skip-etcd-leave", false, "skip removing control plane nodes from etcd cluster (e.g. if the node is not healthy)")
+
+	removeCmd.AddCommand(removeNodeCmd)
+	Cmd.AddCommand(removeCmd)
+}
@@ -0,0 +1,250 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/cluster"
+	"github.com/talos-systems/talos/pkg/cluster/check"
+	"github.com/talos-systems/talos/pkg/cluster/rollout"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/provision"
+	"github.com/talos-systems/talos/pkg/provision/access"
+	"github.com/talos-systems/talos/pkg/provision/providers"
+)
+
+var upgradeCmdFlags struct {
+	image           string
+	preserve        bool
+	workerBatchSize int
+	nodeTimeout     time.Duration
+	rolloutState    string
+	forceEndpoint   string
+	nodes           upgradeNodes
+}
+
+// upgradeCmd represents the cluster upgrade command.
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Upgrade Talos on all the cluster nodes one node at a time",
+	Long: `Performs the rolling upgrade of Talos on the cluster nodes.
+
+Control plane nodes are upgraded one at a time, each node is upgraded only if etcd has quorum.
+Worker nodes are upgraded next in the batches of --worker-batch-size nodes.
+Each node should reboot and the cluster should pass the health checks before the next node is upgraded.
+
+Nodes are taken from --init-node, --control-plane-nodes and --worker-nodes flags, if none are set,
+the nodes of the local cluster created with 'talosctl cluster create' are upgraded.
+
+Rollout progress is kept in the local state file: rerunning the command with the same image continues
+the rollout skipping the already upgraded nodes. The rollout can be paused with 'talosctl cluster upgrade pause'
+(the rollout stops before upgrading the next node) and continued with 'talosctl cluster upgrade resume'.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cli.WithContext(context.Background(), func(ctx context.Context) error {
+			return runRollout(ctx, rolloutStore(), upgradeCmdFlags.image, upgradeCmdFlags.preserve)
+		})
+	},
+}
+
+// upgradePauseCmd represents the cluster upgrade pause command.
+var upgradePauseCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "Pause the rolling upgrade of the cluster",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := rollout.Pause(rolloutStore()); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(os.Stderr, "rollout is paused, it stops before upgrading the next node")
+
+		return nil
+	},
+}
+
+// upgradeResumeCmd represents the cluster upgrade resume command.
+var upgradeResumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume the paused rolling upgrade of the cluster",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cli.WithContext(context.Background(), func(ctx context.Context) error {
+			store := rolloutStore()
+
+			state, err := rollout.Resume(store)
+			if err != nil {
+				return err
+			}
+
+			return runRollout(ctx, store, state.Image, state.Preserve)
+		})
+	},
+}
+
+type upgradeNodes struct {
+	initNode          string
+	controlPlaneNodes []string
+	workerNodes       []string
+}
+
+func (nodes *upgradeNodes) empty() bool {
+	return nodes.initNode == "" && len(nodes.controlPlaneNodes) == 0 && len(nodes.workerNodes) == 0
+}
+
+func (nodes *upgradeNodes) Nodes() []string {
+	return append(nodes.NodesByType(machine.TypeInit), append(nodes.NodesByType(machine.TypeControlPlane), nodes.NodesByType(machine.TypeJoin)...)...)
+}
+
+func (nodes *upgradeNodes) NodesByType(t machine.Type) []string {
+	switch t {
+	case machine.TypeInit:
+		if nodes.initNode == "" {
+			return nil
+		}
+
+		return []string{nodes.initNode}
+	case machine.TypeControlPlane:
+		return append([]string(nil), nodes.controlPlaneNodes...)
+	case machine.TypeJoin:
+		return append([]string(nil), nodes.workerNodes...)
+	case machine.TypeUnknown:
+		return nil
+	default:
+		panic("unsupported machine type")
+	}
+}
+
+func rolloutStore() rollout.Store {
+	path := upgradeCmdFlags.rolloutState
+	if path == "" {
+		path = filepath.Join(stateDir, clusterName, "rollout.yaml")
+	}
+
+	return &rollout.FileStore{Path: path}
+}
+
+func runRollout(ctx context.Context, store rollout.Store, image string, preserve bool) error {
+	cfg, err := clientconfig.Open(talosconfig)
+	if err != nil {
+		return fmt.Errorf("error opening talosconfig: %w", err)
+	}
+
+	var clusterAccess interface {
+		check.ClusterInfo
+		Close() error
+	}
+
+	if upgradeCmdFlags.nodes.empty() {
+		if clusterAccess, err = localClusterAccess(ctx, cfg); err != nil {
+			return err
+		}
+	} else {
+		var c *client.Client
+
+		c, err = client.New(ctx, client.WithConfig(cfg))
+		if err != nil {
+			return err
+		}
+
+		//nolint: errcheck
+		defer c.Close()
+
+		clientProvider := &cluster.ConfigClientProvider{
+			DefaultClient: c,
+		}
+
+		clusterAccess = &struct {
+			*cluster.ConfigClientProvider
+			cluster.K8sProvider
+			cluster.Info
+		}{
+			ConfigClientProvider: clientProvider,
+			K8sProvider: &cluster.KubernetesClient{
+				ClientProvider: clientProvider,
+				ForceEndpoint:  upgradeCmdFlags.forceEndpoint,
+			},
+			Info: &upgradeCmdFlags.nodes,
+		}
+	}
+
+	//nolint: errcheck
+	defer clusterAccess.Close()
+
+	options := rollout.DefaultOptions()
+	options.Image = image
+	options.Preserve = preserve
+	options.WorkerBatchSize = upgradeCmdFlags.workerBatchSize
+	options.NodeTimeout = upgradeCmdFlags.nodeTimeout
+	options.Checks = append(check.DefaultClusterChecks(), check.ExtraClusterChecks()...)
+	options.Log = func(format string, args ...interface{}) {
+		fmt.Fprintf(os.Stderr, format+"\n", args...)
+	}
+
+	err = rollout.Run(ctx, clusterAccess, store, options)
+	if errors.Is(err, rollout.ErrPaused) {
+		fmt.Fprintln(os.Stderr, "rollout is paused, run 'talosctl cluster upgrade resume' to continue")
+
+		return nil
+	}
+
+	return err
+}
+
+func localClusterAccess(ctx context.Context, cfg *clientconfig.Config) (*access.Adapter, error) {
+	provisioner, err := providers.Factory(ctx, provisionerName)
+	if err != nil {
+		return nil, err
+	}
+
+	defer provisioner.Close() //nolint: errcheck
+
+	localCluster, err := provisioner.Reflect(ctx, clusterName, stateDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := cfg.Contexts[clusterName]; ok {
+		cfg.Context = clusterName
+	}
+
+	return access.NewAdapter(localCluster, provision.WithTalosConfig(cfg)), nil
+}
+
+func init() {
+	defaultTalosConfig, err := clientconfig.GetDefaultPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to find default Talos config path: %s", err)
+	}
+
+	upgradeCmd.PersistentFlags().StringVar(&talosconfig, "talosconfig", defaultTalosConfig, "The path to the Talos configuration file")
+	upgradeCmd.PersistentFlags().StringVar(&upgradeCmdFlags.rolloutState, "rollout-state", "", "path to the rollout state file (defaults to rollout.yaml in the cluster state directory)")
+	upgradeCmd.PersistentFlags().IntVar(&upgradeCmdFlags.workerBatchSize, "worker-batch-size", 1, "the number of worker nodes upgraded at the same time")
+	upgradeCmd.PersistentFlags().DurationVar(&upgradeCmdFlags.nodeTimeout, "node-timeout", 20*time.Minute, "timeout for each node (batch) to be upgraded and to pass the health checks")
+	upgradeCmd.PersistentFlags().StringVar(&upgradeCmdFlags.nodes.initNode, "init-node", "", "specify IPs of init node")
+	upgradeCmd.PersistentFlags().StringSliceVar(&upgradeCmdFlags.nodes.controlPlaneNodes, "control-plane-nodes", nil, "specify IPs of control plane nodes")
+	upgradeCmd.PersistentFlags().StringSliceVar(&upgradeCmdFlags.nodes.workerNodes, "worker-nodes", nil, "specify IPs of worker nodes")
+	upgradeCmd.PersistentFlags().StringVar(&upgradeCmdFlags.forceEndpoint, "k8s-endpoint", "", "use endpoint instead of kubeconfig default")
+
+	upgradeCmd.Flags().StringVarP(&upgradeCmdFlags.image, "image", "i", "", "the container image to use for performing the install")
+	upgradeCmd.Flags().BoolVarP(&upgradeCmdFlags.preserve, "preserve", "p", false, "preserve data")
+	cli.Should(upgradeCmd.MarkFlagRequired("image"))
+
+	upgradeCmd.AddCommand(upgradePauseCmd, upgradeResumeCmd)
+	Cmd.AddCommand(upgradeCmd)
+}
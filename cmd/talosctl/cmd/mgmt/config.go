@@ -17,6 +17,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/mgmt/helpers"
+	machinedruntime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/pkg/images"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/bundle"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
@@ -35,6 +36,10 @@ var (
 	outputDir         string
 	registryMirrors   []string
 	persistConfig     bool
+
+	configPatch             []string
+	configPatchControlPlane []string
+	configPatchWorker       []string
 )
 
 // genConfigCmd represents the gen config command.
@@ -92,27 +97,40 @@ func genV1Alpha1Config(args []string) error {
 		genOptions = append(genOptions, generate.WithRegistryMirror(components[0], components[1]))
 	}
 
+	patchOptions, err := helpers.PatchOptions(configPatch, configPatchControlPlane, configPatchWorker)
+	if err != nil {
+		return err
+	}
+
 	configBundle, err := bundle.NewConfigBundle(
-		bundle.WithInputOptions(
-			&bundle.InputOptions{
-				ClusterName: args[0],
-				Endpoint:    args[1],
-				KubeVersion: kubernetesVersion,
-				GenOptions: append(genOptions,
-					generate.WithInstallDisk(installDisk),
-					generate.WithInstallImage(installImage),
-					generate.WithAdditionalSubjectAltNames(additionalSANs),
-					generate.WithDNSDomain(dnsDomain),
-					generate.WithPersist(persistConfig),
-					generate.WithArchitecture(architecture),
-				),
-			},
-		),
+		append([]bundle.Option{
+			bundle.WithInputOptions(
+				&bundle.InputOptions{
+					ClusterName: args[0],
+					Endpoint:    args[1],
+					KubeVersion: kubernetesVersion,
+					GenOptions: append(genOptions,
+						generate.WithInstallDisk(installDisk),
+						generate.WithInstallImage(installImage),
+						generate.WithAdditionalSubjectAltNames(additionalSANs),
+						generate.WithDNSDomain(dnsDomain),
+						generate.WithPersist(persistConfig),
+						generate.WithArchitecture(architecture),
+					),
+				},
+			),
+		}, patchOptions...)...,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to generate config bundle: %w", err)
 	}
 
+	if len(patchOptions) > 0 {
+		if err = helpers.ValidateConfigs(configBundle, machinedruntime.ModeMetal); err != nil {
+			return err
+		}
+	}
+
 	if err = configBundle.Write(outputDir, machine.TypeInit, machine.TypeControlPlane, machine.TypeJoin); err != nil {
 		return err
 	}
@@ -148,4 +166,7 @@ func init() {
 	genConfigCmd.Flags().StringVarP(&outputDir, "output-dir", "o", "", "destination to output generated files")
 	genConfigCmd.Flags().StringSliceVar(&registryMirrors, "registry-mirror", []string{}, "list of registry mirrors to use in format: <registry host>=<mirror URL>")
 	genConfigCmd.Flags().BoolVarP(&persistConfig, "persist", "p", true, "the desired persist value for configs")
+	genConfigCmd.Flags().StringArrayVar(&configPatch, "config-patch", nil, "patch generated machineconfigs (applied to all node types), use @file to read a patch from file")
+	genConfigCmd.Flags().StringArrayVar(&configPatchControlPlane, "config-patch-control-plane", nil, "patch generated machineconfigs (applied to 'init' and 'controlplane' types)")
+	genConfigCmd.Flags().StringArrayVar(&configPatchWorker, "config-patch-worker", nil, "patch generated machineconfigs (applied to 'join' type)")
 }
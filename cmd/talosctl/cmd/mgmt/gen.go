@@ -18,6 +18,7 @@ import (
 	"github.com/talos-systems/crypto/x509"
 
 	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/role"
 )
 
 var (
@@ -30,6 +31,7 @@ var (
 	name         string
 	organization string
 	rsa          bool
+	csrRole      string
 )
 
 // genCmd represents the gen command.
@@ -127,6 +129,14 @@ var csrCmd = &cobra.Command{
 		opts = append(opts, x509.IPAddresses(ips))
 		opts = append(opts, x509.NotAfter(time.Now().Add(time.Duration(crtHours)*time.Hour)))
 
+		if csrRole != "" {
+			if roles, _ := role.Parse([]string{csrRole}); len(roles) == 0 {
+				return fmt.Errorf("unknown role %q", csrRole)
+			}
+
+			opts = append(opts, x509.Organization(csrRole))
+		}
+
 		csr, err := x509.NewCertificateSigningRequest(keyEC, opts...)
 		if err != nil {
 			return fmt.Errorf("error generating CSR: %s", err)
@@ -265,6 +275,7 @@ func init() {
 	cli.Should(cobra.MarkFlagRequired(csrCmd.Flags(), "key"))
 	csrCmd.Flags().StringVar(&ip, "ip", "", "generate the certificate for this IP address")
 	cli.Should(cobra.MarkFlagRequired(csrCmd.Flags(), "ip"))
+	csrCmd.Flags().StringVar(&csrRole, "role", "", "the role of the client certificate (e.g. os:admin, os:operator, os:reader)")
 
 	genCmd.AddCommand(caCmd, keypairCmd, keyCmd, csrCmd, crtCmd)
 	addCommand(genCmd)
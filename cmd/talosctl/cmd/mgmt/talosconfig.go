@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mgmt
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+var genTalosconfigCmdFlags struct {
+	from      string
+	role      string
+	endpoints []string
+	output    string
+}
+
+// genTalosconfigCmd represents the gen talosconfig command.
+var genTalosconfigCmd = &cobra.Command{
+	Use:   "talosconfig",
+	Short: "Generates a talosconfig with the client certificate limited to the specified role",
+	Long: `The client certificate is signed by the Talos CA taken from the machine configuration
+of the control plane node (init.yaml or controlplane.yaml). Available roles are ` +
+		fmt.Sprintf("%q, %q and %q.", role.Admin, role.Operator, role.Reader),
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		r := role.Role(genTalosconfigCmdFlags.role)
+
+		roles, unknown := role.Parse([]string{string(r)})
+		if len(unknown) > 0 || len(roles) == 0 {
+			return fmt.Errorf("unknown role %q", r)
+		}
+
+		cfg, err := configloader.NewFromFile(genTalosconfigCmdFlags.from)
+		if err != nil {
+			return err
+		}
+
+		ca := cfg.Machine().Security().CA()
+		if ca == nil || len(ca.Key) == 0 {
+			return fmt.Errorf("machine configuration %q doesn't contain the Talos CA key", genTalosconfigCmdFlags.from)
+		}
+
+		client, err := generate.NewClientCertificateAndKey(ca.Crt, ca.Key, "127.0.0.1", r)
+		if err != nil {
+			return fmt.Errorf("error generating client certificate: %w", err)
+		}
+
+		contextName := cfg.Cluster().Name()
+
+		talosconfig := &clientconfig.Config{
+			Context: contextName,
+			Contexts: map[string]*clientconfig.Context{
+				contextName: {
+					Endpoints: genTalosconfigCmdFlags.endpoints,
+					CA:        base64.StdEncoding.EncodeToString(ca.Crt),
+					Crt:       base64.StdEncoding.EncodeToString(client.Crt),
+					Key:       base64.StdEncoding.EncodeToString(client.Key),
+				},
+			},
+		}
+
+		data, err := talosconfig.Bytes()
+		if err != nil {
+			return fmt.Errorf("failed to marshal config: %w", err)
+		}
+
+		if err = ioutil.WriteFile(genTalosconfigCmdFlags.output, data, 0o600); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		fmt.Printf("created %s with role %s\n", genTalosconfigCmdFlags.output, r)
+
+		return nil
+	},
+}
+
+func init() {
+	genTalosconfigCmd.Flags().StringVar(&genTalosconfigCmdFlags.from, "from", "", "path to the control plane machine configuration")
+	cli.Should(cobra.MarkFlagRequired(genTalosconfigCmd.Flags(), "from"))
+	genTalosconfigCmd.Flags().StringVar(&genTalosconfigCmdFlags.role, "role", string(role.Reader), "the role of the client")
+	genTalosconfigCmd.Flags().StringSliceVarP(&genTalosconfigCmdFlags.endpoints, "endpoints", "e", []string{"127.0.0.1"}, "the endpoints of the generated context")
+	genTalosconfigCmd.Flags().StringVarP(&genTalosconfigCmdFlags.output, "output", "o", "talosconfig", "the path of the generated talosconfig")
+
+	genCmd.AddCommand(genTalosconfigCmd)
+}
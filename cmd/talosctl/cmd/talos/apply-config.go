@@ -6,16 +6,16 @@ package talos
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"io/ioutil"
 
 	"github.com/spf13/cobra"
-	"github.com/talos-systems/crypto/x509"
 
 	"github.com/talos-systems/talos/internal/pkg/tui/installer"
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
 )
 
 var applyConfigCmdFlags struct {
@@ -24,6 +24,7 @@ var applyConfigCmdFlags struct {
 	insecure         bool
 	interactive      bool
 	noReboot         bool
+	configPatch      []string
 }
 
 // applyConfigCmd represents the applyConfiguration command.
@@ -47,51 +48,19 @@ var applyConfigCmd = &cobra.Command{
 			if len(cfgBytes) < 1 {
 				return fmt.Errorf("no configuration data read")
 			}
+
+			if len(applyConfigCmdFlags.configPatch) > 0 {
+				if cfgBytes, e = patchConfig(cfgBytes, applyConfigCmdFlags.configPatch); e != nil {
+					return e
+				}
+			}
 		} else if !applyConfigCmdFlags.interactive {
 			return fmt.Errorf("no filename supplied for configuration")
 		}
 
 		withClient := func(f func(context.Context, *client.Client) error) error {
 			if applyConfigCmdFlags.insecure {
-				ctx := context.Background()
-
-				if len(Nodes) != 1 {
-					return fmt.Errorf("insecure mode requires one and only one node, got %d", len(Nodes))
-				}
-
-				c, err := client.New(ctx, client.WithTLSConfig(&tls.Config{
-					InsecureSkipVerify: true,
-				}), client.WithEndpoints(Nodes...))
-				if err != nil {
-					return err
-				}
-
-				//nolint: errcheck
-				defer c.Close()
-
-				tlsConfig := &tls.Config{
-					InsecureSkipVerify: true,
-				}
-
-				if len(applyConfigCmdFlags.certFingerprints) > 0 {
-					fingerprints := make([]x509.Fingerprint, len(applyConfigCmdFlags.certFingerprints))
-
-					for i, stringFingerprint := range applyConfigCmdFlags.certFingerprints {
-						fingerprints[i], err = x509.ParseFingerprint(stringFingerprint)
-						if err != nil {
-							return fmt.Errorf("error parsing certificate fingerprint %q: %v", stringFingerprint, err)
-						}
-					}
-
-					tlsConfig.VerifyConnection = x509.MatchSPKIFingerprints(fingerprints...)
-				}
-
-				c, err = client.New(ctx, client.WithTLSConfig(tlsConfig), client.WithEndpoints(Nodes...))
-				if err != nil {
-					return err
-				}
-
-				return f(ctx, c)
+				return WithClientMaintenance(applyConfigCmdFlags.certFingerprints, f)
 			}
 
 			return WithClient(f)
@@ -123,24 +92,66 @@ var applyConfigCmd = &cobra.Command{
 				})
 			}
 
-			if _, err := c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
-				Data:     cfgBytes,
-				NoReboot: applyConfigCmdFlags.noReboot,
-			}); err != nil {
-				return fmt.Errorf("error applying new configuration: %s", err)
-			}
-
-			return nil
+			return applyMachineConfig(ctx, c, cfgBytes, applyConfigCmdFlags.noReboot)
 		})
 	},
 }
 
+// applyMachineConfig applies the configuration to the node and prints the changes applied without a reboot.
+func applyMachineConfig(ctx context.Context, c *client.Client, data []byte, noReboot bool) error {
+	resp, err := c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
+		Data:     data,
+		NoReboot: noReboot,
+	})
+	if err != nil {
+		return fmt.Errorf("error applying new configuration: %w", err)
+	}
+
+	for _, msg := range resp.GetMessages() {
+		prefix := ""
+
+		if msg.Metadata != nil {
+			prefix = msg.Metadata.Hostname + ": "
+		}
+
+		for _, change := range msg.GetAppliedChanges() {
+			fmt.Printf("%sapplied %s\n", prefix, change)
+		}
+
+		for _, change := range msg.GetPendingChanges() {
+			fmt.Printf("%spending reboot %s\n", prefix, change)
+		}
+	}
+
+	return nil
+}
+
+// patchConfig applies the patches to the configuration, patched configuration is validated by the node.
+func patchConfig(cfgBytes []byte, patch []string) ([]byte, error) {
+	patches, err := configpatcher.LoadPatches(patch)
+	if err != nil {
+		return nil, fmt.Errorf("error loading --config-patch: %w", err)
+	}
+
+	cfg, err := configloader.NewFromBytes(cfgBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg, err = configpatcher.ApplyConfig(cfg, patches); err != nil {
+		return nil, err
+	}
+
+	return cfg.Bytes()
+}
+
 func init() {
 	applyConfigCmd.Flags().StringVarP(&applyConfigCmdFlags.filename, "file", "f", "", "the filename of the updated configuration")
 	applyConfigCmd.Flags().BoolVarP(&applyConfigCmdFlags.insecure, "insecure", "i", false, "apply the config using the insecure (encrypted with no auth) maintenance service")
 	applyConfigCmd.Flags().StringSliceVar(&applyConfigCmdFlags.certFingerprints, "cert-fingerprint", nil, "list of server certificate fingeprints to accept (defaults to no check)")
 	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.interactive, "interactive", false, "apply the config using text based interactive mode")
-	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.noReboot, "no-reboot", false, "apply the config only after the reboot")
+	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.noReboot, "no-reboot", false, "apply the config without a reboot, changes which can't be applied live are applied on the next reboot")
+	applyConfigCmd.Flags().StringArrayVar(&applyConfigCmdFlags.configPatch, "config-patch", nil, "patch the configuration before applying it, use @file to read a patch from file")
 
 	addCommand(applyConfigCmd)
 }
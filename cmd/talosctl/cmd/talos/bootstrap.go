@@ -7,21 +7,52 @@ package talos
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
+var bootstrapCmdFlags struct {
+	recoverFrom          string
+	recoverSkipHashCheck bool
+}
+
 // bootstrapCmd represents the bootstrap command.
 var bootstrapCmd = &cobra.Command{
 	Use:   "bootstrap",
 	Short: "Bootstrap the cluster",
-	Long:  ``,
-	Args:  cobra.NoArgs,
+	Long: `Bootstrap etcd and Kubernetes on the node.
+
+If --recover-from is given, etcd snapshot created with 'talosctl etcd snapshot' is uploaded
+to the node first, and etcd is bootstrapped with the data from the snapshot.`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
-			if err := c.Bootstrap(ctx); err != nil {
+			if err := helpers.FailIfMultiNodes(ctx, "bootstrap"); err != nil {
+				return err
+			}
+
+			if bootstrapCmdFlags.recoverFrom != "" {
+				snapshot, err := os.Open(bootstrapCmdFlags.recoverFrom)
+				if err != nil {
+					return fmt.Errorf("error opening snapshot: %w", err)
+				}
+
+				defer snapshot.Close() //nolint: errcheck
+
+				if _, err = c.EtcdRecover(ctx, snapshot); err != nil {
+					return fmt.Errorf("error uploading snapshot: %w", err)
+				}
+			}
+
+			if err := c.Bootstrap(ctx, &machineapi.BootstrapRequest{
+				RecoverEtcd:          bootstrapCmdFlags.recoverFrom != "",
+				RecoverSkipHashCheck: bootstrapCmdFlags.recoverSkipHashCheck,
+			}); err != nil {
 				return fmt.Errorf("error executing bootstrap: %s", err)
 			}
 
@@ -31,5 +62,7 @@ var bootstrapCmd = &cobra.Command{
 }
 
 func init() {
+	bootstrapCmd.Flags().StringVar(&bootstrapCmdFlags.recoverFrom, "recover-from", "", "recover etcd cluster from the snapshot")
+	bootstrapCmd.Flags().BoolVar(&bootstrapCmdFlags.recoverSkipHashCheck, "recover-skip-hash-check", false, "skip integrity check when recovering etcd (use when recovering from data directory copy)")
 	addCommand(bootstrapCmd)
 }
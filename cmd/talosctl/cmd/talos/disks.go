@@ -0,0 +1,223 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/api/storage"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+var disksCmdFlags struct {
+	insecure   bool
+	partitions bool
+	volumes    bool
+}
+
+// disksCmd represents the disks command.
+var disksCmd = &cobra.Command{
+	Use:   "disks",
+	Short: "Get the list of disks from /sys/block on the machine",
+	Long: `Lists the disks of the node with the properties which can be used in the install disk selector
+(size, model, serial and bus), the health of the disk and optionally the partitions with the filesystems.
+
+With --volumes, lists the md RAID arrays and the LVM logical volumes of the node instead.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.ListDisks(ctx, grpc.Peer(&remotePeer))
+			if status.Code(err) == codes.Unimplemented {
+				// node doesn't support ListDisks yet
+				resp, err = legacyDisks(ctx, c, &remotePeer)
+			}
+
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting disks: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			return disksRender(&remotePeer, resp)
+		}
+
+		if disksCmdFlags.insecure {
+			return WithClientMaintenance(nil, f)
+		}
+
+		return WithClient(f)
+	},
+}
+
+// legacyDisks converts the response of the Disks RPC of the older nodes.
+func legacyDisks(ctx context.Context, c *client.Client, remotePeer *peer.Peer) (*storage.ListDisksResponse, error) {
+	resp, err := c.Disks(ctx, grpc.Peer(remotePeer))
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.ListDisksResponse{
+		Messages: []*storage.Disks{
+			{
+				Metadata: resp.Metadata,
+				Disks:    resp.Disks,
+			},
+		},
+	}, nil
+}
+
+func disksRender(remotePeer *peer.Peer, resp *storage.ListDisksResponse) error {
+	if disksCmdFlags.volumes {
+		return volumesRender(remotePeer, resp)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	if disksCmdFlags.partitions {
+		fmt.Fprintln(w, "NODE\tDEV\tMODEL\tSERIAL\tBUS\tTYPE\tSIZE\tHEALTH\tPARTITION\tLABEL\tFILESYSTEM\tMOUNTED ON")
+	} else {
+		fmt.Fprintln(w, "NODE\tDEV\tMODEL\tSERIAL\tBUS\tTYPE\tSIZE\tHEALTH\tPARTITION TABLE")
+	}
+
+	defaultNode := client.AddrFromPeer(remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, disk := range msg.Disks {
+			diskType := "SSD"
+			if disk.Rotational {
+				diskType = "HDD"
+			}
+
+			health := "-"
+
+			if disk.Smart != nil {
+				health = "FAILING"
+
+				if disk.Smart.Passed {
+					health = "OK"
+				}
+			}
+
+			fields := []interface{}{
+				node,
+				disk.DeviceName,
+				orDash(disk.Model),
+				orDash(disk.Serial),
+				orDash(disk.Bus),
+				diskType,
+				humanize.Bytes(disk.Size),
+				health,
+			}
+
+			if !disksCmdFlags.partitions {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", append(fields, orDash(disk.PartitionTable))...)
+
+				continue
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				append(fields, "-", orDash(disk.FilesystemLabel), orDash(disk.Filesystem), orDash(disk.MountPoint))...)
+
+			for _, part := range disk.Partitions {
+				fmt.Fprintf(w, "%s\t%s\t\t\t\t\t%s\t\t%d\t%s\t%s\t%s\n",
+					node,
+					part.DeviceName,
+					humanize.Bytes(part.Size),
+					part.Number,
+					orDash(part.Label),
+					orDash(part.Filesystem),
+					orDash(part.MountPoint),
+				)
+			}
+		}
+	}
+
+	return w.Flush()
+}
+
+func volumesRender(remotePeer *peer.Peer, resp *storage.ListDisksResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NODE\tNAME\tTYPE\tLEVEL\tDEV\tSIZE\tSTATE\tSYNC\tMEMBERS\tMOUNTED ON")
+
+	defaultNode := client.AddrFromPeer(remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, volume := range msg.Volumes {
+			state := volume.State
+			if volume.Degraded {
+				state += " (degraded)"
+			}
+
+			members := make([]string, len(volume.Members))
+
+			for i, member := range volume.Members {
+				members[i] = member.DeviceName
+
+				if member.State != "" {
+					members[i] += "[" + member.State + "]"
+				}
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				node,
+				volume.Name,
+				volume.Type,
+				orDash(volume.Level),
+				volume.DeviceName,
+				humanize.Bytes(volume.Size),
+				orDash(state),
+				orDash(volume.SyncAction),
+				orDash(strings.Join(members, ",")),
+				orDash(volume.MountPoint),
+			)
+		}
+	}
+
+	return w.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
+
+func init() {
+	disksCmd.Flags().BoolVarP(&disksCmdFlags.insecure, "insecure", "i", false, "get disks using the insecure (encrypted with no auth) maintenance service")
+	disksCmd.Flags().BoolVarP(&disksCmdFlags.partitions, "partitions", "p", false, "list the partitions and the filesystems of the disks")
+	disksCmd.Flags().BoolVar(&disksCmdFlags.volumes, "volumes", false, "list the md RAID arrays and the LVM logical volumes")
+	addCommand(disksCmd)
+}
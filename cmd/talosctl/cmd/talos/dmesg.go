@@ -14,11 +14,21 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
 var dmesgTail bool
 
+var dmesgCmdFlags struct {
+	since        string
+	until        string
+	match        string
+	facilities   []string
+	priority     string
+	previousBoot bool
+}
+
 // dmesgCmd represents the dmesg command.
 var dmesgCmd = &cobra.Command{
 	Use:   "dmesg",
@@ -27,7 +37,26 @@ var dmesgCmd = &cobra.Command{
 	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
-			stream, err := c.Dmesg(ctx, follow, dmesgTail)
+			since, err := parseLogTime(dmesgCmdFlags.since)
+			if err != nil {
+				return fmt.Errorf("error parsing --since: %w", err)
+			}
+
+			until, err := parseLogTime(dmesgCmdFlags.until)
+			if err != nil {
+				return fmt.Errorf("error parsing --until: %w", err)
+			}
+
+			stream, err := c.MachineClient.Dmesg(ctx, &machine.DmesgRequest{
+				Follow:       follow,
+				Tail:         dmesgTail,
+				Since:        since,
+				Until:        until,
+				Match:        dmesgCmdFlags.match,
+				Facilities:   dmesgCmdFlags.facilities,
+				Priority:     dmesgCmdFlags.priority,
+				PreviousBoot: dmesgCmdFlags.previousBoot,
+			})
 			if err != nil {
 				return fmt.Errorf("error getting dmesg: %w", err)
 			}
@@ -67,4 +96,10 @@ func init() {
 	addCommand(dmesgCmd)
 	dmesgCmd.Flags().BoolVarP(&follow, "follow", "f", false, "specify if the kernel log should be streamed")
 	dmesgCmd.Flags().BoolVarP(&dmesgTail, "tail", "", false, "specify if only new messages should be sent (makes sense only when combined with --follow)")
+	dmesgCmd.Flags().StringVar(&dmesgCmdFlags.since, "since", "", "show messages logged after the duration (e.g. 10m) or the RFC3339 timestamp")
+	dmesgCmd.Flags().StringVar(&dmesgCmdFlags.until, "until", "", "show messages logged before the duration (e.g. 5m) or the RFC3339 timestamp")
+	dmesgCmd.Flags().StringVar(&dmesgCmdFlags.match, "match", "", "show only the messages matching the regular expression")
+	dmesgCmd.Flags().StringSliceVar(&dmesgCmdFlags.facilities, "facility", nil, "show only the messages with the facilities (e.g. kern,daemon)")
+	dmesgCmd.Flags().StringVar(&dmesgCmdFlags.priority, "priority", "", "show only the messages with the priority or higher (e.g. warning)")
+	dmesgCmd.Flags().BoolVar(&dmesgCmdFlags.previousBoot, "previous-boot", false, "show the kernel log of the previous boot saved by pstore (e.g. after the kernel crash)")
 }
@@ -0,0 +1,244 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+)
+
+var editCmdFlags struct {
+	noReboot bool
+}
+
+// editCmd represents the edit command.
+var editCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Edit a resource from the default editor.",
+	Long:  ``,
+}
+
+// editMachineConfigCmd represents the edit machineconfig command.
+var editMachineConfigCmd = &cobra.Command{
+	Use:     "machineconfig",
+	Aliases: []string{"machineconfigs", "mc"},
+	Short:   "Edit the machine configuration of the node with the default editor.",
+	Long: `Opens the current machine configuration of the node in the editor.
+
+The editor is taken from TALOS_EDITOR or EDITOR environment variables, 'vi' is used by default.
+Edited configuration is validated and applied to the node (the same way as 'talosctl apply-config' does).
+If the edited configuration is not valid, the editor is opened again with the error on top of the configuration.
+If the nodes are set, the configuration of each node is edited one by one.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			for _, node := range Nodes {
+				nodeCtx := client.WithNodes(ctx, node)
+
+				if err := editMachineConfig(nodeCtx, c, node); err != nil {
+					return fmt.Errorf("%s: %w", node, err)
+				}
+			}
+
+			return nil
+		})
+	},
+}
+
+// editHeaderPrefix marks the lines added by talosctl on top of the edited configuration.
+const editHeaderPrefix = "# talosctl: "
+
+func editMachineConfig(ctx context.Context, c *client.Client, node string) error {
+	current, mode, err := getMachineConfig(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	header := []string{
+		fmt.Sprintf("editing the machine configuration of the node %s,", node),
+		"the configuration is applied when the editor is closed, empty file cancels the edit.",
+	}
+
+	var lastErr error
+
+	for {
+		contents := editHeader(header, current)
+
+		edited, err := runEditor(contents)
+		if err != nil {
+			return err
+		}
+
+		edited = stripEditHeader(edited)
+
+		if len(bytes.TrimSpace(edited)) == 0 {
+			fmt.Fprintf(os.Stderr, "%s: edit cancelled\n", node)
+
+			return nil
+		}
+
+		if bytes.Equal(edited, current) {
+			if lastErr != nil {
+				// invalid configuration saved without changes
+				return lastErr
+			}
+
+			fmt.Fprintf(os.Stderr, "%s: edit cancelled, no changes made\n", node)
+
+			return nil
+		}
+
+		if _, err = validateMachineConfig(edited, mode); err != nil {
+			lastErr = err
+			current = edited
+			header = append([]string{"the configuration is not valid:"}, strings.Split(err.Error(), "\n")...)
+
+			continue
+		}
+
+		return applyMachineConfig(ctx, c, edited, editCmdFlags.noReboot)
+	}
+}
+
+// getMachineConfig returns the current machine configuration of the node and the runtime mode of the node.
+func getMachineConfig(ctx context.Context, c *client.Client) ([]byte, string, error) {
+	resp, err := c.GetConfiguration(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting machine configuration: %w", err)
+	}
+
+	if len(resp.GetMessages()) != 1 {
+		return nil, "", fmt.Errorf("expected one response, got %d", len(resp.GetMessages()))
+	}
+
+	return resp.GetMessages()[0].GetData(), resp.GetMessages()[0].GetMode(), nil
+}
+
+// validateMachineConfig loads the configuration and validates it for the runtime mode of the node.
+//
+// Checks of the node devices (e.g. the install disk) are skipped, as they are done by the node itself.
+func validateMachineConfig(data []byte, mode string) (config.Provider, error) {
+	cfg, err := configloader.NewFromBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("error loading machine configuration: %w", err)
+	}
+
+	if mode == "" {
+		// node didn't report the mode, configuration is validated by the node
+		return cfg, nil
+	}
+
+	runtimeMode, err := runtime.ParseMode(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cfg.Validate(runtimeMode); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func editHeader(header []string, data []byte) []byte {
+	var buf bytes.Buffer
+
+	for _, line := range header {
+		buf.WriteString(editHeaderPrefix + line + "\n")
+	}
+
+	buf.Write(data)
+
+	return buf.Bytes()
+}
+
+func stripEditHeader(data []byte) []byte {
+	var buf bytes.Buffer
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+
+	header := true
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if header && strings.HasPrefix(line, editHeaderPrefix) {
+			continue
+		}
+
+		header = false
+
+		buf.WriteString(line + "\n")
+	}
+
+	return buf.Bytes()
+}
+
+// runEditor opens the contents in the editor and returns the edited contents.
+func runEditor(contents []byte) ([]byte, error) {
+	editor := os.Getenv("TALOS_EDITOR")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+
+	if editor == "" {
+		editor = "vi"
+	}
+
+	f, err := ioutil.TempFile("", "talos-machineconfig-*.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.Remove(f.Name()) //nolint: errcheck
+
+	if _, err = f.Write(contents); err != nil {
+		f.Close() //nolint: errcheck
+
+		return nil, err
+	}
+
+	if err = f.Close(); err != nil {
+		return nil, err
+	}
+
+	args := strings.Fields(editor)
+	if len(args) == 0 {
+		return nil, errors.New("editor is not set")
+	}
+
+	cmd := exec.Command(args[0], append(args[1:], f.Name())...) //nolint: gosec
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err = cmd.Run(); err != nil {
+		return nil, fmt.Errorf("error running editor %q: %w", editor, err)
+	}
+
+	return ioutil.ReadFile(f.Name())
+}
+
+func init() {
+	editMachineConfigCmd.Flags().BoolVar(&editCmdFlags.noReboot, "no-reboot", false, "apply the config without a reboot, changes which can't be applied live are applied on the next reboot")
+
+	editCmd.AddCommand(editMachineConfigCmd)
+	addCommand(editCmd)
+}
@@ -0,0 +1,123 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"go.etcd.io/etcd/clientv3/snapshot"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// etcdCmd represents the etcd command.
+var etcdCmd = &cobra.Command{
+	Use:   "etcd",
+	Short: "Manage etcd",
+	Long:  ``,
+}
+
+// etcdSnapshotCmd represents the etcd snapshot command.
+var etcdSnapshotCmd = &cobra.Command{
+	Use:   "snapshot <path>",
+	Short: "Stream snapshot of the etcd node to the path.",
+	Long: `Takes a consistent snapshot of the etcd member running on the node and
+saves it to the local <path>.
+
+Snapshot can be used to recover the cluster with 'talosctl bootstrap --recover-from'.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "etcd snapshot"); err != nil {
+				return err
+			}
+
+			dbPath := filepath.Clean(args[0])
+			partPath := dbPath + ".part"
+
+			defer os.RemoveAll(partPath) //nolint: errcheck
+
+			dest, err := os.OpenFile(partPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+			if err != nil {
+				return fmt.Errorf("error creating temporary file: %w", err)
+			}
+
+			defer dest.Close() //nolint: errcheck
+
+			r, errCh, err := c.EtcdSnapshot(ctx, &machineapi.EtcdSnapshotRequest{})
+			if err != nil {
+				return fmt.Errorf("error reading file: %w", err)
+			}
+
+			defer r.Close() //nolint: errcheck
+
+			var wg sync.WaitGroup
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for err := range errCh {
+					fmt.Fprintln(os.Stderr, err.Error())
+				}
+			}()
+
+			defer wg.Wait()
+
+			size, err := io.Copy(dest, r)
+			if err != nil {
+				return fmt.Errorf("error reading: %w", err)
+			}
+
+			if err = dest.Sync(); err != nil {
+				return fmt.Errorf("failed to fsync: %w", err)
+			}
+
+			// this check is from https://github.com/etcd-io/etcd/blob/v3.4.14/clientv3/snapshot/v3_snapshot.go#L117
+			if (size % 512) != sha256.Size {
+				return fmt.Errorf("sha256 checksum not found (size %d)", size)
+			}
+
+			if err = dest.Close(); err != nil {
+				return fmt.Errorf("failed to close: %w", err)
+			}
+
+			if err = os.Rename(partPath, dbPath); err != nil {
+				return fmt.Errorf("error renaming to final location: %w", err)
+			}
+
+			fmt.Printf("etcd snapshot saved to %q (%d bytes)\n", dbPath, size)
+
+			manager := snapshot.NewV3(zap.NewNop())
+
+			status, err := manager.Status(dbPath)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("snapshot info: hash %08x, revision %d, total keys %d, total size %d\n",
+				status.Hash, status.Revision, status.TotalKey, status.TotalSize)
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	addCommand(etcdCmd)
+
+	etcdCmd.AddCommand(etcdSnapshotCmd)
+}
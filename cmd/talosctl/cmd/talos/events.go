@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -21,6 +22,7 @@ var eventsCmdFlags struct {
 	tailEvents   int32
 	tailDuration time.Duration
 	tailID       string
+	bootID       string
 }
 
 // eventsCmd represents the events command.
@@ -31,7 +33,7 @@ var eventsCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-			fmt.Fprintln(w, "NODE\tID\tEVENT\tSOURCE\tMESSAGE")
+			fmt.Fprintln(w, "NODE\tBOOT\tID\tEVENT\tSOURCE\tMESSAGE")
 
 			opts := []client.EventsOptionFunc{}
 
@@ -47,6 +49,10 @@ var eventsCmd = &cobra.Command{
 				opts = append(opts, client.WithTailID(eventsCmdFlags.tailID))
 			}
 
+			if eventsCmdFlags.bootID != "" {
+				opts = append(opts, client.WithBootID(eventsCmdFlags.bootID))
+			}
+
 			return c.EventsWatch(ctx, func(ch <-chan client.Event) {
 				for {
 					var (
@@ -63,7 +69,7 @@ var eventsCmd = &cobra.Command{
 						return
 					}
 
-					format := "%s\t%s\t%s\t%s\t%s\n"
+					format := "%s\t%s\t%s\t%s\t%s\t%s\n"
 
 					var args []interface{}
 
@@ -81,12 +87,33 @@ var eventsCmd = &cobra.Command{
 						args = []interface{}{msg.GetTask(), msg.GetAction().String()}
 					case *machine.ServiceStateEvent:
 						args = []interface{}{msg.GetService(), fmt.Sprintf("%s: %s", msg.GetAction(), msg.GetMessage())}
+					case *machine.ImageVerificationEvent:
+						args = []interface{}{msg.GetImage()}
+						if msg.GetVerified() {
+							args = append(args, "verified: "+msg.GetDigest())
+						} else {
+							args = append(args, "error: "+msg.GetError())
+						}
+					case *machine.RollbackEvent:
+						args = []interface{}{"rollback", msg.GetReason()}
+					case *machine.KernelCrashEvent:
+						args = []interface{}{"kernel", fmt.Sprintf("crashed on the previous boot: %s", msg.GetReason())}
+					case *machine.VolumeStateEvent:
+						args = []interface{}{msg.GetName(), msg.GetState()}
+						if len(msg.GetFailedMembers()) > 0 {
+							args[1] = fmt.Sprintf("%s: failed %s", msg.GetState(), strings.Join(msg.GetFailedMembers(), ", "))
+						}
 					default:
 						// We haven't implemented the handling of this event yet.
 						continue
 					}
 
-					args = append([]interface{}{event.Node, event.ID, event.TypeURL}, args...)
+					bootID := event.BootID
+					if len(bootID) > 8 {
+						bootID = bootID[:8]
+					}
+
+					args = append([]interface{}{event.Node, bootID, event.ID, event.TypeURL}, args...)
 					fmt.Fprintf(w, format, args...)
 
 					// nolint: errcheck
@@ -102,4 +129,5 @@ func init() {
 	eventsCmd.Flags().Int32Var(&eventsCmdFlags.tailEvents, "tail", 0, "show specified number of past events (use -1 to show full history, default is to show no history)")
 	eventsCmd.Flags().DurationVar(&eventsCmdFlags.tailDuration, "duration", 0, "show events for the past duration interval (one second resolution, default is to show no history)")
 	eventsCmd.Flags().StringVar(&eventsCmdFlags.tailID, "since", "", "show events after the specified event ID (default is to show no history)")
+	eventsCmd.Flags().StringVar(&eventsCmdFlags.bootID, "boot-id", "", "show only events of the boot with the specified ID (or ID prefix), use with --tail to show events of the previous boots")
 }
@@ -11,8 +11,11 @@ import (
 	"io"
 	"os"
 	"sync"
+	"time"
 
 	criconstants "github.com/containerd/cri/pkg/constants"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -29,6 +32,12 @@ var (
 	tailLines int32
 )
 
+var logsCmdFlags struct {
+	since string
+	until string
+	match string
+}
+
 // logsCmd represents the logs command.
 var logsCmd = &cobra.Command{
 	Use:   "logs <service name>",
@@ -49,7 +58,26 @@ var logsCmd = &cobra.Command{
 				driver = common.ContainerDriver_CRI
 			}
 
-			stream, err := c.Logs(ctx, namespace, driver, args[0], follow, tailLines)
+			since, err := parseLogTime(logsCmdFlags.since)
+			if err != nil {
+				return fmt.Errorf("error parsing --since: %w", err)
+			}
+
+			until, err := parseLogTime(logsCmdFlags.until)
+			if err != nil {
+				return fmt.Errorf("error parsing --until: %w", err)
+			}
+
+			stream, err := c.MachineClient.Logs(ctx, &machine.LogsRequest{
+				Namespace: namespace,
+				Driver:    driver,
+				Id:        args[0],
+				Follow:    follow,
+				TailLines: tailLines,
+				Since:     since,
+				Until:     until,
+				Match:     logsCmdFlags.match,
+			})
 			if err != nil {
 				return fmt.Errorf("error fetching logs: %s", err)
 			}
@@ -88,6 +116,26 @@ var logsCmd = &cobra.Command{
 	},
 }
 
+// parseLogTime parses the time bound flag: either the duration back from now (e.g. "10m"),
+// or the RFC3339 timestamp.
+func parseLogTime(value string) (*timestamp.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		d, durationErr := time.ParseDuration(value)
+		if durationErr != nil {
+			return nil, fmt.Errorf("%q is neither a duration nor an RFC3339 timestamp", value)
+		}
+
+		t = time.Now().Add(-d)
+	}
+
+	return ptypes.TimestampProto(t)
+}
+
 // lineSlicer splits random chunks of bytes coming from nodes into a stream
 // of lines aggregated per node.
 type lineSlicer struct {
@@ -188,5 +236,8 @@ func init() {
 	logsCmd.Flags().BoolVarP(&useCRI, "use-cri", "c", false, "use the CRI driver")
 	logsCmd.Flags().BoolVarP(&follow, "follow", "f", false, "specify if the logs should be streamed")
 	logsCmd.Flags().Int32VarP(&tailLines, "tail", "", -1, "lines of log file to display (default is to show from the beginning)")
+	logsCmd.Flags().StringVar(&logsCmdFlags.since, "since", "", "show logs written after the duration (e.g. 10m) or the RFC3339 timestamp")
+	logsCmd.Flags().StringVar(&logsCmdFlags.until, "until", "", "show logs written before the duration (e.g. 5m) or the RFC3339 timestamp")
+	logsCmd.Flags().StringVar(&logsCmdFlags.match, "match", "", "show only the lines matching the regular expression")
 	addCommand(logsCmd)
 }
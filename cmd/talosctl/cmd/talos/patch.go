@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
+)
+
+var patchCmdFlags struct {
+	patch    []string
+	noReboot bool
+}
+
+// patchCmd represents the patch command.
+var patchCmd = &cobra.Command{
+	Use:   "patch",
+	Short: "Update field(s) of a resource using a JSON patch or a strategic merge patch.",
+	Long:  ``,
+}
+
+// patchMachineConfigCmd represents the patch machineconfig command.
+var patchMachineConfigCmd = &cobra.Command{
+	Use:     "machineconfig",
+	Aliases: []string{"machineconfigs", "mc"},
+	Short:   "Patch the machine configuration of the node.",
+	Long: `Patches the current machine configuration of the node and applies the patched configuration.
+
+Patch is either RFC6902 JSON patch or strategic merge patch (partial machine configuration),
+patch starting with '@' is read from the file.
+Patched configuration is validated before it is applied to the node.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		patches, err := configpatcher.LoadPatches(patchCmdFlags.patch)
+		if err != nil {
+			return fmt.Errorf("error loading --patch: %w", err)
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			for _, node := range Nodes {
+				nodeCtx := client.WithNodes(ctx, node)
+
+				if err := patchMachineConfig(nodeCtx, c, patches); err != nil {
+					return fmt.Errorf("%s: %w", node, err)
+				}
+			}
+
+			return nil
+		})
+	},
+}
+
+func patchMachineConfig(ctx context.Context, c *client.Client, patches []configpatcher.Patch) error {
+	current, mode, err := getMachineConfig(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	patched, err := configpatcher.Apply(current, patches)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := validateMachineConfig(patched, mode)
+	if err != nil {
+		return fmt.Errorf("patched configuration is not valid: %w", err)
+	}
+
+	data, err := cfg.Bytes()
+	if err != nil {
+		return err
+	}
+
+	return applyMachineConfig(ctx, c, data, patchCmdFlags.noReboot)
+}
+
+func init() {
+	patchMachineConfigCmd.Flags().StringArrayVarP(&patchCmdFlags.patch, "patch", "p", nil, "the patch to apply, use @file to read a patch from file")
+	patchMachineConfigCmd.Flags().BoolVar(&patchCmdFlags.noReboot, "no-reboot", false, "apply the config without a reboot, changes which can't be applied live are applied on the next reboot")
+	cli.Should(patchMachineConfigCmd.MarkFlagRequired("patch"))
+
+	patchCmd.AddCommand(patchMachineConfigCmd)
+	addCommand(patchCmd)
+}
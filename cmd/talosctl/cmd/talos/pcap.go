@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/talos-systems/talos/internal/pkg/pcap"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+var pcapCmdFlags struct {
+	iface       string
+	promiscuous bool
+	snapLen     uint32
+	bpfRaw      string
+	output      string
+	duration    time.Duration
+}
+
+// pcapCmd represents the pcap command.
+var pcapCmd = &cobra.Command{
+	Use:     "pcap",
+	Aliases: []string{"tcpdump"},
+	Short:   "Capture the network packets from the node.",
+	Long: `Captures the packets on the network interface of the node and writes them in pcap format
+to the file or to stdout, so that the output can be analyzed with tcpdump or wireshark:
+
+    talosctl pcap --interface eth0 -o out.pcap
+    talosctl pcap --interface eth0 -o - | tcpdump -vvv -r -
+
+BPF filter should be passed in the compiled form produced by 'tcpdump -ddd':
+
+    talosctl pcap --interface eth0 --bpf-raw "$(tcpdump -ddd 'port 53' | tr '\n' ',')"
+
+Capture runs until the command is interrupted or the --duration elapses.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "pcap"); err != nil {
+				return err
+			}
+
+			filter, err := pcap.ParseRawFilter(pcapCmdFlags.bpfRaw)
+			if err != nil {
+				return fmt.Errorf("error parsing BPF filter: %w", err)
+			}
+
+			req := &machineapi.PacketCaptureRequest{
+				Interface:   pcapCmdFlags.iface,
+				Promiscuous: pcapCmdFlags.promiscuous,
+				SnapLen:     pcapCmdFlags.snapLen,
+			}
+
+			for _, ins := range filter {
+				req.BpfFilter = append(req.BpfFilter, &machineapi.BPFInstruction{
+					Op: uint32(ins.Op),
+					Jt: uint32(ins.Jt),
+					Jf: uint32(ins.Jf),
+					K:  ins.K,
+				})
+			}
+
+			if pcapCmdFlags.duration > 0 {
+				var cancel context.CancelFunc
+
+				ctx, cancel = context.WithTimeout(ctx, pcapCmdFlags.duration)
+				defer cancel()
+			}
+
+			var out io.Writer = os.Stdout
+
+			if pcapCmdFlags.output != "-" {
+				f, err := os.OpenFile(pcapCmdFlags.output, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+				if err != nil {
+					return fmt.Errorf("error creating output file: %w", err)
+				}
+
+				defer f.Close() //nolint: errcheck
+
+				out = f
+			}
+
+			r, errCh, err := c.PacketCapture(ctx, req)
+			if err != nil {
+				return fmt.Errorf("error capturing packets: %w", err)
+			}
+
+			defer r.Close() //nolint: errcheck
+
+			var wg sync.WaitGroup
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for err := range errCh {
+					fmt.Fprintln(os.Stderr, err.Error())
+				}
+			}()
+
+			defer wg.Wait()
+
+			_, err = io.Copy(out, r)
+
+			return err
+		})
+	},
+}
+
+func init() {
+	pcapCmd.Flags().StringVarP(&pcapCmdFlags.iface, "interface", "i", "eth0", "interface name to capture packets on")
+	pcapCmd.Flags().BoolVar(&pcapCmdFlags.promiscuous, "promiscuous", false, "put interface into promiscuous mode")
+	pcapCmd.Flags().Uint32Var(&pcapCmdFlags.snapLen, "snaplen", pcap.DefaultSnapLen, "maximum number of bytes captured for each packet")
+	pcapCmd.Flags().StringVar(&pcapCmdFlags.bpfRaw, "bpf-raw", "", "BPF filter in the compiled form produced by 'tcpdump -ddd'")
+	pcapCmd.Flags().StringVarP(&pcapCmdFlags.output, "output", "o", "-", "path to the output pcap file, '-' for stdout")
+	pcapCmd.Flags().DurationVar(&pcapCmdFlags.duration, "duration", 0, "duration of the capture, zero means unlimited")
+	addCommand(pcapCmd)
+}
@@ -6,9 +6,11 @@ package talos
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"github.com/talos-systems/crypto/x509"
 
 	"github.com/talos-systems/talos/pkg/cli"
 	"github.com/talos-systems/talos/pkg/machinery/client"
@@ -84,6 +86,46 @@ func WithClient(action func(context.Context, *client.Client) error) error {
 	})
 }
 
+// WithClientMaintenance wraps common code to initialize Talos client in maintenance (insecure) mode.
+//
+// If the certificate fingerprints are specified, the server certificate is verified against them.
+func WithClientMaintenance(certFingerprints []string, action func(context.Context, *client.Client) error) error {
+	return cli.WithContext(context.Background(), func(ctx context.Context) error {
+		if len(Nodes) != 1 {
+			return fmt.Errorf("insecure mode requires one and only one node, got %d", len(Nodes))
+		}
+
+		tlsConfig := &tls.Config{
+			InsecureSkipVerify: true,
+		}
+
+		if len(certFingerprints) > 0 {
+			fingerprints := make([]x509.Fingerprint, len(certFingerprints))
+
+			for i, stringFingerprint := range certFingerprints {
+				var err error
+
+				fingerprints[i], err = x509.ParseFingerprint(stringFingerprint)
+				if err != nil {
+					return fmt.Errorf("error parsing certificate fingerprint %q: %v", stringFingerprint, err)
+				}
+			}
+
+			tlsConfig.VerifyConnection = x509.MatchSPKIFingerprints(fingerprints...)
+		}
+
+		c, err := client.New(ctx, client.WithTLSConfig(tlsConfig), client.WithEndpoints(Nodes...))
+		if err != nil {
+			return err
+		}
+
+		//nolint: errcheck
+		defer c.Close()
+
+		return action(ctx, c)
+	})
+}
+
 // Commands is a list of commands published by the package.
 var Commands []*cobra.Command
 
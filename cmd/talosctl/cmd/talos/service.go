@@ -11,6 +11,7 @@ import (
 	"text/tabwriter"
 	"time"
 
+	humanize "github.com/dustin/go-humanize"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -131,6 +132,12 @@ func serviceInfo(ctx context.Context, c *client.Client, id string) error {
 			fmt.Fprintf(w, "LAST HEALTH MESSAGE\t%s\n", svc.Health.LastMessage)
 		}
 
+		if res := svc.Resources; res != nil {
+			fmt.Fprintf(w, "CGROUP\t%s\n", res.Cgroup)
+			fmt.Fprintf(w, "MEMORY\t%s\n", formatServiceMemory(res.MemoryUsage, res.MemoryLimit))
+			fmt.Fprintf(w, "CPU TIME\t%s\n", time.Duration(res.CpuUsage).Round(time.Millisecond))
+		}
+
 		label := "EVENTS"
 
 		for i := range svc.Events.Events {
@@ -150,6 +157,17 @@ func serviceInfo(ctx context.Context, c *client.Client, id string) error {
 	return w.Flush()
 }
 
+// cgroup v1 reports the maximum page-aligned value when the memory is not limited.
+const serviceMemoryUnlimited = 1 << 62
+
+func formatServiceMemory(usage, limit uint64) string {
+	if limit >= serviceMemoryUnlimited {
+		return fmt.Sprintf("%s (no limit)", humanize.Bytes(usage))
+	}
+
+	return fmt.Sprintf("%s / %s", humanize.Bytes(usage), humanize.Bytes(limit))
+}
+
 func serviceStart(ctx context.Context, c *client.Client, id string) error {
 	var remotePeer peer.Peer
 
@@ -79,11 +79,56 @@ var timeCmd = &cobra.Command{
 				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", node, msg.Server, localtime.String(), remotetime.String())
 			}
 
-			return w.Flush()
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			return renderTimeSources(resp, defaultNode)
 		})
 	},
 }
 
+func renderTimeSources(resp *timeapi.TimeResponse, defaultNode string) error {
+	hasSources := false
+
+	for _, msg := range resp.Messages {
+		hasSources = hasSources || len(msg.Sources) > 0
+	}
+
+	if !hasSources {
+		return nil
+	}
+
+	fmt.Println()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tSOURCE\tSTATE\tSTRATUM\tOFFSET\tROOT-DISTANCE\tREACH")
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, source := range msg.Sources {
+			offset, err := ptypes.Duration(source.Offset)
+			if err != nil {
+				return fmt.Errorf("error parsing offset: %w", err)
+			}
+
+			rootDistance, err := ptypes.Duration(source.RootDistance)
+			if err != nil {
+				return fmt.Errorf("error parsing root distance: %w", err)
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%08b\n", node, source.Server, source.State, source.Stratum, offset, rootDistance, source.Reach)
+		}
+	}
+
+	return w.Flush()
+}
+
 func init() {
 	timeCmd.Flags().String("check", "c", "checks server time against specified ntp server")
 	addCommand(timeCmd)
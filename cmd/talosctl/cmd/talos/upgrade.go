@@ -6,8 +6,13 @@ package talos
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -16,12 +21,17 @@ import (
 	"google.golang.org/grpc/peer"
 
 	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
 var (
-	upgradeImage string
-	preserve     bool
+	upgradeImage            string
+	upgradeFromFile         string
+	upgradeDigest           string
+	upgradeSignature        string
+	upgradeSignaturePayload string
+	preserve                bool
 )
 
 // upgradeCmd represents the processes command.
@@ -38,6 +48,10 @@ var upgradeCmd = &cobra.Command{
 func init() {
 	upgradeCmd.Flags().StringVarP(&upgradeImage, "image", "i", "", "the container image to use for performing the install")
 	upgradeCmd.Flags().BoolVarP(&preserve, "preserve", "p", false, "preserve data")
+	upgradeCmd.Flags().StringVar(&upgradeFromFile, "from-file", "", "upload the installer image archive (OCI or Docker image tarball) to the node instead of pulling the image, --image sets the image name")
+	upgradeCmd.Flags().StringVar(&upgradeDigest, "digest", "", "expected digest of the archive (sha256:<hex>), calculated from the file if not set")
+	upgradeCmd.Flags().StringVar(&upgradeSignature, "signature", "", "file with the base64-encoded signature of the image in the archive (cosign format), used to verify the image without the registry access")
+	upgradeCmd.Flags().StringVar(&upgradeSignaturePayload, "signature-payload", "", "file with the signed payload of the image signature, required with --signature")
 	addCommand(upgradeCmd)
 }
 
@@ -45,9 +59,19 @@ func upgrade() error {
 	return WithClient(func(ctx context.Context, c *client.Client) error {
 		var remotePeer peer.Peer
 
+		var (
+			resp *machine.UpgradeResponse
+			err  error
+		)
+
 		// TODO: See if we can validate version and prevent starting upgrades to
 		// an unknown version
-		resp, err := c.Upgrade(ctx, upgradeImage, preserve, grpc.Peer(&remotePeer))
+		if upgradeFromFile != "" {
+			resp, err = upgradeFromArchive(ctx, c, &remotePeer)
+		} else {
+			resp, err = c.Upgrade(ctx, upgradeImage, preserve, grpc.Peer(&remotePeer))
+		}
+
 		if err != nil {
 			if resp == nil {
 				return fmt.Errorf("error performing upgrade: %s", err)
@@ -74,3 +98,78 @@ func upgrade() error {
 		return w.Flush()
 	})
 }
+
+func upgradeFromArchive(ctx context.Context, c *client.Client, remotePeer *peer.Peer) (*machine.UpgradeResponse, error) {
+	if upgradeImage == "" {
+		return nil, fmt.Errorf("--image is required with --from-file")
+	}
+
+	digest := upgradeDigest
+
+	if digest == "" {
+		var err error
+
+		if digest, err = fileDigest(upgradeFromFile); err != nil {
+			return nil, err
+		}
+	}
+
+	signature, err := imageSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(upgradeFromFile)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint: errcheck
+	defer f.Close()
+
+	return c.UpgradeFromArchive(ctx, upgradeImage, digest, signature, f, preserve, grpc.Peer(remotePeer))
+}
+
+// imageSignature reads the detached image signature, nil is returned if the signature is not set.
+func imageSignature() (*machine.ImageSignature, error) {
+	if upgradeSignature == "" && upgradeSignaturePayload == "" {
+		return nil, nil
+	}
+
+	if upgradeSignature == "" || upgradeSignaturePayload == "" {
+		return nil, fmt.Errorf("--signature and --signature-payload should be set together")
+	}
+
+	signature, err := ioutil.ReadFile(upgradeSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := ioutil.ReadFile(upgradeSignaturePayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.ImageSignature{
+		Payload:   payload,
+		Signature: strings.TrimSpace(string(signature)),
+	}, nil
+}
+
+func fileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+
+	//nolint: errcheck
+	defer f.Close()
+
+	hash := sha256.New()
+
+	if _, err = io.Copy(hash, f); err != nil {
+		return "", fmt.Errorf("error reading %q: %w", path, err)
+	}
+
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil)), nil
+}
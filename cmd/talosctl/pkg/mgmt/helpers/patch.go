@@ -0,0 +1,62 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/bundle"
+)
+
+// PatchOptions converts the config patch flags into the config bundle options.
+func PatchOptions(patch, patchControlPlane, patchWorker []string) ([]bundle.Option, error) {
+	var opts []bundle.Option
+
+	for _, p := range []struct {
+		flag   string
+		specs  []string
+		option func([]configpatcher.Patch) bundle.Option
+	}{
+		{"--config-patch", patch, bundle.WithPatch},
+		{"--config-patch-control-plane", patchControlPlane, bundle.WithPatchControlPlane},
+		{"--config-patch-worker", patchWorker, bundle.WithPatchJoin},
+	} {
+		if len(p.specs) == 0 {
+			continue
+		}
+
+		patches, err := configpatcher.LoadPatches(p.specs)
+		if err != nil {
+			return nil, fmt.Errorf("error loading %s: %w", p.flag, err)
+		}
+
+		opts = append(opts, p.option(patches))
+	}
+
+	return opts, nil
+}
+
+// ValidateConfigs validates the machine configs of the bundle.
+//
+// Configs are validated without the checks of the local system, as the install disk
+// and other devices are looked up on the node, not on the host running talosctl.
+func ValidateConfigs(configBundle config.ProviderBundle, mode config.RuntimeMode) error {
+	for _, cfg := range []struct {
+		name     string
+		provider config.Provider
+	}{
+		{"init", configBundle.Init()},
+		{"controlplane", configBundle.ControlPlane()},
+		{"join", configBundle.Join()},
+	} {
+		if err := cfg.provider.Validate(mode); err != nil {
+			return fmt.Errorf("%s config is not valid: %w", cfg.name, err)
+		}
+	}
+
+	return nil
+}
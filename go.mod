@@ -45,10 +45,11 @@ require (
 	github.com/mattn/go-isatty v0.0.12
 	github.com/mdlayher/genetlink v1.0.0
 	github.com/mdlayher/netlink v1.1.1
-	github.com/opencontainers/image-spec v1.0.1 // indirect
+	github.com/opencontainers/image-spec v1.0.1
 	github.com/opencontainers/runc v1.0.0-rc92 // indirect
 	github.com/opencontainers/runtime-spec v1.0.3-0.20200728170252-4d89ac9fbff6
 	github.com/pin/tftp v2.1.0+incompatible
+	github.com/prometheus/client_golang v1.7.1
 	github.com/prometheus/procfs v0.2.0
 	github.com/rivo/tview v0.0.0-20201018122409-d551c850a743
 	github.com/rs/xid v1.2.1
@@ -71,7 +72,8 @@ require (
 	github.com/vmware-tanzu/sonobuoy v0.19.0
 	github.com/vmware/vmw-guestinfo v0.0.0-20200218095840-687661b8bd8e
 	go.etcd.io/etcd v0.5.0-alpha.5.0.20201125193152-8a03d2e9614b
-	golang.org/x/crypto v0.0.0-20201124201722-c8d3bf9c5392 // indirect
+	go.uber.org/zap v1.14.1
+	golang.org/x/crypto v0.0.0-20201124201722-c8d3bf9c5392
 	golang.org/x/net v0.0.0-20201110031124-69a78807bb2b
 	golang.org/x/sync v0.0.0-20201008141435-b3e1573b7520
 	golang.org/x/sys v0.0.0-20201112073958-5cba982894dd
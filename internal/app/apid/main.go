@@ -19,6 +19,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/apid/pkg/director"
 	"github.com/talos-systems/talos/internal/app/apid/pkg/provider"
 	"github.com/talos-systems/talos/pkg/grpc/factory"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/auth/authz"
 	"github.com/talos-systems/talos/pkg/grpc/proxy/backend"
 	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
@@ -70,10 +71,13 @@ func main() {
 		"/machine.MachineService/Copy",
 		"/machine.MachineService/DiskUsage",
 		"/machine.MachineService/Dmesg",
+		"/machine.MachineService/EtcdRecover",
+		"/machine.MachineService/EtcdSnapshot",
 		"/machine.MachineService/Events",
 		"/machine.MachineService/Kubeconfig",
 		"/machine.MachineService/List",
 		"/machine.MachineService/Logs",
+		"/machine.MachineService/PacketCapture",
 		"/machine.MachineService/Read",
 		"/os.OSService/Dmesg",
 		"/cluster.ClusterService/HealthCheck",
@@ -84,13 +88,30 @@ func main() {
 	// register future pattern: method should have suffix "Stream"
 	router.RegisterStreamedRegex("Stream$")
 
+	authorizer := &authz.Authorizer{
+		Rules:         rules,
+		FallbackRoles: adminRoles,
+		Logger:        log.Printf,
+	}
+
 	var errGroup errgroup.Group
 
 	errGroup.Go(func() error {
+		// clients authenticated with certificates issued before roles were introduced get full access
+		injector := &authz.Injector{
+			Mode:          authz.ReadFromCertificate,
+			FallbackRoles: adminRoles,
+			Logger:        log.Printf,
+		}
+
 		return factory.ListenAndServe(
 			router,
 			factory.Port(constants.ApidPort),
 			factory.WithDefaultLog(),
+			factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
+			factory.WithStreamInterceptor(injector.StreamInterceptor()),
+			factory.WithUnaryInterceptor(authorizer.UnaryInterceptor()),
+			factory.WithStreamInterceptor(authorizer.StreamInterceptor()),
 			factory.ServerOptions(
 				grpc.Creds(
 					credentials.NewTLS(serverTLSConfig),
@@ -106,11 +127,22 @@ func main() {
 	})
 
 	errGroup.Go(func() error {
+		// local socket is accessible only on the node itself, so roles in the metadata are trusted
+		injector := &authz.Injector{
+			Mode:          authz.ReadFromMetadata,
+			FallbackRoles: adminRoles,
+			Logger:        log.Printf,
+		}
+
 		return factory.ListenAndServe(
 			router,
 			factory.Network("unix"),
 			factory.SocketPath(constants.APISocketPath),
 			factory.WithDefaultLog(),
+			factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
+			factory.WithStreamInterceptor(injector.StreamInterceptor()),
+			factory.WithUnaryInterceptor(authorizer.UnaryInterceptor()),
+			factory.WithStreamInterceptor(authorizer.StreamInterceptor()),
 			factory.ServerOptions(
 				grpc.CustomCodec(proxy.Codec()),
 				grpc.UnknownServiceHandler(
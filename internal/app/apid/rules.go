@@ -0,0 +1,74 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import "github.com/talos-systems/talos/pkg/machinery/role"
+
+var (
+	readerRoles   = role.MakeSet(role.Admin, role.Operator, role.Reader)
+	operatorRoles = role.MakeSet(role.Admin, role.Operator)
+	adminRoles    = role.MakeSet(role.Admin)
+)
+
+// rules is the allowlist of roles per API method.
+//
+// Methods not listed here are available only to the admin role.
+// Reader role has access to the methods which don't change the state of the machine
+// and don't expose secrets (so that Read, Copy, Kubeconfig, etc. are excluded).
+var rules = map[string]role.Set{
+	"/cluster.ClusterService/HealthCheck": readerRoles,
+
+	"/machine.MachineService/ApplyConfiguration":    adminRoles,
+	"/machine.MachineService/Bootstrap":             adminRoles,
+	"/machine.MachineService/CPUInfo":               readerRoles,
+	"/machine.MachineService/Containers":            readerRoles,
+	"/machine.MachineService/Copy":                  adminRoles,
+	"/machine.MachineService/DiskStats":             readerRoles,
+	"/machine.MachineService/DiskUsage":             readerRoles,
+	"/machine.MachineService/Dmesg":                 readerRoles,
+	"/machine.MachineService/EtcdForfeitLeadership": operatorRoles,
+	"/machine.MachineService/EtcdLeaveCluster":      adminRoles,
+	"/machine.MachineService/EtcdMemberList":        readerRoles,
+	"/machine.MachineService/EtcdRecover":           adminRoles,
+	"/machine.MachineService/EtcdSnapshot":          adminRoles,
+	"/machine.MachineService/Events":                readerRoles,
+	"/machine.MachineService/GenerateConfiguration": adminRoles,
+	"/machine.MachineService/GetConfiguration":      adminRoles,
+	"/machine.MachineService/Hostname":              readerRoles,
+	"/machine.MachineService/Kubeconfig":            adminRoles,
+	"/machine.MachineService/List":                  readerRoles,
+	"/machine.MachineService/LoadAvg":               readerRoles,
+	"/machine.MachineService/Logs":                  readerRoles,
+	"/machine.MachineService/Memory":                readerRoles,
+	"/machine.MachineService/Mounts":                readerRoles,
+	"/machine.MachineService/NetworkDeviceStats":    readerRoles,
+	"/machine.MachineService/PacketCapture":         operatorRoles,
+	"/machine.MachineService/Processes":             readerRoles,
+	"/machine.MachineService/Read":                  adminRoles,
+	"/machine.MachineService/Reboot":                operatorRoles,
+	"/machine.MachineService/Recover":               adminRoles,
+	"/machine.MachineService/Reset":                 adminRoles,
+	"/machine.MachineService/Restart":               operatorRoles,
+	"/machine.MachineService/Rollback":              adminRoles,
+	"/machine.MachineService/ServiceList":           readerRoles,
+	"/machine.MachineService/ServiceRestart":        operatorRoles,
+	"/machine.MachineService/ServiceStart":          operatorRoles,
+	"/machine.MachineService/ServiceStop":           operatorRoles,
+	"/machine.MachineService/Shutdown":              operatorRoles,
+	"/machine.MachineService/Stats":                 readerRoles,
+	"/machine.MachineService/SystemStat":            readerRoles,
+	"/machine.MachineService/Upgrade":               adminRoles,
+	"/machine.MachineService/UpgradeFromArchive":    adminRoles,
+	"/machine.MachineService/Version":               readerRoles,
+
+	"/network.NetworkService/Interfaces": readerRoles,
+	"/network.NetworkService/Routes":     readerRoles,
+
+	"/storage.StorageService/Disks":     readerRoles,
+	"/storage.StorageService/ListDisks": readerRoles,
+
+	"/time.TimeService/Time":      readerRoles,
+	"/time.TimeService/TimeCheck": readerRoles,
+}
@@ -52,8 +52,11 @@ func RunInstallerContainer(disk, platform, ref string, reg config.Registries, op
 		if errdefs.IsNotFound(err) && options.Pull {
 			log.Printf("pulling %q", ref)
 
-			img, err = image.Pull(ctx, reg, client, ref)
+			img, err = image.Pull(ctx, reg, client, ref, options.PullOptions...)
 		}
+	} else {
+		// the image which is already present (pulled or imported from the archive) is verified as well
+		err = image.Verify(ctx, reg, img, options.PullOptions...)
 	}
 
 	if err != nil {
@@ -96,6 +99,10 @@ func RunInstallerContainer(disk, platform, ref string, reg config.Registries, op
 		args = append(args, []string{"--extra-kernel-arg", arg}...)
 	}
 
+	for _, label := range options.EncryptedPartitions {
+		args = append(args, []string{"--encrypt-partition", label}...)
+	}
+
 	specOpts := []oci.SpecOpts{
 		oci.WithImageConfig(img),
 		oci.WithProcessArgs(args...),
@@ -4,6 +4,8 @@
 
 package install
 
+import "github.com/talos-systems/talos/internal/pkg/containers/image"
+
 // Option is a functional option.
 type Option func(o *Options) error
 
@@ -14,6 +16,10 @@ type Options struct {
 	Upgrade         bool
 	Zero            bool
 	ExtraKernelArgs []string
+
+	EncryptedPartitions []string
+
+	PullOptions []image.PullOption
 }
 
 // DefaultInstallOptions returns default options.
@@ -67,3 +73,21 @@ func WithExtraKernelArgs(s []string) Option {
 		return nil
 	}
 }
+
+// WithEncryptedPartitions sets the labels of the encrypted system partitions.
+func WithEncryptedPartitions(labels []string) Option {
+	return func(o *Options) error {
+		o.EncryptedPartitions = labels
+
+		return nil
+	}
+}
+
+// WithPullOptions sets the options for the installer image pull.
+func WithPullOptions(opts ...image.PullOption) Option {
+	return func(o *Options) error {
+		o.PullOptions = append(o.PullOptions, opts...)
+
+		return nil
+	}
+}
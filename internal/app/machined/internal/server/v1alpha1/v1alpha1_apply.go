@@ -0,0 +1,220 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/pkg/configuration"
+	"github.com/talos-systems/talos/internal/pkg/containers/cri/containerd"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/sysctl"
+)
+
+// applyLive applies configuration changes which don't require a reboot.
+//
+// New configuration should be already set in the runtime, current is the configuration
+// machine was running with before.
+//
+// nolint: gocyclo
+func (s *Server) applyLive(ctx context.Context, current config.Provider, changes *configuration.Changes) error {
+	var result *multierror.Error
+
+	for key, value := range changes.Sysctls {
+		if err := sysctl.WriteSystemProperty(&sysctl.SystemProperty{Key: key, Value: value}); err != nil {
+			result = multierror.Append(result, fmt.Errorf("error writing sysctl %q: %w", key, err))
+		}
+	}
+
+	currentFiles, err := current.Machine().Files()
+	if err != nil {
+		return err
+	}
+
+	for _, f := range changes.Files {
+		if err = writeFile(f, f.Content(), hasPath(currentFiles, f.Path())); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	var services []string
+
+	if changes.Registries {
+		if err = applyRegistries(current.Machine().Registries(), s.Controller.Runtime().Config().Machine().Registries()); err != nil {
+			result = multierror.Append(result, fmt.Errorf("error updating registries configuration: %w", err))
+		} else {
+			services = append(services, "cri")
+		}
+	}
+
+	if changes.KubeletExtraArgs {
+		services = append(services, "kubelet")
+	}
+
+	if changes.NetworkInterfaces {
+		services = append(services, "networkd")
+	}
+
+	if changes.Logging {
+		var senders []runtime.LogSender
+
+		if senders, err = logging.NewSenders(s.Controller.Runtime().Config().Machine().Logging().Destinations()); err != nil {
+			result = multierror.Append(result, fmt.Errorf("error updating log forwarding: %w", err))
+		} else {
+			s.Controller.Runtime().Logging().SetSenders(senders)
+		}
+	}
+
+	for _, id := range services {
+		if err = s.restartService(ctx, id); err != nil {
+			result = multierror.Append(result, fmt.Errorf("error restarting %q: %w", id, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// restartService restarts the service if it is running, so that it picks up new configuration.
+func (s *Server) restartService(ctx context.Context, id string) error {
+	services := system.Services(s.Controller.Runtime())
+
+	// service is not loaded or not running, it will pick up new config once started
+	if _, running, err := services.IsRunning(id); err != nil || !running {
+		return nil
+	}
+
+	log.Printf("restarting service %q to apply configuration changes", id)
+
+	if err := services.Stop(ctx, id); err != nil {
+		return err
+	}
+
+	return services.Start(id)
+}
+
+// applyRegistries rewrites registry configuration generated for the CRI.
+//
+// CRI containerd configuration is appended to the config shipped with the rootfs at boot,
+// so the previously appended part is replaced with the new one.
+func applyRegistries(current, next config.Registries) error {
+	currentFiles, err := containerd.GenerateRegistriesConfig(current)
+	if err != nil {
+		return err
+	}
+
+	nextFiles, err := containerd.GenerateRegistriesConfig(next)
+	if err != nil {
+		return err
+	}
+
+	var appended string
+
+	for _, f := range currentFiles {
+		if f.Path() == constants.CRIContainerdConfig {
+			appended = "\n" + f.Content()
+		}
+	}
+
+	for _, f := range nextFiles {
+		if f.Path() != constants.CRIContainerdConfig {
+			if err = writeFile(f, f.Content(), hasPath(currentFiles, f.Path())); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		var existing []byte
+
+		existing, err = ioutil.ReadFile(filepath.Join("/var", f.Path()))
+		if err != nil {
+			return err
+		}
+
+		if !strings.HasSuffix(string(existing), appended) {
+			return fmt.Errorf("unexpected contents of %q", f.Path())
+		}
+
+		if err = writeFile(f, strings.TrimSuffix(string(existing), appended)+"\n"+f.Content(), true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeFile writes the machine file following the rules of the boot time WriteUserFiles task.
+//
+// Files outside of /var are written to /var and bind mounted into the place, the bind
+// mount is skipped if the file was already mounted on boot.
+func writeFile(f config.File, content string, mounted bool) error {
+	if filepath.Dir(f.Path()) == constants.ManifestsDirectory {
+		if err := ioutil.WriteFile(f.Path(), []byte(content), f.Permissions()); err != nil {
+			return err
+		}
+
+		return os.Chmod(f.Path(), f.Permissions())
+	}
+
+	p := f.Path()
+	inVar := strings.Split(strings.TrimLeft(p, "/"), string(os.PathSeparator))[0] == "var"
+
+	if !inVar {
+		if f.Op() == "create" {
+			return fmt.Errorf("create operation not allowed outside of /var: %q", f.Path())
+		}
+
+		p = filepath.Join("/var", f.Path())
+	}
+
+	if f.Op() == "overwrite" && !mounted {
+		if _, err := os.Stat(f.Path()); err != nil {
+			return fmt.Errorf("file must exist: %q", f.Path())
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(p, []byte(content), f.Permissions()); err != nil {
+		return err
+	}
+
+	if err := os.Chmod(p, f.Permissions()); err != nil {
+		return err
+	}
+
+	if !inVar && !mounted {
+		if err := unix.Mount(p, f.Path(), "", unix.MS_BIND|unix.MS_RDONLY, ""); err != nil {
+			return fmt.Errorf("failed to create bind mount for %s: %w", p, err)
+		}
+	}
+
+	return nil
+}
+
+func hasPath(files []config.File, path string) bool {
+	for _, f := range files {
+		if f.Path() == path {
+			return true
+		}
+	}
+
+	return false
+}
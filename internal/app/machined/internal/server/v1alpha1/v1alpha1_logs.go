@@ -0,0 +1,177 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/pkg/kmsg"
+	"github.com/talos-systems/talos/pkg/chunker/filter"
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// parseTimeBounds converts the optional time bounds of the request, zero time means no bound.
+func parseTimeBounds(since, until *timestamp.Timestamp) (sinceTime, untilTime time.Time, err error) {
+	if since != nil {
+		if sinceTime, err = ptypes.Timestamp(since); err != nil {
+			return sinceTime, untilTime, fmt.Errorf("invalid since: %w", err)
+		}
+	}
+
+	if until != nil {
+		if untilTime, err = ptypes.Timestamp(until); err != nil {
+			return sinceTime, untilTime, fmt.Errorf("invalid until: %w", err)
+		}
+	}
+
+	if !sinceTime.IsZero() && !untilTime.IsZero() && untilTime.Before(sinceTime) {
+		return sinceTime, untilTime, fmt.Errorf("until %s is before since %s", untilTime, sinceTime)
+	}
+
+	return sinceTime, untilTime, nil
+}
+
+// compileMatch compiles the optional regular expression of the request.
+func compileMatch(match string) (*regexp.Regexp, error) {
+	if match == "" {
+		return nil, nil
+	}
+
+	re, err := regexp.Compile(match)
+	if err != nil {
+		return nil, fmt.Errorf("invalid match expression: %w", err)
+	}
+
+	return re, nil
+}
+
+// containerLogsMatch builds the line filter for the container logs.
+//
+// Container log files written by CRI prefix each line with the RFC3339 timestamp, which is used
+// for the time bounds. Lines without the timestamp are not filtered by time.
+func containerLogsMatch(req *machine.LogsRequest) (filter.MatchFunc, error) {
+	since, until, err := parseTimeBounds(req.Since, req.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	re, err := compileMatch(req.Match)
+	if err != nil {
+		return nil, err
+	}
+
+	if re == nil && since.IsZero() && until.IsZero() {
+		return nil, nil
+	}
+
+	return func(line []byte) bool {
+		if !since.IsZero() || !until.IsZero() {
+			if i := bytes.IndexByte(line, ' '); i > 0 {
+				if ts, err := time.Parse(time.RFC3339Nano, string(line[:i])); err == nil {
+					if !since.IsZero() && ts.Before(since) {
+						return false
+					}
+
+					if !until.IsZero() && ts.After(until) {
+						return false
+					}
+				}
+			}
+		}
+
+		return re == nil || re.Match(line)
+	}, nil
+}
+
+// dmesgFilter builds the kernel log filter from the request.
+func dmesgFilter(req *machine.DmesgRequest) (*kmsg.Filter, error) {
+	var (
+		f   kmsg.Filter
+		err error
+	)
+
+	if f.Since, f.Until, err = parseTimeBounds(req.Since, req.Until); err != nil {
+		return nil, err
+	}
+
+	if f.Match, err = compileMatch(req.Match); err != nil {
+		return nil, err
+	}
+
+	for _, name := range req.Facilities {
+		var facility kmsg.Facility
+
+		if facility, err = kmsg.ParseFacility(name); err != nil {
+			return nil, err
+		}
+
+		f.Facilities = append(f.Facilities, facility)
+	}
+
+	if req.Priority != "" {
+		var priority kmsg.Priority
+
+		if priority, err = kmsg.ParsePriority(req.Priority); err != nil {
+			return nil, err
+		}
+
+		f.Priority = &priority
+	}
+
+	return &f, nil
+}
+
+// previousBootDmesg sends the kernel log of the previous boot saved from the pstore records.
+func previousBootDmesg(req *machine.DmesgRequest, srv machine.MachineService_DmesgServer) error {
+	if req.Follow || req.Tail || req.Since != nil || req.Until != nil || len(req.Facilities) > 0 || req.Priority != "" {
+		return status.Error(codes.InvalidArgument, "only match filter is supported for the previous boot kernel log")
+	}
+
+	re, err := compileMatch(req.Match)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(constants.PreviousBootKernelLogPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return status.Error(codes.NotFound, "kernel log of the previous boot is not available")
+		}
+
+		return err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+
+		if re != nil && !re.Match(line) {
+			continue
+		}
+
+		if err = srv.Send(&common.Data{
+			Bytes: append(append([]byte(nil), line...), '\n'),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
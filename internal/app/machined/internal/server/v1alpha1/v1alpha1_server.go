@@ -17,6 +17,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"syscall"
 	"time"
@@ -31,12 +32,14 @@ import (
 	"github.com/prometheus/procfs"
 	"github.com/rs/xid"
 	"go.etcd.io/etcd/clientv3/concurrency"
+	"golang.org/x/net/bpf"
 	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/grub"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	storaged "github.com/talos-systems/talos/internal/app/storaged"
 	"github.com/talos-systems/talos/internal/pkg/configuration"
 	"github.com/talos-systems/talos/internal/pkg/containers"
 	taloscontainerd "github.com/talos-systems/talos/internal/pkg/containers/containerd"
@@ -46,12 +49,15 @@ import (
 	"github.com/talos-systems/talos/internal/pkg/kmsg"
 	"github.com/talos-systems/talos/internal/pkg/kubeconfig"
 	"github.com/talos-systems/talos/internal/pkg/mount"
+	"github.com/talos-systems/talos/internal/pkg/pcap"
 	"github.com/talos-systems/talos/pkg/archiver"
 	"github.com/talos-systems/talos/pkg/chunker"
+	"github.com/talos-systems/talos/pkg/chunker/filter"
 	"github.com/talos-systems/talos/pkg/chunker/stream"
 	"github.com/talos-systems/talos/pkg/machinery/api/cluster"
 	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/api/storage"
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	machinetype "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
@@ -69,6 +75,8 @@ const OSPathSeparator = string(os.PathSeparator)
 
 // Server implements the gRPC service server.
 type Server struct {
+	storaged.Server
+
 	Controller runtime.Controller
 
 	server *grpc.Server
@@ -90,9 +98,15 @@ func (s *Server) Register(obj *grpc.Server) {
 
 	machine.RegisterMachineServiceServer(obj, s)
 	cluster.RegisterClusterServiceServer(obj, s)
+	storage.RegisterStorageServiceServer(obj, s)
 }
 
 // ApplyConfiguration implements machine.MachineService.
+//
+// With NoReboot, configuration changes which can be applied at runtime are applied
+// immediately, and the rest of the changes is picked up on the next reboot.
+//
+// nolint: gocyclo
 func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfigurationRequest) (reply *machine.ApplyConfigurationResponse, err error) {
 	if !in.NoReboot {
 		if err = s.Controller.Runtime().SetConfig(in.GetData()); err != nil {
@@ -108,38 +122,84 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 				}
 			}
 		}()
-	} else {
-		cfg, err := s.Controller.Runtime().ValidateConfig(in.GetData())
-		if err != nil {
-			return nil, err
-		}
 
-		err = cfg.ApplyDynamicConfig(ctx, s.Controller.Runtime().State().Platform())
-		if err != nil {
-			return nil, err
-		}
+		return &machine.ApplyConfigurationResponse{
+			Messages: []*machine.ApplyConfiguration{
+				{},
+			},
+		}, nil
+	}
 
-		var b []byte
+	cfg, err := s.Controller.Runtime().ValidateConfig(in.GetData())
+	if err != nil {
+		return nil, err
+	}
 
-		b, err = cfg.Bytes()
-		if err != nil {
-			return nil, err
-		}
+	// running configuration has the dynamic configuration applied, so the new one
+	// should be compared with it the same way, but persisted as received
+	err = cfg.ApplyDynamicConfig(ctx, s.Controller.Runtime().State().Platform())
+	if err != nil {
+		return nil, err
+	}
 
-		if err = ioutil.WriteFile(constants.ConfigPath, b, 0o600); err != nil {
-			return nil, err
-		}
+	current := s.Controller.Runtime().Config()
+
+	changes, err := configuration.Diff(current, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var live []byte
+
+	live, err = configuration.Merge(current, cfg, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ioutil.WriteFile(constants.ConfigPath, in.GetData(), 0o600); err != nil {
+		return nil, err
+	}
+
+	if err = s.Controller.Runtime().SetConfig(live); err != nil {
+		return nil, err
+	}
+
+	if err = s.applyLive(ctx, current, changes); err != nil {
+		return nil, fmt.Errorf("error applying configuration changes: %w", err)
 	}
 
 	reply = &machine.ApplyConfigurationResponse{
 		Messages: []*machine.ApplyConfiguration{
-			{},
+			{
+				AppliedChanges: changes.Live(),
+				PendingChanges: changes.RebootRequired,
+			},
 		},
 	}
 
 	return reply, nil
 }
 
+// GetConfiguration implements the machine.MachineServer interface.
+//
+// Configuration is returned as persisted on disk, so it includes the dynamic configuration
+// (e.g. platform hostname) which is applied before the configuration is saved.
+func (s *Server) GetConfiguration(ctx context.Context, in *machine.GetConfigurationRequest) (reply *machine.GetConfigurationResponse, err error) {
+	b, err := ioutil.ReadFile(constants.ConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading machine configuration: %w", err)
+	}
+
+	return &machine.GetConfigurationResponse{
+		Messages: []*machine.GetConfiguration{
+			{
+				Data: b,
+				Mode: s.Controller.Runtime().State().Platform().Mode().String(),
+			},
+		},
+	}, nil
+}
+
 // GenerateConfiguration implements the machine.MachineServer interface.
 // nolint:gocyclo
 func (s *Server) GenerateConfiguration(ctx context.Context, in *machine.GenerateConfigurationRequest) (reply *machine.GenerateConfigurationResponse, err error) {
@@ -198,8 +258,13 @@ func (s *Server) Rollback(ctx context.Context, in *machine.RollbackRequest) (*ma
 			}
 		}()
 
+		systemDisk := s.Controller.Runtime().State().Machine().Disk()
+		if systemDisk == nil {
+			return fmt.Errorf("system disk not found")
+		}
+
 		grub := &grub.Grub{
-			BootDisk: s.Controller.Runtime().Config().Machine().Install().Disk(),
+			BootDisk: systemDisk.BlockDevice.Device().Name(),
 		}
 
 		_, next, err := grub.Labels()
@@ -249,6 +314,12 @@ func (s *Server) Bootstrap(ctx context.Context, in *machine.BootstrapRequest) (r
 		return nil, fmt.Errorf("bootstrap can only be performed on a control plane node")
 	}
 
+	if in.GetRecoverEtcd() {
+		if _, err = os.Stat(constants.EtcdRecoverySnapshotPath); err != nil {
+			return nil, fmt.Errorf("etcd snapshot is not available, upload it with EtcdRecover first: %w", err)
+		}
+	}
+
 	go func() {
 		if err := s.Controller.Run(runtime.SequenceBootstrap, in); err != nil {
 			log.Println("bootstrap failed:", err)
@@ -305,8 +376,6 @@ func (s *Server) Shutdown(ctx context.Context, in *empty.Empty) (reply *machine.
 //
 // nolint: dupl, gocyclo
 func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (reply *machine.UpgradeResponse, err error) {
-	var mu *concurrency.Mutex
-
 	if err = s.checkSupported(runtime.Upgrade); err != nil {
 		return nil, err
 	}
@@ -315,10 +384,19 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (reply
 
 	log.Printf("validating %q", in.GetImage())
 
-	if err = pullAndValidateInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), in.GetImage()); err != nil {
+	if err = pullAndValidateInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), in.GetImage(),
+		image.WithVerification(s.Controller.Runtime().Config().Machine().Install().ImageVerification(), s.Controller.Runtime().Events()),
+	); err != nil {
 		return nil, fmt.Errorf("error validating installer image %q: %w", in.GetImage(), err)
 	}
 
+	return s.upgrade(ctx, in)
+}
+
+// upgrade runs the upgrade sequence with the installer image which is already available on the node.
+func (s *Server) upgrade(ctx context.Context, in *machine.UpgradeRequest) (reply *machine.UpgradeResponse, err error) {
+	var mu *concurrency.Mutex
+
 	if s.Controller.Runtime().Config().Machine().Type() != machinetype.TypeJoin {
 		client, err := etcd.NewClientFromControlPlaneIPs(ctx, s.Controller.Runtime().Config().Cluster().CA(), s.Controller.Runtime().Config().Cluster().Endpoint())
 		if err != nil {
@@ -936,6 +1014,20 @@ func (s *Server) Logs(req *machine.LogsRequest, l machine.MachineService_LogsSer
 			options = append(options, runtime.WithTailLines(int(req.TailLines)))
 		}
 
+		var since, until time.Time
+
+		if since, until, err = parseTimeBounds(req.Since, req.Until); err != nil {
+			return err
+		}
+
+		if !since.IsZero() {
+			options = append(options, runtime.WithSince(since))
+		}
+
+		if !until.IsZero() {
+			options = append(options, runtime.WithUntil(until))
+		}
+
 		var logR io.ReadCloser
 
 		logR, err = s.Controller.Runtime().Logging().ServiceLog(req.Id).Reader(options...)
@@ -947,14 +1039,35 @@ func (s *Server) Logs(req *machine.LogsRequest, l machine.MachineService_LogsSer
 		defer logR.Close()
 
 		chunk = stream.NewChunker(l.Context(), logR)
+
+		var re *regexp.Regexp
+
+		if re, err = compileMatch(req.Match); err != nil {
+			return err
+		}
+
+		if re != nil {
+			chunk = filter.NewChunker(l.Context(), chunk, re.Match)
+		}
 	default:
-		var file io.Closer
+		var (
+			file  io.Closer
+			match filter.MatchFunc
+		)
+
+		if match, err = containerLogsMatch(req); err != nil {
+			return err
+		}
 
 		if chunk, file, err = k8slogs(l.Context(), req); err != nil {
 			return err
 		}
 		// nolint: errcheck
 		defer file.Close()
+
+		if match != nil {
+			chunk = filter.NewChunker(l.Context(), chunk, match)
+		}
 	}
 
 	for data := range chunk.Read() {
@@ -1077,6 +1190,10 @@ func (s *Server) Events(req *machine.EventsRequest, l machine.MachineService_Eve
 						return nil
 					}
 
+					if req.BootId != "" && !strings.HasPrefix(event.BootID, req.BootId) {
+						continue
+					}
+
 					msg, err := event.ToMachineEvent()
 					if err != nil {
 						return err
@@ -1095,7 +1212,7 @@ func (s *Server) Events(req *machine.EventsRequest, l machine.MachineService_Eve
 	return <-errCh
 }
 
-func pullAndValidateInstallerImage(ctx context.Context, reg config.Registries, ref string) error {
+func pullAndValidateInstallerImage(ctx context.Context, reg config.Registries, ref string, opts ...image.PullOption) error {
 	// Pull down specified installer image early so we can bail if it doesn't exist in the upstream registry
 	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
 
@@ -1104,11 +1221,15 @@ func pullAndValidateInstallerImage(ctx context.Context, reg config.Registries, r
 		return err
 	}
 
-	img, err := image.Pull(containerdctx, reg, client, ref)
+	img, err := image.Pull(containerdctx, reg, client, ref, opts...)
 	if err != nil {
 		return err
 	}
 
+	return validateInstallerImage(containerdctx, client, img)
+}
+
+func validateInstallerImage(containerdctx context.Context, client *containerd.Client, img containerd.Image) error {
 	// Launch the container with a known help command for a simple check to make sure the image is valid
 	args := []string{
 		"/bin/installer",
@@ -1298,6 +1419,10 @@ func (s *Server) Restart(ctx context.Context, in *machine.RestartRequest) (*mach
 //
 //nolint: gocyclo
 func (s *Server) Dmesg(req *machine.DmesgRequest, srv machine.MachineService_DmesgServer) error {
+	if req.PreviousBoot {
+		return previousBootDmesg(req, srv)
+	}
+
 	ctx := srv.Context()
 
 	var options []kmsg.Option
@@ -1310,6 +1435,11 @@ func (s *Server) Dmesg(req *machine.DmesgRequest, srv machine.MachineService_Dme
 		options = append(options, kmsg.FromTail())
 	}
 
+	msgFilter, err := dmesgFilter(req)
+	if err != nil {
+		return err
+	}
+
 	reader, err := kmsg.NewReader(options...)
 	if err != nil {
 		return fmt.Errorf("error opening /dev/kmsg reader: %w", err)
@@ -1337,6 +1467,16 @@ func (s *Server) Dmesg(req *machine.DmesgRequest, srv machine.MachineService_Dme
 				})
 			} else {
 				msg := packet.Message
+
+				// messages are ordered by the timestamp, so there's nothing left to send
+				if !msgFilter.Until.IsZero() && msg.Timestamp.After(msgFilter.Until) {
+					return nil
+				}
+
+				if !msgFilter.Matches(msg) {
+					continue
+				}
+
 				err = srv.Send(&common.Data{
 					Bytes: []byte(fmt.Sprintf("%s: %7s: [%s]: %s", msg.Facility, msg.Priority, msg.Timestamp.Format(time.RFC3339Nano), msg.Message)),
 				})
@@ -1349,6 +1489,62 @@ func (s *Server) Dmesg(req *machine.DmesgRequest, srv machine.MachineService_Dme
 	}
 }
 
+// PacketCapture implements the machine.MachineServer interface.
+//
+// PacketCapture captures packets on the interface and streams them in pcap format.
+func (s *Server) PacketCapture(in *machine.PacketCaptureRequest, srv machine.MachineService_PacketCaptureServer) error {
+	filter := make([]bpf.RawInstruction, len(in.BpfFilter))
+
+	for i, ins := range in.BpfFilter {
+		filter[i] = bpf.RawInstruction{
+			Op: uint16(ins.Op),
+			Jt: uint8(ins.Jt),
+			Jf: uint8(ins.Jf),
+			K:  ins.K,
+		}
+	}
+
+	capture, err := pcap.NewCapture(in.Interface,
+		pcap.WithPromiscuousMode(in.Promiscuous),
+		pcap.WithSnapLen(in.SnapLen),
+		pcap.WithFilter(filter),
+	)
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer capture.Close()
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	pr, pw := io.Pipe()
+
+	captureDone := make(chan struct{})
+
+	go func() {
+		defer close(captureDone)
+
+		pw.CloseWithError(capture.Run(ctx, pw))
+	}()
+
+	// capture socket should be closed only after the capture goroutine is done
+	defer func() { <-captureDone }()
+
+	chunker := stream.NewChunker(ctx, pr)
+	chunkCh := chunker.Read()
+
+	for data := range chunkCh {
+		err := srv.SendMsg(&common.Data{Bytes: data})
+		if err != nil {
+			cancel()
+		}
+	}
+
+	return nil
+}
+
 // Processes implements the machine.MachineServer interface.
 func (s *Server) Processes(ctx context.Context, in *empty.Empty) (reply *machine.ProcessesResponse, err error) {
 	procs, err := procfs.AllProcs()
@@ -1569,6 +1765,115 @@ func (s *Server) EtcdForfeitLeadership(ctx context.Context, in *machine.EtcdForf
 	return reply, nil
 }
 
+// EtcdSnapshot implements the machine.MachineServer interface.
+//
+// EtcdSnapshot streams a consistent snapshot of the local etcd member.
+func (s *Server) EtcdSnapshot(in *machine.EtcdSnapshotRequest, srv machine.MachineService_EtcdSnapshotServer) error {
+	if s.Controller.Runtime().Config().Machine().Type() == machinetype.TypeJoin {
+		return fmt.Errorf("etcd snapshot can only be taken on a control plane node")
+	}
+
+	client, err := etcd.NewClient([]string{"127.0.0.1:2379"})
+	if err != nil {
+		return fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	rd, err := client.Snapshot(srv.Context())
+	if err != nil {
+		return fmt.Errorf("failed reading etcd snapshot: %w", err)
+	}
+
+	// nolint: errcheck
+	defer rd.Close()
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	chunker := stream.NewChunker(ctx, rd)
+	chunkCh := chunker.Read()
+
+	for data := range chunkCh {
+		err := srv.SendMsg(&common.Data{Bytes: data})
+		if err != nil {
+			cancel()
+		}
+	}
+
+	return nil
+}
+
+// EtcdRecover implements the machine.MachineServer interface.
+//
+// EtcdRecover receives an etcd snapshot and stores it on the node, so that
+// it can be used to bootstrap etcd via Bootstrap with recover_etcd set.
+//
+// nolint: gocyclo
+func (s *Server) EtcdRecover(srv machine.MachineService_EtcdRecoverServer) error {
+	if s.Controller.Runtime().Config().Machine().Type() == machinetype.TypeJoin {
+		return fmt.Errorf("etcd recover can only be performed on a control plane node")
+	}
+
+	if _, err := os.Stat(filepath.Dir(constants.EtcdRecoverySnapshotPath)); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("etcd service is not ready for recovery yet")
+		}
+
+		return err
+	}
+
+	snapshot, err := os.OpenFile(constants.EtcdRecoverySnapshotPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("error creating etcd recovery snapshot: %w", err)
+	}
+
+	// nolint: errcheck
+	defer snapshot.Close()
+
+	successfulUpload := false
+
+	defer func() {
+		if !successfulUpload {
+			os.Remove(snapshot.Name()) //nolint: errcheck
+		}
+	}()
+
+	for {
+		var msg *common.Data
+
+		msg, err = srv.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return err
+		}
+
+		if _, err = snapshot.Write(msg.Bytes); err != nil {
+			return fmt.Errorf("error writing etcd recovery snapshot: %w", err)
+		}
+	}
+
+	if err = snapshot.Sync(); err != nil {
+		return fmt.Errorf("error fsyncing etcd recovery snapshot: %w", err)
+	}
+
+	if err = snapshot.Close(); err != nil {
+		return fmt.Errorf("error closing etcd recovery snapshot: %w", err)
+	}
+
+	successfulUpload = true
+
+	return srv.SendAndClose(&machine.EtcdRecoverResponse{
+		Messages: []*machine.EtcdRecover{
+			{},
+		},
+	})
+}
+
 func upgradeMutex(c *etcd.Client) (*concurrency.Mutex, error) {
 	sess, err := concurrency.NewSession(c.Client,
 		concurrency.WithTTL(MinimumEtcdUpgradeLeaseLockSeconds),
@@ -0,0 +1,176 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/namespaces"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+const sha256Prefix = "sha256:"
+
+// UpgradeFromArchive implements the machine.MachineServer interface.
+//
+// UpgradeFromArchive receives the installer image archive, verifies the digest of the archive
+// and imports the image into the system containerd, then runs the same upgrade sequence as Upgrade.
+func (s *Server) UpgradeFromArchive(srv machine.MachineService_UpgradeFromArchiveServer) error {
+	if err := s.checkSupported(runtime.Upgrade); err != nil {
+		return err
+	}
+
+	log.Printf("upgrade from archive request received")
+
+	req, err := srv.Recv()
+	if err != nil {
+		return fmt.Errorf("error receiving upgrade request: %w", err)
+	}
+
+	if req.GetImage() == "" {
+		return errors.New("installer image name is required")
+	}
+
+	if !strings.HasPrefix(req.GetDigest(), sha256Prefix) {
+		return fmt.Errorf("archive digest should be in the form %s<hex>, got %q", sha256Prefix, req.GetDigest())
+	}
+
+	// archive might be too big for the tmpfs, so it's stored on the EPHEMERAL partition
+	f, err := ioutil.TempFile(constants.EphemeralMountPoint, "installer-*.tar")
+	if err != nil {
+		return err
+	}
+
+	archivePath := f.Name()
+
+	// archive is not needed once imported
+	defer os.Remove(archivePath) //nolint: errcheck
+
+	digest, err := receiveArchive(srv, req, f)
+	if err != nil {
+		return err
+	}
+
+	if digest != req.GetDigest() {
+		return fmt.Errorf("archive digest mismatch: expected %q, got %q", req.GetDigest(), digest)
+	}
+
+	log.Printf("importing %q from the archive (%s)", req.GetImage(), digest)
+
+	ctx := srv.Context()
+
+	var sig *image.Signature
+
+	if req.GetSignature() != nil {
+		sig = &image.Signature{
+			Payload:   req.GetSignature().GetPayload(),
+			Signature: req.GetSignature().GetSignature(),
+		}
+	}
+
+	if err = importAndValidateInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), archivePath, req.GetImage(), sig,
+		image.WithVerification(s.Controller.Runtime().Config().Machine().Install().ImageVerification(), s.Controller.Runtime().Events()),
+	); err != nil {
+		return fmt.Errorf("error validating installer image %q: %w", req.GetImage(), err)
+	}
+
+	reply, err := s.upgrade(ctx, &machine.UpgradeRequest{
+		Image:    req.GetImage(),
+		Preserve: req.GetPreserve(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return srv.SendAndClose(reply)
+}
+
+// receiveArchive writes the archive data from the stream to the file and returns the digest of the data.
+func receiveArchive(srv machine.MachineService_UpgradeFromArchiveServer, req *machine.UpgradeFromArchiveRequest, f *os.File) (string, error) {
+	//nolint: errcheck
+	defer f.Close()
+
+	hash := sha256.New()
+	w := io.MultiWriter(f, hash)
+
+	for {
+		if _, err := w.Write(req.GetData()); err != nil {
+			return "", fmt.Errorf("error writing archive: %w", err)
+		}
+
+		var err error
+
+		req, err = srv.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return "", fmt.Errorf("error receiving archive: %w", err)
+		}
+	}
+
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("error writing archive: %w", err)
+	}
+
+	return sha256Prefix + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// importAndValidateInstallerImage imports the installer image from the archive and validates it.
+//
+// If the signature is set, it's attached to the image, so that the image is verified offline
+// (both here and by the upgrade sequence). The imported image is removed if it doesn't pass
+// the image signature verification.
+func importAndValidateInstallerImage(ctx context.Context, reg config.Registries, archivePath, ref string, sig *image.Signature, opts ...image.PullOption) error {
+	if err := image.Import(ctx, archivePath, ref); err != nil {
+		return err
+	}
+
+	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
+
+	client, err := containerd.New(constants.SystemContainerdAddress)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer client.Close()
+
+	img, err := client.GetImage(containerdctx, ref)
+	if err != nil {
+		return err
+	}
+
+	if sig != nil {
+		if img, err = image.AttachSignature(containerdctx, client, img, sig); err != nil {
+			return err
+		}
+	}
+
+	if err = image.Verify(containerdctx, reg, img, opts...); err != nil {
+		//nolint: errcheck
+		client.ImageService().Delete(containerdctx, img.Name())
+
+		return err
+	}
+
+	return validateInstallerImage(containerdctx, client, img)
+}
@@ -69,12 +69,16 @@ func recovery() {
 }
 
 func handle(err error) {
+	reason := "machined failure"
+
 	if err != nil {
 		log.Print(err)
+
+		reason = err.Error()
 	}
 
 	if meta, err := bootloader.NewMeta(); err == nil {
-		if err = meta.Revert(); err != nil {
+		if err = meta.RevertWithReason(reason); err != nil {
 			log.Printf("failed to revert upgrade: %v", err)
 		}
 
@@ -151,6 +155,12 @@ func main() {
 		handle(err)
 	}
 
+	// Roll back the upgrade if the machine fails to boot.
+	watchdog, err := v1alpha1runtime.StartBootWatchdog(c)
+	if err != nil {
+		log.Printf("failed to start boot watchdog: %s", err)
+	}
+
 	// Start the machine API.
 	system.Services(c.Runtime()).LoadAndStart(&services.Machined{Controller: c})
 
@@ -159,6 +169,8 @@ func main() {
 		handle(err)
 	}
 
+	watchdog.Booted()
+
 	// Watch and handle runtime events.
 	_ = c.Runtime().Events().Watch(func(events <-chan runtime.Event) { //nolint: errcheck
 		for {
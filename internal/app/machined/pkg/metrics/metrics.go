@@ -0,0 +1,48 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package metrics implements Prometheus metrics of the node.
+package metrics
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/procfs"
+)
+
+const namespace = "talos"
+
+var (
+	registry     *prometheus.Registry
+	registryOnce sync.Once
+)
+
+// Registry returns the registry with all the node metrics.
+//
+// Registry is created on the first call, sequencer metrics are collected
+// from the moment machined starts even if the endpoint is not enabled.
+func Registry() *prometheus.Registry {
+	registryOnce.Do(func() {
+		registry = prometheus.NewRegistry()
+
+		registry.MustRegister(
+			NewSystemCollector(procfs.DefaultMountPoint),
+			NewServicesCollector(),
+			phaseDuration,
+			phaseFailures,
+		)
+	})
+
+	return registry
+}
+
+// Handler returns HTTP handler which serves the node metrics.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry(), promhttp.HandlerOpts{
+		ErrorHandling: promhttp.ContinueOnError,
+	})
+}
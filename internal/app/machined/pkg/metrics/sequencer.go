@@ -0,0 +1,42 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	phaseDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "sequencer",
+			Name:      "phase_duration_seconds",
+			Help:      "Duration of the last run of the sequencer phase.",
+		},
+		[]string{"sequence", "phase"},
+	)
+
+	phaseFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "sequencer",
+			Name:      "phase_failures_total",
+			Help:      "Number of the failed runs of the sequencer phase.",
+		},
+		[]string{"sequence", "phase"},
+	)
+)
+
+// ObservePhase records the run of the sequencer phase.
+func ObservePhase(sequence, phase string, duration time.Duration, err error) {
+	phaseDuration.WithLabelValues(sequence, phase).Set(duration.Seconds())
+
+	if err != nil {
+		phaseFailures.WithLabelValues(sequence, phase).Inc()
+	}
+}
@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+var serviceStates = []events.ServiceState{
+	events.StateInitialized,
+	events.StatePreparing,
+	events.StateWaiting,
+	events.StateRunning,
+	events.StateStopping,
+	events.StateFinished,
+	events.StateFailed,
+	events.StateSkipped,
+}
+
+// ServicesCollector exports the state, the health and the resource usage of the Talos services.
+type ServicesCollector struct {
+	list func() []*machine.ServiceInfo
+
+	state       *prometheus.Desc
+	healthy     *prometheus.Desc
+	memoryUsage *prometheus.Desc
+	cpuSeconds  *prometheus.Desc
+}
+
+// NewServicesCollector creates the collector for the services managed by machined.
+func NewServicesCollector() *ServicesCollector {
+	return newServicesCollector(func() []*machine.ServiceInfo {
+		runners := system.Services(nil).List()
+
+		services := make([]*machine.ServiceInfo, len(runners))
+
+		for i := range runners {
+			services[i] = runners[i].AsProto()
+		}
+
+		return services
+	})
+}
+
+func newServicesCollector(list func() []*machine.ServiceInfo) *ServicesCollector {
+	return &ServicesCollector{
+		list: list,
+
+		state: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "service", "state"),
+			"Current state of the service, 1 for the current state and 0 for all the other states.",
+			[]string{"service", "state"}, nil,
+		),
+		healthy: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "service", "healthy"),
+			"Whether the service is healthy, reported only for the services with known health.",
+			[]string{"service"}, nil,
+		),
+		memoryUsage: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "service", "memory_usage_bytes"),
+			"Memory usage of the service cgroup.",
+			[]string{"service"}, nil,
+		),
+		cpuSeconds: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "service", "cpu_seconds_total"),
+			"CPU time consumed by the service cgroup.",
+			[]string{"service"}, nil,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector interface.
+func (c *ServicesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.state
+	ch <- c.healthy
+	ch <- c.memoryUsage
+	ch <- c.cpuSeconds
+}
+
+// Collect implements prometheus.Collector interface.
+func (c *ServicesCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, svc := range c.list() {
+		for _, state := range serviceStates {
+			ch <- prometheus.MustNewConstMetric(c.state, prometheus.GaugeValue, boolValue(svc.State == state.String()), svc.Id, state.String())
+		}
+
+		if health := svc.GetHealth(); health != nil && !health.Unknown {
+			ch <- prometheus.MustNewConstMetric(c.healthy, prometheus.GaugeValue, boolValue(health.Healthy), svc.Id)
+		}
+
+		if resources := svc.GetResources(); resources != nil {
+			ch <- prometheus.MustNewConstMetric(c.memoryUsage, prometheus.GaugeValue, float64(resources.MemoryUsage), svc.Id)
+			ch <- prometheus.MustNewConstMetric(c.cpuSeconds, prometheus.CounterValue, float64(resources.CpuUsage)/1e9, svc.Id)
+		}
+	}
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// nolint: testpackage
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+func TestServicesCollector(t *testing.T) {
+	collector := newServicesCollector(func() []*machine.ServiceInfo {
+		return []*machine.ServiceInfo{
+			{
+				Id:     "apid",
+				State:  "Running",
+				Health: &machine.ServiceHealth{Healthy: true},
+			},
+			{
+				Id:     "etcd",
+				State:  "Failed",
+				Health: &machine.ServiceHealth{Unknown: true},
+			},
+		}
+	})
+
+	expected := `
+# HELP talos_service_healthy Whether the service is healthy, reported only for the services with known health.
+# TYPE talos_service_healthy gauge
+talos_service_healthy{service="apid"} 1
+# HELP talos_service_state Current state of the service, 1 for the current state and 0 for all the other states.
+# TYPE talos_service_state gauge
+talos_service_state{service="apid",state="Initialized"} 0
+talos_service_state{service="apid",state="Preparing"} 0
+talos_service_state{service="apid",state="Waiting"} 0
+talos_service_state{service="apid",state="Running"} 1
+talos_service_state{service="apid",state="Stopping"} 0
+talos_service_state{service="apid",state="Finished"} 0
+talos_service_state{service="apid",state="Failed"} 0
+talos_service_state{service="apid",state="Skipped"} 0
+talos_service_state{service="etcd",state="Initialized"} 0
+talos_service_state{service="etcd",state="Preparing"} 0
+talos_service_state{service="etcd",state="Waiting"} 0
+talos_service_state{service="etcd",state="Running"} 0
+talos_service_state{service="etcd",state="Stopping"} 0
+talos_service_state{service="etcd",state="Finished"} 0
+talos_service_state{service="etcd",state="Failed"} 1
+talos_service_state{service="etcd",state="Skipped"} 0
+`
+
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
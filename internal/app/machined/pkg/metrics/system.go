@@ -0,0 +1,426 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/procfs"
+)
+
+const (
+	// diskstats report sizes in 512-byte sectors regardless of the device sector size.
+	diskSectorSize = 512
+
+	// meminfo reports sizes in kB.
+	meminfoUnit = 1024
+)
+
+type memoryMetric struct {
+	desc  *prometheus.Desc
+	value func(procfs.Meminfo) uint64
+}
+
+// SystemCollector exports the same system statistics which are available
+// via LoadAvg, SystemStat, CPUInfo, Memory, NetworkDeviceStats and DiskStats APIs.
+type SystemCollector struct {
+	procPath string
+
+	load1  *prometheus.Desc
+	load5  *prometheus.Desc
+	load15 *prometheus.Desc
+
+	bootTime        *prometheus.Desc
+	cpuSeconds      *prometheus.Desc
+	interrupts      *prometheus.Desc
+	contextSwitches *prometheus.Desc
+	forks           *prometheus.Desc
+	procsRunning    *prometheus.Desc
+	procsBlocked    *prometheus.Desc
+
+	cpuInfo      *prometheus.Desc
+	cpuFrequency *prometheus.Desc
+
+	memory []memoryMetric
+
+	netReceiveBytes    *prometheus.Desc
+	netReceivePackets  *prometheus.Desc
+	netReceiveErrors   *prometheus.Desc
+	netReceiveDropped  *prometheus.Desc
+	netTransmitBytes   *prometheus.Desc
+	netTransmitPackets *prometheus.Desc
+	netTransmitErrors  *prometheus.Desc
+	netTransmitDropped *prometheus.Desc
+
+	diskReadsCompleted    *prometheus.Desc
+	diskReadsMerged       *prometheus.Desc
+	diskReadBytes         *prometheus.Desc
+	diskReadTime          *prometheus.Desc
+	diskWritesCompleted   *prometheus.Desc
+	diskWritesMerged      *prometheus.Desc
+	diskWrittenBytes      *prometheus.Desc
+	diskWriteTime         *prometheus.Desc
+	diskIONow             *prometheus.Desc
+	diskIOTime            *prometheus.Desc
+	diskIOTimeWeighted    *prometheus.Desc
+	diskDiscardsCompleted *prometheus.Desc
+	diskDiscardsMerged    *prometheus.Desc
+	diskDiscardedBytes    *prometheus.Desc
+	diskDiscardTime       *prometheus.Desc
+}
+
+// NewSystemCollector creates the collector which reads system statistics from procfs mounted at procPath.
+func NewSystemCollector(procPath string) *SystemCollector {
+	desc := func(subsystem, name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, name), help, labels, nil)
+	}
+
+	memory := func(name, help string, value func(procfs.Meminfo) uint64) memoryMetric {
+		return memoryMetric{
+			desc:  desc("memory", name+"_bytes", help),
+			value: value,
+		}
+	}
+
+	return &SystemCollector{
+		procPath: procPath,
+
+		load1:  desc("", "load1", "1m load average."),
+		load5:  desc("", "load5", "5m load average."),
+		load15: desc("", "load15", "15m load average."),
+
+		bootTime:        desc("", "boot_time_seconds", "Node boot time, in unixtime."),
+		cpuSeconds:      desc("cpu", "seconds_total", "Seconds the CPUs spent in each mode.", "cpu", "mode"),
+		interrupts:      desc("", "interrupts_total", "Total number of interrupts serviced."),
+		contextSwitches: desc("", "context_switches_total", "Total number of context switches."),
+		forks:           desc("", "forks_total", "Total number of forks."),
+		procsRunning:    desc("", "procs_running", "Number of processes in runnable state."),
+		procsBlocked:    desc("", "procs_blocked", "Number of processes blocked waiting for I/O to complete."),
+
+		cpuInfo:      desc("cpu", "info", "CPU information from /proc/cpuinfo.", "cpu", "vendor_id", "model_name", "physical_id", "core_id"),
+		cpuFrequency: desc("cpu", "frequency_hertz", "Current CPU frequency as reported in /proc/cpuinfo.", "cpu"),
+
+		memory: []memoryMetric{
+			memory("total", "Total usable memory.", func(m procfs.Meminfo) uint64 { return m.MemTotal }),
+			memory("free", "Free memory.", func(m procfs.Meminfo) uint64 { return m.MemFree }),
+			memory("available", "Memory available for starting new applications.", func(m procfs.Meminfo) uint64 { return m.MemAvailable }),
+			memory("buffers", "Memory in buffer cache.", func(m procfs.Meminfo) uint64 { return m.Buffers }),
+			memory("cached", "Memory in the page cache.", func(m procfs.Meminfo) uint64 { return m.Cached }),
+			memory("swap_cached", "Memory that once was swapped out and is swapped back in.", func(m procfs.Meminfo) uint64 { return m.SwapCached }),
+			memory("active", "Memory that has been used more recently.", func(m procfs.Meminfo) uint64 { return m.Active }),
+			memory("inactive", "Memory which has been less recently used.", func(m procfs.Meminfo) uint64 { return m.Inactive }),
+			memory("swap_total", "Total amount of swap space.", func(m procfs.Meminfo) uint64 { return m.SwapTotal }),
+			memory("swap_free", "Unused swap space.", func(m procfs.Meminfo) uint64 { return m.SwapFree }),
+			memory("dirty", "Memory waiting to get written back to the disk.", func(m procfs.Meminfo) uint64 { return m.Dirty }),
+			memory("writeback", "Memory actively being written back to the disk.", func(m procfs.Meminfo) uint64 { return m.Writeback }),
+			memory("anon_pages", "Non-file backed pages mapped into userspace page tables.", func(m procfs.Meminfo) uint64 { return m.AnonPages }),
+			memory("mapped", "Files which have been mapped into memory.", func(m procfs.Meminfo) uint64 { return m.Mapped }),
+			memory("shmem", "Memory used by shared memory and tmpfs.", func(m procfs.Meminfo) uint64 { return m.Shmem }),
+			memory("slab", "In-kernel data structures cache.", func(m procfs.Meminfo) uint64 { return m.Slab }),
+			memory("kernel_stack", "Memory used by kernel stacks.", func(m procfs.Meminfo) uint64 { return m.KernelStack }),
+			memory("page_tables", "Memory used by page tables.", func(m procfs.Meminfo) uint64 { return m.PageTables }),
+			memory("committed_as", "Memory which is currently allocated on the system.", func(m procfs.Meminfo) uint64 { return m.CommittedAS }),
+		},
+
+		netReceiveBytes:    desc("network", "receive_bytes_total", "Received bytes.", "device"),
+		netReceivePackets:  desc("network", "receive_packets_total", "Received packets.", "device"),
+		netReceiveErrors:   desc("network", "receive_errors_total", "Receive errors.", "device"),
+		netReceiveDropped:  desc("network", "receive_dropped_total", "Dropped received packets.", "device"),
+		netTransmitBytes:   desc("network", "transmit_bytes_total", "Transmitted bytes.", "device"),
+		netTransmitPackets: desc("network", "transmit_packets_total", "Transmitted packets.", "device"),
+		netTransmitErrors:  desc("network", "transmit_errors_total", "Transmit errors.", "device"),
+		netTransmitDropped: desc("network", "transmit_dropped_total", "Dropped transmitted packets.", "device"),
+
+		diskReadsCompleted:    desc("disk", "reads_completed_total", "Reads completed successfully.", "device"),
+		diskReadsMerged:       desc("disk", "reads_merged_total", "Reads merged.", "device"),
+		diskReadBytes:         desc("disk", "read_bytes_total", "Bytes read successfully.", "device"),
+		diskReadTime:          desc("disk", "read_time_seconds_total", "Seconds spent by all reads.", "device"),
+		diskWritesCompleted:   desc("disk", "writes_completed_total", "Writes completed successfully.", "device"),
+		diskWritesMerged:      desc("disk", "writes_merged_total", "Writes merged.", "device"),
+		diskWrittenBytes:      desc("disk", "written_bytes_total", "Bytes written successfully.", "device"),
+		diskWriteTime:         desc("disk", "write_time_seconds_total", "Seconds spent by all writes.", "device"),
+		diskIONow:             desc("disk", "io_now", "I/Os currently in progress.", "device"),
+		diskIOTime:            desc("disk", "io_time_seconds_total", "Seconds spent doing I/Os.", "device"),
+		diskIOTimeWeighted:    desc("disk", "io_time_weighted_seconds_total", "Weighted seconds spent doing I/Os.", "device"),
+		diskDiscardsCompleted: desc("disk", "discards_completed_total", "Discards completed successfully.", "device"),
+		diskDiscardsMerged:    desc("disk", "discards_merged_total", "Discards merged.", "device"),
+		diskDiscardedBytes:    desc("disk", "discarded_bytes_total", "Bytes discarded successfully.", "device"),
+		diskDiscardTime:       desc("disk", "discard_time_seconds_total", "Seconds spent by all discards.", "device"),
+	}
+}
+
+// Describe implements prometheus.Collector interface.
+func (c *SystemCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		c.load1, c.load5, c.load15,
+		c.bootTime, c.cpuSeconds, c.interrupts, c.contextSwitches, c.forks, c.procsRunning, c.procsBlocked,
+		c.cpuInfo, c.cpuFrequency,
+		c.netReceiveBytes, c.netReceivePackets, c.netReceiveErrors, c.netReceiveDropped,
+		c.netTransmitBytes, c.netTransmitPackets, c.netTransmitErrors, c.netTransmitDropped,
+		c.diskReadsCompleted, c.diskReadsMerged, c.diskReadBytes, c.diskReadTime,
+		c.diskWritesCompleted, c.diskWritesMerged, c.diskWrittenBytes, c.diskWriteTime,
+		c.diskIONow, c.diskIOTime, c.diskIOTimeWeighted,
+		c.diskDiscardsCompleted, c.diskDiscardsMerged, c.diskDiscardedBytes, c.diskDiscardTime,
+	} {
+		ch <- desc
+	}
+
+	for _, m := range c.memory {
+		ch <- m.desc
+	}
+}
+
+// Collect implements prometheus.Collector interface.
+//
+// Failure to read one of the sources is reported as an invalid metric,
+// metrics from the other sources are still collected.
+func (c *SystemCollector) Collect(ch chan<- prometheus.Metric) {
+	fs, err := procfs.NewFS(c.procPath)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.load1, err)
+
+		return
+	}
+
+	for _, source := range []struct {
+		desc    *prometheus.Desc
+		collect func(procfs.FS, chan<- prometheus.Metric) error
+	}{
+		{c.load1, c.collectLoadAvg},
+		{c.cpuSeconds, c.collectStat},
+		{c.cpuInfo, c.collectCPUInfo},
+		{c.memory[0].desc, c.collectMemory},
+		{c.netReceiveBytes, c.collectNetDev},
+		{c.diskReadsCompleted, c.collectDiskStats},
+	} {
+		if err = source.collect(fs, ch); err != nil {
+			ch <- prometheus.NewInvalidMetric(source.desc, err)
+		}
+	}
+}
+
+func (c *SystemCollector) collectLoadAvg(fs procfs.FS, ch chan<- prometheus.Metric) error {
+	loadAvg, err := fs.LoadAvg()
+	if err != nil {
+		return err
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.load1, prometheus.GaugeValue, loadAvg.Load1)
+	ch <- prometheus.MustNewConstMetric(c.load5, prometheus.GaugeValue, loadAvg.Load5)
+	ch <- prometheus.MustNewConstMetric(c.load15, prometheus.GaugeValue, loadAvg.Load15)
+
+	return nil
+}
+
+func (c *SystemCollector) collectStat(fs procfs.FS, ch chan<- prometheus.Metric) error {
+	stat, err := fs.Stat()
+	if err != nil {
+		return err
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.bootTime, prometheus.GaugeValue, float64(stat.BootTime))
+	ch <- prometheus.MustNewConstMetric(c.interrupts, prometheus.CounterValue, float64(stat.IRQTotal))
+	ch <- prometheus.MustNewConstMetric(c.contextSwitches, prometheus.CounterValue, float64(stat.ContextSwitches))
+	ch <- prometheus.MustNewConstMetric(c.forks, prometheus.CounterValue, float64(stat.ProcessCreated))
+	ch <- prometheus.MustNewConstMetric(c.procsRunning, prometheus.GaugeValue, float64(stat.ProcessesRunning))
+	ch <- prometheus.MustNewConstMetric(c.procsBlocked, prometheus.GaugeValue, float64(stat.ProcessesBlocked))
+
+	for i, cpu := range stat.CPU {
+		label := strconv.Itoa(i)
+
+		for mode, value := range map[string]float64{
+			"user":       cpu.User,
+			"nice":       cpu.Nice,
+			"system":     cpu.System,
+			"idle":       cpu.Idle,
+			"iowait":     cpu.Iowait,
+			"irq":        cpu.IRQ,
+			"softirq":    cpu.SoftIRQ,
+			"steal":      cpu.Steal,
+			"guest":      cpu.Guest,
+			"guest_nice": cpu.GuestNice,
+		} {
+			ch <- prometheus.MustNewConstMetric(c.cpuSeconds, prometheus.CounterValue, value, label, mode)
+		}
+	}
+
+	return nil
+}
+
+func (c *SystemCollector) collectCPUInfo(fs procfs.FS, ch chan<- prometheus.Metric) error {
+	info, err := fs.CPUInfo()
+	if err != nil {
+		return err
+	}
+
+	for _, cpu := range info {
+		label := strconv.FormatUint(uint64(cpu.Processor), 10)
+
+		ch <- prometheus.MustNewConstMetric(c.cpuInfo, prometheus.GaugeValue, 1, label, cpu.VendorID, cpu.ModelName, cpu.PhysicalID, cpu.CoreID)
+		ch <- prometheus.MustNewConstMetric(c.cpuFrequency, prometheus.GaugeValue, cpu.CPUMHz*1e6, label)
+	}
+
+	return nil
+}
+
+func (c *SystemCollector) collectMemory(fs procfs.FS, ch chan<- prometheus.Metric) error {
+	info, err := fs.Meminfo()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range c.memory {
+		ch <- prometheus.MustNewConstMetric(m.desc, prometheus.GaugeValue, float64(m.value(info)*meminfoUnit))
+	}
+
+	return nil
+}
+
+func (c *SystemCollector) collectNetDev(fs procfs.FS, ch chan<- prometheus.Metric) error {
+	netDev, err := fs.NetDev()
+	if err != nil {
+		return err
+	}
+
+	for _, line := range netDev {
+		for desc, value := range map[*prometheus.Desc]uint64{
+			c.netReceiveBytes:    line.RxBytes,
+			c.netReceivePackets:  line.RxPackets,
+			c.netReceiveErrors:   line.RxErrors,
+			c.netReceiveDropped:  line.RxDropped,
+			c.netTransmitBytes:   line.TxBytes,
+			c.netTransmitPackets: line.TxPackets,
+			c.netTransmitErrors:  line.TxErrors,
+			c.netTransmitDropped: line.TxDropped,
+		} {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, float64(value), line.Name)
+		}
+	}
+
+	return nil
+}
+
+func (c *SystemCollector) collectDiskStats(_ procfs.FS, ch chan<- prometheus.Metric) error {
+	stats, err := readDiskStats(filepath.Join(c.procPath, "diskstats"))
+	if err != nil {
+		return err
+	}
+
+	for _, stat := range stats {
+		for desc, value := range map[*prometheus.Desc]float64{
+			c.diskReadsCompleted:    float64(stat.readCompleted),
+			c.diskReadsMerged:       float64(stat.readMerged),
+			c.diskReadBytes:         float64(stat.readSectors * diskSectorSize),
+			c.diskReadTime:          float64(stat.readTimeMs) / 1000,
+			c.diskWritesCompleted:   float64(stat.writeCompleted),
+			c.diskWritesMerged:      float64(stat.writeMerged),
+			c.diskWrittenBytes:      float64(stat.writeSectors * diskSectorSize),
+			c.diskWriteTime:         float64(stat.writeTimeMs) / 1000,
+			c.diskIOTime:            float64(stat.ioTimeMs) / 1000,
+			c.diskIOTimeWeighted:    float64(stat.ioTimeWeightedMs) / 1000,
+			c.diskDiscardsCompleted: float64(stat.discardCompleted),
+			c.diskDiscardsMerged:    float64(stat.discardMerged),
+			c.diskDiscardedBytes:    float64(stat.discardSectors * diskSectorSize),
+			c.diskDiscardTime:       float64(stat.discardTimeMs) / 1000,
+		} {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value, stat.name)
+		}
+
+		ch <- prometheus.MustNewConstMetric(c.diskIONow, prometheus.GaugeValue, float64(stat.ioInProgress), stat.name)
+	}
+
+	return nil
+}
+
+type diskStat struct {
+	name string
+
+	readCompleted    uint64
+	readMerged       uint64
+	readSectors      uint64
+	readTimeMs       uint64
+	writeCompleted   uint64
+	writeMerged      uint64
+	writeSectors     uint64
+	writeTimeMs      uint64
+	ioInProgress     uint64
+	ioTimeMs         uint64
+	ioTimeWeightedMs uint64
+	discardCompleted uint64
+	discardMerged    uint64
+	discardSectors   uint64
+	discardTimeMs    uint64
+}
+
+// diskstats fields (including the major, minor and name fields) reported by kernels before 4.18,
+// and the number of fields with the discard statistics.
+const (
+	diskStatsFields        = 14
+	diskStatsDiscardFields = 18
+)
+
+// readDiskStats parses /proc/diskstats.
+//
+// Discard statistics are left zero if the kernel doesn't report them.
+func readDiskStats(path string) ([]diskStat, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	var stats []diskStat
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) < diskStatsFields {
+			continue
+		}
+
+		n := diskStatsFields
+		if len(fields) >= diskStatsDiscardFields {
+			n = diskStatsDiscardFields
+		}
+
+		// values beyond the reported fields are left zero
+		values := make([]uint64, diskStatsDiscardFields-3)
+		for i := range values[:n-3] {
+			values[i], err = strconv.ParseUint(fields[3+i], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing diskstats of %q: %w", fields[2], err)
+			}
+		}
+
+		stats = append(stats, diskStat{
+			name:             fields[2],
+			readCompleted:    values[0],
+			readMerged:       values[1],
+			readSectors:      values[2],
+			readTimeMs:       values[3],
+			writeCompleted:   values[4],
+			writeMerged:      values[5],
+			writeSectors:     values[6],
+			writeTimeMs:      values[7],
+			ioInProgress:     values[8],
+			ioTimeMs:         values[9],
+			ioTimeWeightedMs: values[10],
+			discardCompleted: values[11],
+			discardMerged:    values[12],
+			discardSectors:   values[13],
+			discardTimeMs:    values[14],
+		})
+	}
+
+	return stats, scanner.Err()
+}
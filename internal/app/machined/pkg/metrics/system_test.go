@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// nolint: testpackage
+package metrics
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadDiskStats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	path := filepath.Join(dir, "diskstats")
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`   7       0 loop0 0 0 0 0 0 0 0 0 0 0 0
+ 259       0 nvme0n1 1000 20 30000 400 500 60 7000 800 1 900 1200 10 2 300 40 0 0
+ 259       1 nvme0n1p1 100 0 2000 10 0 0 0 0 0 20 10 0 0 0 0 0 0
+`), 0o600))
+
+	stats, err := readDiskStats(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, []diskStat{
+		{
+			name: "loop0",
+		},
+		{
+			name:             "nvme0n1",
+			readCompleted:    1000,
+			readMerged:       20,
+			readSectors:      30000,
+			readTimeMs:       400,
+			writeCompleted:   500,
+			writeMerged:      60,
+			writeSectors:     7000,
+			writeTimeMs:      800,
+			ioInProgress:     1,
+			ioTimeMs:         900,
+			ioTimeWeightedMs: 1200,
+			discardCompleted: 10,
+			discardMerged:    2,
+			discardSectors:   300,
+			discardTimeMs:    40,
+		},
+		{
+			name:             "nvme0n1p1",
+			readCompleted:    100,
+			readSectors:      2000,
+			readTimeMs:       10,
+			ioTimeMs:         20,
+			ioTimeWeightedMs: 10,
+		},
+	}, stats)
+}
+
+func TestReadDiskStatsFixtures(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		expected []diskStat
+	}{
+		{
+			// kernels before 4.18 don't report the discard statistics
+			name: "diskstats-4.14",
+			expected: []diskStat{
+				{name: "loop0", readCompleted: 58, readSectors: 2152, readTimeMs: 14, ioTimeMs: 28},
+				{
+					name:             "sda",
+					readCompleted:    18213,
+					readMerged:       5302,
+					readSectors:      1325358,
+					readTimeMs:       11640,
+					writeCompleted:   31034,
+					writeMerged:      39751,
+					writeSectors:     1452098,
+					writeTimeMs:      42460,
+					ioTimeMs:         33376,
+					ioTimeWeightedMs: 49800,
+				},
+				{name: "sda1", readCompleted: 180, readSectors: 10442, readTimeMs: 52, writeCompleted: 2, writeSectors: 2, ioTimeMs: 60, ioTimeWeightedMs: 52},
+				{name: "sr0"},
+			},
+		},
+		{
+			// kernels since 5.5 report the flush statistics which are ignored
+			name: "diskstats-5.10",
+			expected: []diskStat{
+				{name: "loop0", readCompleted: 58, readSectors: 2152, readTimeMs: 14, ioTimeMs: 28},
+				{
+					name:             "nvme0n1",
+					readCompleted:    126511,
+					readMerged:       29877,
+					readSectors:      8612762,
+					readTimeMs:       47023,
+					writeCompleted:   270347,
+					writeMerged:      175212,
+					writeSectors:     11946442,
+					writeTimeMs:      334893,
+					ioInProgress:     1,
+					ioTimeMs:         206572,
+					ioTimeWeightedMs: 398212,
+					discardCompleted: 2311,
+					discardSectors:   48029968,
+					discardTimeMs:    1245,
+				},
+				{name: "nvme0n1p1", readCompleted: 312, readMerged: 1207, readSectors: 15166, readTimeMs: 88, writeCompleted: 2, writeSectors: 2, ioTimeMs: 104, ioTimeWeightedMs: 88},
+				{
+					name:             "dm-0",
+					readCompleted:    155938,
+					readSectors:      8590970,
+					readTimeMs:       66692,
+					writeCompleted:   445564,
+					writeSectors:     11946440,
+					writeTimeMs:      1064848,
+					ioTimeMs:         206820,
+					ioTimeWeightedMs: 1131540,
+					discardCompleted: 2311,
+					discardSectors:   48029968,
+					discardTimeMs:    3520,
+				},
+			},
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			stats, err := readDiskStats(filepath.Join("testdata", tt.name))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, stats)
+		})
+	}
+}
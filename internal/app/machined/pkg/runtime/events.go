@@ -11,7 +11,9 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/rs/xid"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
 
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 )
 
@@ -20,6 +22,8 @@ type Event struct {
 	TypeURL string
 	ID      xid.ID
 	Payload proto.Message
+	// BootID is the ID of the boot the event was published in.
+	BootID string
 }
 
 // WatchFunc defines the watcher callback function.
@@ -106,11 +110,47 @@ func (event *Event) ToMachineEvent() (*machine.Event, error) {
 		return nil, err
 	}
 
-	return &machine.Event{
+	msg := &machine.Event{
 		Data: &any.Any{
 			TypeUrl: event.TypeURL,
 			Value:   value,
 		},
 		Id: event.ID.String(),
+	}
+
+	if event.BootID != "" {
+		msg.Metadata = &common.Metadata{
+			BootId: event.BootID,
+		}
+	}
+
+	return msg, nil
+}
+
+// EventFromMachineEvent deserializes Event from proto message machine.Event.
+func EventFromMachineEvent(msg *machine.Event) (Event, error) {
+	id, err := xid.FromString(msg.GetId())
+	if err != nil {
+		return Event{}, fmt.Errorf("error parsing event ID: %w", err)
+	}
+
+	typeURL := msg.GetData().GetTypeUrl()
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByURL(typeURL)
+	if err != nil {
+		return Event{}, fmt.Errorf("unknown event type %q: %w", typeURL, err)
+	}
+
+	payload := mt.New().Interface()
+
+	if err = proto.Unmarshal(msg.GetData().GetValue(), payload); err != nil {
+		return Event{}, err
+	}
+
+	return Event{
+		TypeURL: typeURL,
+		ID:      id,
+		Payload: payload,
+		BootID:  msg.GetMetadata().GetBootId(),
 	}, nil
 }
@@ -4,17 +4,51 @@
 
 package runtime
 
-import "io"
+import (
+	"context"
+	"io"
+	"time"
+)
 
 // LoggingManager provides unified interface to publish and consume logs.
 type LoggingManager interface {
 	ServiceLog(service string) LogHandler
+
+	// SetSenders replaces the list of the senders all the logs are forwarded to.
+	//
+	// Previous senders are closed.
+	SetSenders(senders []LogSender)
+}
+
+// LogEvent is a single log message forwarded to the LogSender.
+type LogEvent struct {
+	// Service is the ID of the service, or "kernel" for the kernel messages.
+	Service string
+	Msg     string
+	Time    time.Time
+
+	// Facility and Severity as defined in RFC5424.
+	Facility int
+	Severity int
+}
+
+// LogSender delivers log events to the remote destination.
+type LogSender interface {
+	// Send the event, Send might block until the event is delivered.
+	//
+	// Send returns an error if the event can't be delivered, the caller is expected to retry.
+	Send(ctx context.Context, event *LogEvent) error
+
+	// Close releases resources associated with the sender.
+	Close() error
 }
 
 // LogOptions for LogHandler.Reader.
 type LogOptions struct {
 	Follow    bool
 	TailLines *int
+	Since     *time.Time
+	Until     *time.Time
 }
 
 // LogOption provides functional options for LogHandler.Reader.
@@ -38,6 +72,24 @@ func WithTailLines(lines int) LogOption {
 	}
 }
 
+// WithSince starts log reading from the lines written at or after the time.
+func WithSince(since time.Time) LogOption {
+	return func(o *LogOptions) error {
+		o.Since = &since
+
+		return nil
+	}
+}
+
+// WithUntil stops log reading at the lines written after the time.
+func WithUntil(until time.Time) LogOption {
+	return func(o *LogOptions) error {
+		o.Until = &until
+
+		return nil
+	}
+}
+
 // LogHandler provides interface to access particular log file.
 type LogHandler interface {
 	Writer() (io.WriteCloser, error)
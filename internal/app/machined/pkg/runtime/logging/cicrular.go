@@ -5,9 +5,11 @@
 package logging
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/pkg/circular"
@@ -25,13 +27,41 @@ const (
 )
 
 // CircularBufferLoggingManager implements logging to circular fixed size buffer.
+//
+// Circular buffers also serve as a buffer for the log forwarding.
 type CircularBufferLoggingManager struct {
 	buffers sync.Map
+
+	forwardKernel bool
+
+	// sendersMu protects senders and creation of the buffers.
+	sendersMu         sync.Mutex
+	senders           []runtime.LogSender
+	sendersConfigured bool
+	sendersCtx        context.Context
+	sendersCancel     context.CancelFunc
+	sendersWg         sync.WaitGroup
+}
+
+// CircularBufferOption configures CircularBufferLoggingManager.
+type CircularBufferOption func(*CircularBufferLoggingManager)
+
+// WithKernelLogForwarding enables forwarding of the kernel messages to the senders.
+func WithKernelLogForwarding() CircularBufferOption {
+	return func(manager *CircularBufferLoggingManager) {
+		manager.forwardKernel = true
+	}
 }
 
 // NewCircularBufferLoggingManager initializes new CircularBufferLoggingManager.
-func NewCircularBufferLoggingManager() *CircularBufferLoggingManager {
-	return &CircularBufferLoggingManager{}
+func NewCircularBufferLoggingManager(options ...CircularBufferOption) *CircularBufferLoggingManager {
+	manager := &CircularBufferLoggingManager{}
+
+	for _, o := range options {
+		o(manager)
+	}
+
+	return manager
 }
 
 // ServiceLog implements runtime.LoggingManager interface.
@@ -42,6 +72,82 @@ func (manager *CircularBufferLoggingManager) ServiceLog(id string) runtime.LogHa
 	}
 }
 
+// SetSenders implements runtime.LoggingManager interface.
+//
+// Logs buffered before the first call to SetSenders are forwarded as well.
+func (manager *CircularBufferLoggingManager) SetSenders(senders []runtime.LogSender) {
+	manager.sendersMu.Lock()
+	defer manager.sendersMu.Unlock()
+
+	if manager.sendersCancel != nil {
+		manager.sendersCancel()
+		manager.sendersWg.Wait()
+
+		manager.sendersCancel = nil
+	}
+
+	for _, sender := range manager.senders {
+		sender.Close() //nolint: errcheck
+	}
+
+	fromStart := !manager.sendersConfigured
+
+	manager.senders = senders
+	manager.sendersConfigured = true
+
+	if len(senders) == 0 {
+		return
+	}
+
+	manager.sendersCtx, manager.sendersCancel = context.WithCancel(context.Background())
+
+	manager.buffers.Range(func(key, value interface{}) bool {
+		manager.startForwarding(key.(string), value.(*circular.Buffer), fromStart)
+
+		return true
+	})
+
+	if manager.forwardKernel {
+		ctx := manager.sendersCtx
+
+		for _, sender := range senders {
+			sender := sender
+
+			manager.sendersWg.Add(1)
+
+			go func() {
+				defer manager.sendersWg.Done()
+
+				forwardKernel(ctx, sender, fromStart)
+			}()
+		}
+	}
+}
+
+// startForwarding should be called with sendersMu held.
+func (manager *CircularBufferLoggingManager) startForwarding(id string, buf *circular.Buffer, fromStart bool) {
+	ctx := manager.sendersCtx
+
+	for _, sender := range manager.senders {
+		sender := sender
+
+		// reader is positioned before the goroutine is started, so that no lines written after this point are skipped
+		r := buf.GetStreamingReader()
+
+		if !fromStart {
+			r.Seek(0, io.SeekEnd) //nolint: errcheck
+		}
+
+		manager.sendersWg.Add(1)
+
+		go func() {
+			defer manager.sendersWg.Done()
+
+			forwardBuffer(ctx, id, r, sender)
+		}()
+	}
+}
+
 func (manager *CircularBufferLoggingManager) getBuffer(id string, create bool) (*circular.Buffer, error) {
 	buf, ok := manager.buffers.Load(id)
 	if !ok {
@@ -57,7 +163,17 @@ func (manager *CircularBufferLoggingManager) getBuffer(id string, create bool) (
 			return nil, err // only configuration issue might raise error
 		}
 
-		buf, _ = manager.buffers.LoadOrStore(id, b)
+		manager.sendersMu.Lock()
+		defer manager.sendersMu.Unlock()
+
+		var loaded bool
+
+		buf, loaded = manager.buffers.LoadOrStore(id, b)
+
+		if !loaded && manager.sendersCancel != nil {
+			// new buffer is empty, so it is forwarded from the start
+			manager.startForwarding(id, b, true)
+		}
 	}
 
 	return buf.(*circular.Buffer), nil
@@ -121,6 +237,8 @@ func (handler *circularHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser
 	var r interface {
 		io.ReadCloser
 		io.Seeker
+		SeekTime(time.Time) (int64, error)
+		LimitTime(time.Time)
 	}
 
 	if opt.Follow {
@@ -129,6 +247,23 @@ func (handler *circularHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser
 		r = handler.buf.GetReader()
 	}
 
+	var sinceOff int64
+
+	if opt.Since != nil {
+		var err error
+
+		sinceOff, err = r.SeekTime(*opt.Since)
+		if err != nil {
+			r.Close() //nolint: errcheck
+
+			return nil, fmt.Errorf("error seeking log: %w", err)
+		}
+	}
+
+	if opt.Until != nil {
+		r.LimitTime(*opt.Until)
+	}
+
 	if opt.TailLines != nil {
 		err := tail.SeekLines(r, *opt.TailLines)
 		if err != nil {
@@ -136,6 +271,16 @@ func (handler *circularHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser
 
 			return nil, fmt.Errorf("error tailing log: %w", err)
 		}
+
+		// tail can't go beyond the lines written before the start time
+		if off, err := r.Seek(0, io.SeekCurrent); err == nil && off < sinceOff {
+			_, err = r.Seek(sinceOff, io.SeekStart)
+			if err != nil {
+				r.Close() //nolint: errcheck
+
+				return nil, fmt.Errorf("error seeking log: %w", err)
+			}
+		}
 	}
 
 	return r, nil
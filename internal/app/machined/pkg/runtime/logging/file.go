@@ -37,6 +37,15 @@ func (manager *FileLoggingManager) ServiceLog(id string) runtime.LogHandler {
 	}
 }
 
+// SetSenders implements runtime.LoggingManager interface.
+//
+// Log forwarding is not supported, senders are closed immediately.
+func (manager *FileLoggingManager) SetSenders(senders []runtime.LogSender) {
+	for _, sender := range senders {
+		sender.Close() //nolint: errcheck
+	}
+}
+
 type fileLogHandler struct {
 	path string
 
@@ -73,6 +82,10 @@ func (handler *fileLogHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser,
 		}
 	}
 
+	if opt.Since != nil || opt.Until != nil {
+		return nil, fmt.Errorf("time bounds are not supported for file logs")
+	}
+
 	if err := handler.buildPath(); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bufio"
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/circular"
+	"github.com/talos-systems/talos/internal/pkg/kmsg"
+)
+
+// Syslog facilities and severities of the forwarded logs.
+const (
+	FacilityKern   = int(kmsg.Kern)
+	FacilityDaemon = int(kmsg.Daemon)
+	SeverityInfo   = int(kmsg.Info)
+)
+
+// KernelLogID is the service ID of the forwarded kernel messages.
+const KernelLogID = "kernel"
+
+// Retry intervals for the failed deliveries.
+const (
+	minRetryInterval = time.Second
+	maxRetryInterval = time.Minute
+)
+
+// send delivers the event retrying with exponential backoff until the context is canceled.
+func send(ctx context.Context, sender runtime.LogSender, event *runtime.LogEvent) error {
+	interval := minRetryInterval
+
+	for {
+		err := sender.Send(ctx, event)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > maxRetryInterval {
+			interval = maxRetryInterval
+		}
+	}
+}
+
+// forwardBuffer sends lines read from the log buffer to the sender until the context is canceled.
+//
+// Lines are read from the buffer independently of the writers, so a slow or unavailable
+// destination never blocks the service: if the reader falls behind too much, the oldest lines
+// are overwritten in the buffer and skipped.
+func forwardBuffer(ctx context.Context, id string, r *circular.StreamingReader, sender runtime.LogSender) {
+	go func() {
+		<-ctx.Done()
+
+		r.Close() //nolint: errcheck
+	}()
+
+	br := bufio.NewReader(r)
+
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			// reader is closed
+			return
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			continue
+		}
+
+		if err = send(ctx, sender, &runtime.LogEvent{
+			Service:  id,
+			Msg:      line,
+			Time:     time.Now(),
+			Facility: FacilityDaemon,
+			Severity: SeverityInfo,
+		}); err != nil {
+			return
+		}
+	}
+}
+
+// forwardKernel sends kernel messages to the sender until the context is canceled.
+func forwardKernel(ctx context.Context, sender runtime.LogSender, fromStart bool) {
+	options := []kmsg.Option{kmsg.Follow()}
+
+	if !fromStart {
+		options = append(options, kmsg.FromTail())
+	}
+
+	reader, err := kmsg.NewReader(options...)
+	if err != nil {
+		log.Printf("error opening /dev/kmsg reader: %s", err)
+
+		return
+	}
+
+	//nolint: errcheck
+	defer reader.Close()
+
+	ch := reader.Scan(ctx)
+
+	for {
+		var (
+			packet kmsg.Packet
+			ok     bool
+		)
+
+		select {
+		case <-ctx.Done():
+			return
+		case packet, ok = <-ch:
+			if !ok {
+				return
+			}
+		}
+
+		// messages written to /dev/kmsg by the userspace (e.g. machined) are forwarded from the service logs
+		if packet.Err != nil || packet.Message.Facility != kmsg.Kern {
+			continue
+		}
+
+		if err = send(ctx, sender, &runtime.LogEvent{
+			Service:  KernelLogID,
+			Msg:      packet.Message.Message,
+			Time:     packet.Message.Timestamp,
+			Facility: FacilityKern,
+			Severity: int(packet.Message.Priority),
+		}); err != nil {
+			return
+		}
+	}
+}
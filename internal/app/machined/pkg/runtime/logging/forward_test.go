@@ -0,0 +1,212 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+type mockSender struct {
+	ch chan *runtime.LogEvent
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func (s *mockSender) Send(ctx context.Context, event *runtime.LogEvent) error {
+	select {
+	case s.ch <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *mockSender) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+
+	return nil
+}
+
+func (s *mockSender) expect(t *testing.T, service, msg string) {
+	select {
+	case event := <-s.ch:
+		assert.Equal(t, service, event.Service)
+		assert.Equal(t, msg, event.Msg)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for %q", msg)
+	}
+}
+
+func TestCircularBufferForwarding(t *testing.T) {
+	manager := logging.NewCircularBufferLoggingManager()
+
+	w, err := manager.ServiceLog("svc1").Writer()
+	require.NoError(t, err)
+
+	_, err = w.Write([]byte("before senders\n"))
+	require.NoError(t, err)
+
+	sender := &mockSender{ch: make(chan *runtime.LogEvent)}
+
+	manager.SetSenders([]runtime.LogSender{sender})
+
+	// logs written before the senders were configured are forwarded as well
+	sender.expect(t, "svc1", "before senders")
+
+	_, err = w.Write([]byte("line 1\nline 2\n"))
+	require.NoError(t, err)
+
+	sender.expect(t, "svc1", "line 1")
+	sender.expect(t, "svc1", "line 2")
+
+	w2, err := manager.ServiceLog("svc2").Writer()
+	require.NoError(t, err)
+
+	_, err = w2.Write([]byte("svc2 line\n"))
+	require.NoError(t, err)
+
+	sender.expect(t, "svc2", "svc2 line")
+
+	sender2 := &mockSender{ch: make(chan *runtime.LogEvent)}
+
+	manager.SetSenders([]runtime.LogSender{sender2})
+
+	sender.mu.Lock()
+	assert.True(t, sender.closed)
+	sender.mu.Unlock()
+
+	// new senders get only new logs
+	_, err = w.Write([]byte("line 3\n"))
+	require.NoError(t, err)
+
+	sender2.expect(t, "svc1", "line 3")
+
+	manager.SetSenders(nil)
+
+	sender2.mu.Lock()
+	assert.True(t, sender2.closed)
+	sender2.mu.Unlock()
+}
+
+func TestSyslogSenderUDP(t *testing.T) {
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer listener.Close() //nolint: errcheck
+
+	sender, err := logging.NewSender(&url.URL{Scheme: "udp", Host: listener.LocalAddr().String()}, "rfc5424")
+	require.NoError(t, err)
+
+	defer sender.Close() //nolint: errcheck
+
+	require.NoError(t, sender.Send(context.Background(), &runtime.LogEvent{
+		Service:  "machined",
+		Msg:      "hello world",
+		Time:     time.Date(2020, 12, 1, 10, 11, 12, 123456000, time.UTC),
+		Facility: logging.FacilityDaemon,
+		Severity: logging.SeverityInfo,
+	}))
+
+	buf := make([]byte, 1024)
+
+	require.NoError(t, listener.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	n, _, err := listener.ReadFrom(buf)
+	require.NoError(t, err)
+
+	msg := string(buf[:n])
+
+	assert.True(t, strings.HasPrefix(msg, "<30>1 2020-12-01T10:11:12.123456Z "), msg)
+	assert.True(t, strings.HasSuffix(msg, " machined - - - hello world"), msg)
+}
+
+func TestJSONLinesSenderTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer listener.Close() //nolint: errcheck
+
+	sender, err := logging.NewSender(&url.URL{Scheme: "tcp", Host: listener.Addr().String()}, "json_lines")
+	require.NoError(t, err)
+
+	defer sender.Close() //nolint: errcheck
+
+	for _, msg := range []string{"first", "second"} {
+		require.NoError(t, sender.Send(context.Background(), &runtime.LogEvent{
+			Service:  "kernel",
+			Msg:      msg,
+			Time:     time.Now(),
+			Facility: logging.FacilityKern,
+			Severity: 4,
+		}))
+	}
+
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+
+	defer conn.Close() //nolint: errcheck
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	scanner := bufio.NewScanner(conn)
+
+	for _, expected := range []string{"first", "second"} {
+		require.True(t, scanner.Scan())
+
+		var event map[string]string
+
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+
+		assert.Equal(t, expected, event["msg"])
+		assert.Equal(t, "kernel", event["talos-service"])
+		assert.Equal(t, "warning", event["talos-level"])
+		assert.Equal(t, "kern", event["talos-facility"])
+	}
+}
+
+func TestNewSender(t *testing.T) {
+	for _, test := range []struct {
+		endpoint string
+		format   string
+		valid    bool
+	}{
+		{"udp://127.0.0.1:514", "rfc5424", true},
+		{"tcp://127.0.0.1:514", "rfc5424", true},
+		{"tls://127.0.0.1:6514", "rfc5424", true},
+		{"tcp://127.0.0.1:5000", "json_lines", true},
+		{"udp://127.0.0.1:5000", "json_lines", false},
+		{"tcp://127.0.0.1:5000", "gelf", false},
+		{"http://127.0.0.1:5000", "rfc5424", false},
+	} {
+		endpoint, err := url.Parse(test.endpoint)
+		require.NoError(t, err)
+
+		_, err = logging.NewSender(endpoint, test.format)
+
+		if test.valid {
+			assert.NoError(t, err, "%s %s", test.endpoint, test.format)
+		} else {
+			assert.Error(t, err, "%s %s", test.endpoint, test.format)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/kmsg"
+)
+
+// jsonLinesSender sends logs as JSON objects separated by newlines.
+type jsonLinesSender struct {
+	conn *conn
+}
+
+type jsonLinesEvent struct {
+	Msg      string `json:"msg"`
+	Time     string `json:"talos-time"`
+	Service  string `json:"talos-service"`
+	Level    string `json:"talos-level"`
+	Facility string `json:"talos-facility"`
+	Hostname string `json:"hostname,omitempty"`
+}
+
+// Send implements runtime.LogSender interface.
+func (s *jsonLinesSender) Send(ctx context.Context, event *runtime.LogEvent) error {
+	b, err := formatJSONLine(event)
+	if err != nil {
+		return err
+	}
+
+	return s.conn.write(ctx, b)
+}
+
+// Close implements runtime.LogSender interface.
+func (s *jsonLinesSender) Close() error {
+	return s.conn.Close()
+}
+
+// formatJSONLine formats the event as a single line of JSON terminated with a newline.
+func formatJSONLine(event *runtime.LogEvent) ([]byte, error) {
+	hostname, _ := os.Hostname() //nolint: errcheck
+
+	b, err := json.Marshal(&jsonLinesEvent{
+		Msg:      event.Msg,
+		Time:     event.Time.UTC().Format(time.RFC3339Nano),
+		Service:  event.Service,
+		Level:    kmsg.Priority(event.Severity).String(),
+		Facility: kmsg.Facility(event.Facility).String(),
+		Hostname: hostname,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, '\n'), nil
+}
@@ -24,6 +24,15 @@ func (manager *NullLoggingManager) ServiceLog(id string) runtime.LogHandler {
 	return &nullLogHandler{}
 }
 
+// SetSenders implements LoggingManager.
+//
+// Log forwarding is not supported, senders are closed immediately.
+func (manager *NullLoggingManager) SetSenders(senders []runtime.LogSender) {
+	for _, sender := range senders {
+		sender.Close() //nolint: errcheck
+	}
+}
+
 type nullLogHandler struct{}
 
 func (handler *nullLogHandler) Writer() (io.WriteCloser, error) {
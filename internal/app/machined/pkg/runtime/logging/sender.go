@@ -0,0 +1,163 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// Timeouts for the connections to the log destinations.
+const (
+	dialTimeout  = 10 * time.Second
+	writeTimeout = 10 * time.Second
+)
+
+// NewSenders creates log senders for the destinations.
+func NewSenders(destinations []config.LoggingDestination) ([]runtime.LogSender, error) {
+	senders := make([]runtime.LogSender, 0, len(destinations))
+
+	for _, destination := range destinations {
+		sender, err := NewSender(destination.Endpoint(), destination.Format())
+		if err != nil {
+			for _, s := range senders {
+				s.Close() //nolint: errcheck
+			}
+
+			return nil, err
+		}
+
+		senders = append(senders, sender)
+	}
+
+	return senders, nil
+}
+
+// NewSender creates log sender for the endpoint and the format.
+//
+// Connection to the endpoint is established on the first Send, and re-established
+// after any write error.
+func NewSender(endpoint *url.URL, format string) (runtime.LogSender, error) {
+	if endpoint == nil {
+		return nil, fmt.Errorf("logging endpoint is not set")
+	}
+
+	c := &conn{
+		endpoint: endpoint,
+	}
+
+	switch format {
+	case "rfc5424":
+		switch endpoint.Scheme {
+		case "udp":
+			return &syslogSender{conn: c}, nil
+		case "tcp", "tls":
+			return &syslogSender{conn: c, octetCounting: true}, nil
+		}
+	case "json_lines":
+		if endpoint.Scheme == "tcp" {
+			return &jsonLinesSender{conn: c}, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported logging format %q", format)
+	}
+
+	return nil, fmt.Errorf("unsupported scheme %q for logging format %q", endpoint.Scheme, format)
+}
+
+// conn is a connection to the log destination which is re-established on failure.
+type conn struct {
+	endpoint *url.URL
+
+	mu sync.Mutex
+	c  net.Conn
+}
+
+func (c *conn) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{
+		Timeout: dialTimeout,
+	}
+
+	switch c.endpoint.Scheme {
+	case "tls":
+		rawConn, err := dialer.DialContext(ctx, "tcp", c.endpoint.Host)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConn := tls.Client(rawConn, &tls.Config{
+			ServerName: c.endpoint.Hostname(),
+		})
+
+		if err = tlsConn.SetDeadline(time.Now().Add(dialTimeout)); err == nil {
+			err = tlsConn.Handshake()
+		}
+
+		if err == nil {
+			err = tlsConn.SetDeadline(time.Time{})
+		}
+
+		if err != nil {
+			rawConn.Close() //nolint: errcheck
+
+			return nil, err
+		}
+
+		return tlsConn, nil
+	default:
+		return dialer.DialContext(ctx, c.endpoint.Scheme, c.endpoint.Host)
+	}
+}
+
+// write sends the data, a new connection is established if needed.
+func (c *conn) write(ctx context.Context, b []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.c == nil {
+		var err error
+
+		c.c, err = c.dial(ctx)
+		if err != nil {
+			return fmt.Errorf("error connecting to %q: %w", c.endpoint, err)
+		}
+	}
+
+	err := c.c.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err == nil {
+		_, err = c.c.Write(b)
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	c.c.Close() //nolint: errcheck
+	c.c = nil
+
+	return fmt.Errorf("error sending logs to %q: %w", c.endpoint, err)
+}
+
+func (c *conn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.c == nil {
+		return nil
+	}
+
+	err := c.c.Close()
+	c.c = nil
+
+	return err
+}
@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+// syslogTimestampFormat is the RFC5424 timestamp with microsecond precision.
+const syslogTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+// syslogSender sends logs as RFC5424 syslog messages.
+//
+// Over stream transports messages are framed with octet counting (RFC6587).
+type syslogSender struct {
+	conn          *conn
+	octetCounting bool
+}
+
+// Send implements runtime.LogSender interface.
+func (s *syslogSender) Send(ctx context.Context, event *runtime.LogEvent) error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "-"
+	}
+
+	msg := formatRFC5424(event, hostname)
+
+	if s.octetCounting {
+		msg = fmt.Sprintf("%d %s", len(msg), msg)
+	}
+
+	return s.conn.write(ctx, []byte(msg))
+}
+
+// Close implements runtime.LogSender interface.
+func (s *syslogSender) Close() error {
+	return s.conn.Close()
+}
+
+// formatRFC5424 formats the event as RFC5424 syslog message.
+func formatRFC5424(event *runtime.LogEvent, hostname string) string {
+	return fmt.Sprintf("<%d>1 %s %s %s - - - %s",
+		event.Facility*8+event.Severity,
+		event.Time.UTC().Format(syslogTimestampFormat),
+		syslogHeaderField(hostname, 255),
+		syslogHeaderField(event.Service, 48),
+		event.Msg,
+	)
+}
+
+// syslogHeaderField sanitizes header field value: it should be printable US-ASCII without spaces.
+func syslogHeaderField(s string, maxLen int) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+
+		return r
+	}, s)
+
+	if s == "" {
+		return "-"
+	}
+
+	if len(s) > maxLen {
+		s = s[:maxLen]
+	}
+
+	return s
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"unicode/utf8"
 
 	"github.com/talos-systems/go-blockdevice/blockdevice/probe"
 
@@ -44,6 +45,8 @@ const (
 	AdvReserved3
 	// AdvUpgrade is the upgrade tag.
 	AdvUpgrade
+	// AdvRollbackReason is the reason of the last upgrade rollback tag.
+	AdvRollbackReason
 )
 
 // Meta represents the meta reader.
@@ -131,6 +134,42 @@ func (m *Meta) Revert() (err error) {
 	return nil
 }
 
+// RevertWithReason reverts the default bootloader label to the previous installation
+// recording the reason of the rollback.
+//
+// The reason is recorded only if there is an installation to revert to.
+func (m *Meta) RevertWithReason(reason string) error {
+	if _, ok := m.ReadTag(AdvUpgrade); !ok {
+		return nil
+	}
+
+	m.DeleteTag(AdvRollbackReason)
+
+	if ok := m.SetTag(AdvRollbackReason, TruncateTagValue(reason)); !ok {
+		return fmt.Errorf("failed to record rollback reason")
+	}
+
+	return m.Revert()
+}
+
+// AdvTagMaxLen is the maximum length of the tag value in bytes.
+const AdvTagMaxLen = 255
+
+// TruncateTagValue truncates the value to AdvTagMaxLen bytes without splitting a multi-byte UTF-8 character.
+func TruncateTagValue(val string) string {
+	if len(val) <= AdvTagMaxLen {
+		return val
+	}
+
+	i := AdvTagMaxLen
+
+	for i > 0 && !utf8.RuneStart(val[i]) {
+		i--
+	}
+
+	return val[:i]
+}
+
 // NewADV returns the Auxiliary Data Vector.
 func NewADV(r io.ReadSeeker) (adv ADV, err error) {
 	_, err = r.Seek(-2*AdvSize, io.SeekEnd)
@@ -187,7 +226,7 @@ func (a ADV) ReadTag(t uint8) (val string, ok bool) {
 func (a ADV) SetTag(t uint8, val string) (ok bool) {
 	b := []byte(val)
 
-	if len(b) > 255 {
+	if len(b) > AdvTagMaxLen {
 		return false
 	}
 
@@ -206,13 +245,16 @@ func (a ADV) SetTag(t uint8, val string) (ok bool) {
 			continue
 		}
 
-		length := uint8(len(b))
+		// tag should fit before the tail leaving the space for the end tag
+		if i+2+len(b) > AdvSize-4-2 {
+			break
+		}
 
 		a[i] = t
 
-		a[i+1] = length
+		a[i+1] = uint8(len(b))
 
-		copy(a[i+2:uint8(i+2)+length], b)
+		copy(a[i+2:i+2+len(b)], b)
 
 		ok = true
 
@@ -247,28 +289,23 @@ func (a ADV) DeleteTag(t uint8) (ok bool) {
 			continue
 		}
 
-		// Save the data after the tag that we will shift to the left by 2 + length
+		// Shift the data after the tag up to the tail to the left by 2 + length
 		// of the tag data.
 		start := i + 2 + size
 
-		end := a[AdvSize-4]
-
-		data := make([]byte, len(a[start:end]))
+		end := AdvSize - 4
 
-		copy(data, a[start:end])
+		if start > end {
+			start = end
+		}
 
-		// The total size we want to zero out is the length of all the remaining
-		// data we saved above.
-		length := 2 + len(data)
+		n := copy(a[i:end], a[start:end])
 
-		// Zero each element to the right.
-		for j := i; j < length; j++ {
+		// Zero the space freed at the end.
+		for j := i + n; j < end; j++ {
 			a[j] = 0
 		}
 
-		// Shift the data.
-		copy(a[i:], data)
-
 		ok = true
 
 		break
@@ -10,7 +10,9 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
+	"unicode/utf8"
 )
 
 func TestNewADV(t *testing.T) {
@@ -322,3 +324,103 @@ func TestADV_tail(t *testing.T) {
 		})
 	}
 }
+
+func TestTruncateTagValue(t *testing.T) {
+	tests := []struct {
+		name string
+		val  string
+		want string
+	}{
+		{
+			name: "short",
+			val:  "node didn't boot",
+			want: "node didn't boot",
+		},
+		{
+			name: "max",
+			val:  strings.Repeat("a", AdvTagMaxLen),
+			want: strings.Repeat("a", AdvTagMaxLen),
+		},
+		{
+			name: "long",
+			val:  strings.Repeat("a", AdvTagMaxLen+10),
+			want: strings.Repeat("a", AdvTagMaxLen),
+		},
+		{
+			name: "multi-byte character on the boundary",
+			val:  strings.Repeat("a", AdvTagMaxLen-1) + "й" + "a",
+			want: strings.Repeat("a", AdvTagMaxLen-1),
+		},
+		{
+			name: "multi-byte character after the boundary",
+			val:  strings.Repeat("a", AdvTagMaxLen-2) + "й" + "a",
+			want: strings.Repeat("a", AdvTagMaxLen-2) + "й",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateTagValue(tt.val)
+
+			if got != tt.want {
+				t.Errorf("TruncateTagValue() = %q, want %q", got, tt.want)
+			}
+
+			if !utf8.ValidString(got) {
+				t.Errorf("TruncateTagValue() = %q is not valid UTF-8", got)
+			}
+
+			if ok := ADV(make([]byte, AdvSize*2)).SetTag(AdvRollbackReason, got); !ok {
+				t.Errorf("SetTag() failed for %q", got)
+			}
+		})
+	}
+}
+
+func TestADV_LongTag(t *testing.T) {
+	a := ADV(make([]byte, 2*AdvSize))
+	a.cleanup()
+
+	reason := strings.Repeat("r", AdvTagMaxLen)
+
+	if ok := a.SetTag(AdvUpgrade, "A"); !ok {
+		t.Fatal("ADV.SetTag() failed")
+	}
+
+	if ok := a.SetTag(AdvRollbackReason, reason); !ok {
+		t.Fatal("ADV.SetTag() failed")
+	}
+
+	// no space left for another tag of the maximum length
+	if ok := a.SetTag(AdvBootonce, reason); ok {
+		t.Error("ADV.SetTag() = true, want false")
+	}
+
+	if val, ok := a.ReadTag(AdvRollbackReason); !ok || val != reason {
+		t.Errorf("ADV.ReadTag() = %q, %v, want %q, true", val, ok, reason)
+	}
+
+	if ok := a.DeleteTag(AdvUpgrade); !ok {
+		t.Fatal("ADV.DeleteTag() failed")
+	}
+
+	if val, ok := a.ReadTag(AdvRollbackReason); !ok || val != reason {
+		t.Errorf("ADV.ReadTag() = %q, %v, want %q, true", val, ok, reason)
+	}
+
+	if ok := a.DeleteTag(AdvRollbackReason); !ok {
+		t.Fatal("ADV.DeleteTag() failed")
+	}
+
+	if _, ok := a.ReadTag(AdvRollbackReason); ok {
+		t.Error("ADV.ReadTag() = true after the tag was deleted")
+	}
+
+	if !bytes.Equal(a[8:AdvSize-4], make([]byte, AdvSize-12)) {
+		t.Error("ADV data is not zeroed after all the tags were deleted")
+	}
+
+	if !bytes.Equal(a[:AdvSize], a[AdvSize:]) {
+		t.Error("ADV copies don't match")
+	}
+}
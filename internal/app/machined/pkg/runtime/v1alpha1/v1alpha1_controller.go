@@ -19,6 +19,7 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/talos-systems/talos/internal/app/machined/pkg/metrics"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/acpi"
@@ -64,7 +65,14 @@ func NewController(b []byte) (*Controller, error) {
 	// TODO: this should be streaming capacity and probably some constant
 	e := NewEvents(1000, 10)
 
-	l := logging.NewCircularBufferLoggingManager()
+	var loggingOptions []logging.CircularBufferOption
+
+	// in container mode /dev/kmsg belongs to the host
+	if s.Platform().Mode() != runtime.ModeContainer {
+		loggingOptions = append(loggingOptions, logging.WithKernelLogForwarding())
+	}
+
+	l := logging.NewCircularBufferLoggingManager(loggingOptions...)
 
 	ctlr := &Controller{
 		r: NewRuntime(cfg, s, e, l),
@@ -262,6 +270,8 @@ func (c *Controller) run(seq runtime.Sequence, phases []runtime.Phase, data inte
 }
 
 func (c *Controller) runPhase(phase runtime.Phase, seq runtime.Sequence, data interface{}) error {
+	start := time.Now()
+
 	c.Runtime().Events().Publish(&machine.PhaseEvent{
 		Phase:  phase.Name,
 		Action: machine.PhaseEvent_START,
@@ -293,7 +303,11 @@ func (c *Controller) runPhase(phase runtime.Phase, seq runtime.Sequence, data in
 		})
 	}
 
-	return eg.Wait()
+	err := eg.Wait()
+
+	metrics.ObservePhase(seq.String(), phase.Name, time.Since(start), err)
+
+	return err
 }
 
 func (c *Controller) runTask(progress string, f runtime.TaskSetupFunc, seq runtime.Sequence, data interface{}) error {
@@ -7,7 +7,9 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -74,7 +76,12 @@ type Events struct {
 	// gap is a safety gap between consumers and publishers
 	gap int
 
-	// mutext protects access to writePos and stream
+	// bootID is the ID of the current boot
+	bootID string
+	// history is the list of events of the previous boots loaded from the event log
+	history []runtime.Event
+
+	// mutext protects access to writePos, stream and history
 	mu sync.Mutex
 	c  *sync.Cond
 }
@@ -91,6 +98,10 @@ func NewEvents(cap, gap int) *Events {
 		gap:    gap,
 	}
 
+	if bootID, err := ioutil.ReadFile("/proc/sys/kernel/random/boot_id"); err == nil {
+		e.bootID = strings.TrimSpace(string(bootID))
+	}
+
 	if gap >= cap {
 		// we should never reach this, but if we do, panic so that we know.
 		panic("NewEvents: gap >= cap")
@@ -135,12 +146,26 @@ func (e *Events) Watch(f runtime.WatchFunc, opt ...runtime.WatchOptionFunc) erro
 		minPos = 0
 	}
 
+	// history of the previous boots is served before the events of the current boot,
+	// events of the current boot which were overwritten in the stream are skipped
+	var history []runtime.Event
+
 	// calculate initial position based on options
 	switch {
 	case opts.TailEvents != 0:
 		if opts.TailEvents < 0 {
+			history = e.history
+
 			pos = minPos
 		} else {
+			if tail := int64(opts.TailEvents) - (pos - minPos); tail > 0 && len(e.history) > 0 {
+				if tail > int64(len(e.history)) {
+					tail = int64(len(e.history))
+				}
+
+				history = e.history[int64(len(e.history))-tail:]
+			}
+
 			pos -= int64(opts.TailEvents)
 
 			if pos < minPos {
@@ -148,6 +173,10 @@ func (e *Events) Watch(f runtime.WatchFunc, opt ...runtime.WatchOptionFunc) erro
 			}
 		}
 	case !opts.TailID.IsNil():
+		history = e.history[sort.Search(len(e.history), func(i int) bool {
+			return e.history[i].ID.Compare(opts.TailID) > 0
+		}):]
+
 		pos = minPos + int64(sort.Search(int(pos-minPos), func(i int) bool {
 			event := e.stream[(minPos+int64(i))%int64(e.cap)]
 
@@ -156,6 +185,10 @@ func (e *Events) Watch(f runtime.WatchFunc, opt ...runtime.WatchOptionFunc) erro
 	case opts.TailDuration != 0:
 		timestamp := time.Now().Add(-opts.TailDuration)
 
+		history = e.history[sort.Search(len(e.history), func(i int) bool {
+			return e.history[i].ID.Time().After(timestamp)
+		}):]
+
 		pos = minPos + int64(sort.Search(int(pos-minPos), func(i int) bool {
 			event := e.stream[(minPos+int64(i))%int64(e.cap)]
 
@@ -168,6 +201,14 @@ func (e *Events) Watch(f runtime.WatchFunc, opt ...runtime.WatchOptionFunc) erro
 	go func() {
 		defer close(ch)
 
+		for _, event := range history {
+			select {
+			case ch <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
 		for {
 			e.mu.Lock()
 			// while there's no data to consume (pos == e.writePos), wait for Condition variable signal,
@@ -219,6 +260,7 @@ func (e *Events) Publish(msg proto.Message) {
 		TypeURL: fmt.Sprintf("talos/runtime/%s", msg.ProtoReflect().Descriptor().FullName()),
 		Payload: msg,
 		ID:      xid.New(),
+		BootID:  e.bootID,
 	}
 
 	e.mu.Lock()
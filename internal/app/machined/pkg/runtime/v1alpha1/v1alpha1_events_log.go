@@ -0,0 +1,177 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// EventLog persists runtime events on disk.
+//
+// Events are appended to the log file as length-delimited machine.Event messages.
+// When the log file grows over the maximum size, it is rotated to the file with `.1`
+// suffix, so the log keeps at most two files.
+type EventLog struct {
+	path    string
+	maxSize int64
+
+	mu sync.Mutex
+}
+
+// NewEventLog initializes the event log at the path.
+func NewEventLog(path string, maxSize int64) *EventLog {
+	return &EventLog{
+		path:    path,
+		maxSize: maxSize,
+	}
+}
+
+// Load reads the events from the log.
+//
+// Truncated records and events of unknown types are skipped.
+func (l *EventLog) Load() ([]runtime.Event, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var events []runtime.Event
+
+	for _, path := range []string{l.path + ".1", l.path} {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		for len(data) > 0 {
+			record, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				// last record might be partially written
+				break
+			}
+
+			data = data[n:]
+
+			var msg machine.Event
+
+			if err = proto.Unmarshal(record, &msg); err != nil {
+				continue
+			}
+
+			event, err := runtime.EventFromMachineEvent(&msg)
+			if err != nil {
+				continue
+			}
+
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+// Append writes the event to the log.
+//
+// The file is opened for each event, so that the log doesn't keep the partition busy.
+func (l *EventLog) Append(event runtime.Event) error {
+	msg, err := event.ToMachineEvent()
+	if err != nil {
+		return err
+	}
+
+	record, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err = l.rotate(); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(protowire.AppendBytes(nil, record)); err != nil {
+		f.Close() //nolint: errcheck
+
+		return err
+	}
+
+	return f.Close()
+}
+
+func (l *EventLog) rotate() error {
+	st, err := os.Stat(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if st.Size() < l.maxSize {
+		return nil
+	}
+
+	if err = os.Rename(l.path, l.path+".1"); err != nil {
+		return fmt.Errorf("error rotating event log: %w", err)
+	}
+
+	return nil
+}
+
+// Persist loads the events of the previous boots from the log, and starts appending
+// new events to the log.
+func (e *Events) Persist(eventLog *EventLog) error {
+	if err := os.MkdirAll(filepath.Dir(eventLog.path), 0o700); err != nil {
+		return err
+	}
+
+	history, err := eventLog.Load()
+	if err != nil {
+		return err
+	}
+
+	// start persisting before the history is set, so that the history is not written back to the log
+	if err = e.Watch(func(events <-chan runtime.Event) {
+		failed := false
+
+		for event := range events {
+			if appendErr := eventLog.Append(event); appendErr != nil && !failed {
+				// the STATE partition might be unmounted, so log the error only once
+				failed = true
+
+				log.Printf("failed to persist event: %s", appendErr)
+			}
+		}
+	}, runtime.WithTailEvents(-1)); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	e.history = history
+	e.mu.Unlock()
+
+	return nil
+}
@@ -8,6 +8,9 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -254,6 +257,107 @@ func TestEvents_WatchOptionsTailID(t *testing.T) {
 	}
 }
 
+func TestEvents_Persist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	assert.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	eventLog := NewEventLog(filepath.Join(dir, "events", "events.log"), 1024)
+
+	// waitPersisted waits for the event with the sequence to be written to the log
+	waitPersisted := func(seq int) {
+		for i := 0; i < 100; i++ {
+			events, err := eventLog.Load()
+			assert.NoError(t, err)
+
+			if len(events) > 0 && extractSeq(t, events)[len(events)-1] == seq {
+				return
+			}
+
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		t.Fatalf("events were not persisted")
+	}
+
+	e := NewEvents(100, 10)
+	assert.NoError(t, e.Persist(eventLog))
+
+	for i := 0; i < 10; i++ {
+		e.Publish(&machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	waitPersisted(9)
+
+	// next boot
+	e = NewEvents(100, 10)
+
+	e.Publish(&machine.SequenceEvent{
+		Sequence: "10",
+	})
+
+	assert.NoError(t, e.Persist(eventLog))
+
+	for i := 11; i < 15; i++ {
+		e.Publish(&machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	waitPersisted(14)
+
+	assert.Equal(t, []int(nil), extractSeq(t, receive(t, e, 0)))
+	assert.Equal(t, gen(0, 15), extractSeq(t, receive(t, e, 15, runtime.WithTailEvents(-1))))
+	assert.Equal(t, gen(8, 15), extractSeq(t, receive(t, e, 7, runtime.WithTailEvents(7))))
+	assert.Equal(t, gen(12, 15), extractSeq(t, receive(t, e, 3, runtime.WithTailEvents(3))))
+
+	events := receive(t, e, 15, runtime.WithTailEvents(-1))
+	assert.Equal(t, gen(6, 15), extractSeq(t, receive(t, e, 9, runtime.WithTailID(events[5].ID))))
+
+	// log is rotated, so the oldest events are dropped
+	for i := 15; i < 100; i++ {
+		e.Publish(&machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	waitPersisted(99)
+
+	persisted, err := eventLog.Load()
+	assert.NoError(t, err)
+	assert.Less(t, len(persisted), 100)
+}
+
+func TestEvents_HistoryWrapped(t *testing.T) {
+	previous := NewEvents(100, 10)
+
+	for i := 0; i < 5; i++ {
+		previous.Publish(&machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	e := NewEvents(20, 5)
+	e.history = receive(t, previous, 5, runtime.WithTailEvents(-1))
+
+	// the stream wraps around, only the last 15 events are available
+	for i := 5; i < 35; i++ {
+		e.Publish(&machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	expected := append(gen(0, 5), gen(20, 35)...)
+
+	assert.Equal(t, expected, extractSeq(t, receive(t, e, 20, runtime.WithTailEvents(-1))))
+	assert.Equal(t, expected[3:], extractSeq(t, receive(t, e, 17, runtime.WithTailEvents(17))))
+	assert.Equal(t, expected[2:], extractSeq(t, receive(t, e, 18, runtime.WithTailID(e.history[1].ID))))
+	assert.Equal(t, expected, extractSeq(t, receive(t, e, 20, runtime.WithTailDuration(time.Hour))))
+}
+
 func BenchmarkWatch(b *testing.B) {
 	e := NewEvents(100, 10)
 
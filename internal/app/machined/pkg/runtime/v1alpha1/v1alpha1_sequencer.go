@@ -196,6 +196,14 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 		r.State().Platform().Mode() != runtime.ModeContainer,
 		"mountState",
 		MountStatePartition,
+	).AppendWhen(
+		r.State().Platform().Mode() != runtime.ModeContainer,
+		"events",
+		PersistEvents,
+	).AppendWhen(
+		r.State().Platform().Mode() != runtime.ModeContainer,
+		"pstore",
+		SavePreviousBootKernelLog,
 	).Append(
 		"validateConfig",
 		ValidateConfig,
@@ -205,6 +213,9 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 	).Append(
 		"env",
 		SetUserEnvVars,
+	).Append(
+		"logging",
+		StartLogForwarding,
 	).Append(
 		"containerd",
 		StartContainerd,
@@ -235,6 +246,10 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 		r.State().Platform().Mode() != runtime.ModeContainer,
 		"userDisks",
 		MountUserDisks,
+	).AppendWhen(
+		r.State().Platform().Mode() != runtime.ModeContainer,
+		"userVolumes",
+		MountUserVolumes,
 	).Append(
 		"userSetup",
 		WriteUserFiles,
@@ -255,7 +270,8 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 		"uncordon",
 		UncordonNode,
 	).AppendWhen(
-		r.State().Platform().Mode() != runtime.ModeContainer,
+		// with the boot watchdog enabled, fallback is removed by the watchdog once the boot succeeds
+		r.State().Platform().Mode() != runtime.ModeContainer && r.Config().Machine().Install().BootWatchdog() == nil,
 		"bootloader",
 		UpdateBootloader,
 	)
@@ -418,6 +434,9 @@ func stopAllPhaselist(r runtime.Runtime) PhaseList {
 			"umount",
 			UnmountOverlayFilesystems,
 			UnmountPodMounts,
+		).Append(
+			"stopUserVolumes",
+			StopUserVolumes,
 		).Append(
 			"unmountSystem",
 			UnmountEphemeralPartition,
@@ -20,6 +20,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"text/template"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	installer "github.com/talos-systems/talos/cmd/installer/pkg/install"
 	"github.com/talos-systems/talos/internal/app/machined/internal/install"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/grub"
 	perrors "github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
@@ -45,20 +47,28 @@ import (
 	"github.com/talos-systems/talos/internal/app/maintenance"
 	"github.com/talos-systems/talos/internal/app/networkd/pkg/networkd"
 	"github.com/talos-systems/talos/internal/pkg/containers/cri/containerd"
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/internal/pkg/cri"
+	"github.com/talos-systems/talos/internal/pkg/disk"
+	"github.com/talos-systems/talos/internal/pkg/encryption"
 	"github.com/talos-systems/talos/internal/pkg/etcd"
 	"github.com/talos-systems/talos/internal/pkg/kernel/kspp"
 	"github.com/talos-systems/talos/internal/pkg/kmsg"
 	"github.com/talos-systems/talos/internal/pkg/kubeconfig"
 	"github.com/talos-systems/talos/internal/pkg/mount"
+	"github.com/talos-systems/talos/internal/pkg/pstore"
+	"github.com/talos-systems/talos/internal/pkg/volume"
 	"github.com/talos-systems/talos/pkg/cmd"
 	"github.com/talos-systems/talos/pkg/conditions"
 	"github.com/talos-systems/talos/pkg/images"
 	"github.com/talos-systems/talos/pkg/kubernetes"
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	v1alpha1cfg "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/makefs"
 	"github.com/talos-systems/talos/pkg/sysctl"
 	"github.com/talos-systems/talos/pkg/version"
 )
@@ -179,6 +189,15 @@ func MountPseudoFilesystems(seq runtime.Sequence, data interface{}) (runtime.Tas
 			return err
 		}
 
+		mountpoints, err = mount.PstoreMountPoints()
+		if err != nil {
+			return err
+		}
+
+		if err = mount.Mount(mountpoints); err != nil {
+			logger.Printf("failed to mount pstore, previous boot kernel logs won't be available: %s", err)
+		}
+
 		return nil
 	}, "mountPseudoFilesystems"
 }
@@ -544,7 +563,7 @@ func receiveConfigViaMaintenanceService(ctx context.Context, logger *log.Logger,
 		return nil, fmt.Errorf("failed to create config provider: %w", err)
 	}
 
-	if err = provider.Validate(r.State().Platform().Mode()); err != nil {
+	if err = provider.Validate(r.State().Platform().Mode(), config.WithLocal()); err != nil {
 		return nil, fmt.Errorf("failed to validate config: %w", err)
 	}
 
@@ -559,7 +578,7 @@ func receiveConfigViaMaintenanceService(ctx context.Context, logger *log.Logger,
 // ValidateConfig validates the config.
 func ValidateConfig(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
-		return r.Config().Validate(r.State().Platform().Mode())
+		return r.Config().Validate(r.State().Platform().Mode(), config.WithLocal())
 	}, "validateConfig"
 }
 
@@ -592,6 +611,25 @@ func SetUserEnvVars(seq runtime.Sequence, data interface{}) (runtime.TaskExecuti
 	}, "setUserEnvVars"
 }
 
+// StartLogForwarding represents the task to forward the logs to the configured destinations.
+func StartLogForwarding(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		destinations := r.Config().Machine().Logging().Destinations()
+		if len(destinations) == 0 {
+			return nil
+		}
+
+		senders, err := logging.NewSenders(destinations)
+		if err != nil {
+			return err
+		}
+
+		r.Logging().SetSenders(senders)
+
+		return nil
+	}, "startLogForwarding"
+}
+
 // StartContainerd represents the task to start containerd.
 func StartContainerd(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -663,6 +701,18 @@ func StartAllServices(seq runtime.Sequence, data interface{}) (runtime.TaskExecu
 			)
 		}
 
+		if r.Config().Machine().Metrics().Enabled() {
+			svcs.Load(
+				&services.Metrics{},
+			)
+		}
+
+		for _, svc := range r.Config().Machine().Services() {
+			svcs.Load(
+				services.NewUser(svc),
+			)
+		}
+
 		switch r.Config().Machine().Type() {
 		case machine.TypeInit:
 			svcs.Load(
@@ -723,8 +773,15 @@ func VerifyInstallation(seq runtime.Sequence, data interface{}) (runtime.TaskExe
 			next    string
 		)
 
+		// install disk selector is only resolved at install time, afterwards the disk
+		// holding the system partitions is used
+		systemDisk := r.State().Machine().Disk()
+		if systemDisk == nil {
+			return fmt.Errorf("system disk not found")
+		}
+
 		grub := &grub.Grub{
-			BootDisk: r.Config().Machine().Install().Disk(),
+			BootDisk: systemDisk.BlockDevice.Device().Name(),
 		}
 
 		current, next, err = grub.Labels()
@@ -805,6 +862,11 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) (err error)
 	}
 
 	for _, disk := range r.Config().Machine().Disks() {
+		// disks without partitions are left as is, e.g. to be used as the volume members
+		if len(disk.Partitions()) == 0 {
+			continue
+		}
+
 		var bd *blockdevice.BlockDevice
 
 		bd, err = blockdevice.Open(disk.Device())
@@ -828,18 +890,40 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) (err error)
 		//  conditions are true:
 		// - a partition table exists AND there are no partitions
 		// - a partition table does not exist
+		// - wipe is enabled AND the existing partitions don't match the configuration
 
 		if pt != nil {
 			if len(pt.Partitions().Items()) > 0 {
-				logger.Printf(("skipping setup of %q, found existing partitions"), disk.Device())
+				if !disk.Wipe() {
+					logger.Printf(("skipping setup of %q, found existing partitions"), disk.Device())
 
-				continue
+					continue
+				}
+
+				existing := make([]string, 0, len(pt.Partitions().Items()))
+
+				for _, item := range pt.Partitions().Items() {
+					existing = append(existing, item.Name)
+				}
+
+				if partitionsMatch(existing, disk.Partitions()) {
+					logger.Printf(("skipping setup of %q, existing partitions match the configuration"), disk.Device())
+
+					continue
+				}
+
+				logger.Printf(("wiping %q, existing partitions don't match the configuration"), disk.Device())
 			}
 		}
 
+		if err = checkPartitionsSize(disk.Device(), disk.Partitions()); err != nil {
+			return err
+		}
+
 		m.Devices[disk.Device()] = installer.Device{
 			Device:              disk.Device(),
 			ResetPartitionTable: true,
+			Zero:                disk.Wipe(),
 		}
 
 		if m.Targets[disk.Device()] == nil {
@@ -849,10 +933,11 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) (err error)
 		for _, part := range disk.Partitions() {
 			extraTarget := &installer.Target{
 				Device:         disk.Device(),
+				Label:          part.Label(),
 				Size:           part.Size(),
 				Force:          true,
 				PartitionType:  installer.LinuxFilesystemData,
-				FileSystemType: installer.FilesystemTypeXFS,
+				FileSystemType: part.Filesystem(),
 			}
 
 			m.Targets[disk.Device()] = append(m.Targets[disk.Device()], extraTarget)
@@ -866,11 +951,49 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) (err error)
 	return nil
 }
 
+// partitionsMatch checks whether the existing partitions match the configured ones
+// by the number of partitions and by the labels (if set).
+//
+// The contents of the partitions are not compared: partitions might be formatted
+// or used by the workloads (e.g. unformatted partitions used by CSI drivers or Ceph),
+// and the disk with the matching layout should never be wiped.
+func partitionsMatch(existing []string, partitions []config.Partition) bool {
+	if len(existing) != len(partitions) {
+		return false
+	}
+
+	for i, part := range partitions {
+		if part.Label() != "" && existing[i] != part.Label() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkPartitionsSize checks that the configured partitions fit the disk along with the partition table.
+func checkPartitionsSize(device string, partitions []config.Partition) error {
+	diskSize, err := disk.Size(device)
+	if err != nil {
+		return err
+	}
+
+	if requiredSize := config.RequiredDiskSize(partitions); requiredSize > diskSize {
+		return fmt.Errorf("partitions for disk %q require %d bytes, but the disk size is %d bytes", device, requiredSize, diskSize)
+	}
+
+	return nil
+}
+
 func mountDisks(r runtime.Runtime) (err error) {
 	mountpoints := mount.NewMountPoints()
 
 	for _, disk := range r.Config().Machine().Disks() {
 		for i, part := range disk.Partitions() {
+			if part.Filesystem() == installer.FilesystemTypeNone || part.MountPoint() == "" {
+				continue
+			}
+
 			var partname string
 
 			partname, err = util.PartPath(disk.Device(), i+1)
@@ -884,7 +1007,13 @@ func mountDisks(r runtime.Runtime) (err error) {
 				}
 			}
 
-			mountpoints.Set(partname, mount.NewMountPoint(partname, part.MountPoint(), "xfs", unix.MS_NOATIME, ""))
+			flags, data := uintptr(unix.MS_NOATIME), ""
+
+			if len(part.MountOptions()) > 0 {
+				flags, data = mount.ParseOptions(part.MountOptions())
+			}
+
+			mountpoints.Set(partname, mount.NewMountPoint(partname, part.MountPoint(), part.Filesystem(), flags, data))
 		}
 	}
 
@@ -895,6 +1024,236 @@ func mountDisks(r runtime.Runtime) (err error) {
 	return nil
 }
 
+// MountUserVolumes represents the MountUserVolumes task.
+func MountUserVolumes(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		volumes := r.Config().Machine().Volumes()
+		if len(volumes) == 0 {
+			return nil
+		}
+
+		mountpoints := mount.NewMountPoints()
+
+		type extendedVolume struct {
+			config.Volume
+			device string
+		}
+
+		var extended []extendedVolume
+
+		for _, v := range volumes {
+			var result *volume.Result
+
+			if result, err = volume.Assemble(v); err != nil {
+				return fmt.Errorf("error assembling volume %q: %w", v.Name(), err)
+			}
+
+			if v.Filesystem() == installer.FilesystemTypeNone {
+				continue
+			}
+
+			if err = formatVolume(logger, v, result); err != nil {
+				return fmt.Errorf("error formatting volume %q: %w", v.Name(), err)
+			}
+
+			if v.MountPoint() == "" {
+				continue
+			}
+
+			if err = os.MkdirAll(v.MountPoint(), 0o700); err != nil {
+				return err
+			}
+
+			flags, mountData := uintptr(unix.MS_NOATIME), ""
+
+			if len(v.MountOptions()) > 0 {
+				flags, mountData = mount.ParseOptions(v.MountOptions())
+			}
+
+			mountpoints.Set(result.DeviceName, mount.NewMountPoint(result.DeviceName, v.MountPoint(), v.Filesystem(), flags, mountData))
+
+			if result.Extended {
+				extended = append(extended, extendedVolume{Volume: v, device: result.DeviceName})
+			}
+		}
+
+		if err = mount.Mount(mountpoints); err != nil {
+			return err
+		}
+
+		for _, v := range extended {
+			logger.Printf("growing the filesystem of the extended volume %q", v.Name())
+
+			if v.Filesystem() == installer.FilesystemTypeExt4 {
+				err = makefs.Ext4Grow(v.device)
+			} else {
+				err = makefs.XFSGrow(v.MountPoint())
+			}
+
+			if err != nil {
+				return fmt.Errorf("error growing the filesystem of volume %q: %w", v.Name(), err)
+			}
+		}
+
+		startVolumeMonitor(r, volumes)
+
+		return nil
+	}, "mountUserVolumes"
+}
+
+// StopUserVolumes represents the StopUserVolumes task.
+//
+// The user volumes are unmounted, and the RAID arrays are stopped and the LVM volume groups are deactivated,
+// so that the member disks are released before the node is shut down or reset.
+func StopUserVolumes(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		stopVolumeMonitor()
+
+		if r.Config() == nil {
+			return nil
+		}
+
+		var result *multierror.Error
+
+		for _, v := range r.Config().Machine().Volumes() {
+			if v.MountPoint() != "" {
+				if err = unix.Unmount(v.MountPoint(), 0); err != nil && !errors.Is(err, unix.EINVAL) && !errors.Is(err, unix.ENOENT) {
+					result = multierror.Append(result, fmt.Errorf("error unmounting volume %q: %w", v.Name(), err))
+
+					continue
+				}
+			}
+
+			logger.Printf("deactivating volume %q", v.Name())
+
+			if err = volume.Deactivate(v); err != nil {
+				result = multierror.Append(result, fmt.Errorf("error deactivating volume %q: %w", v.Name(), err))
+			}
+		}
+
+		return result.ErrorOrNil()
+	}, "stopUserVolumes"
+}
+
+// formatVolume formats the volume if it was just created.
+//
+// Existing volumes are never formatted: the volume with the filesystem which is not recognized
+// (or which doesn't match the configuration) is left as is, and the error is returned.
+func formatVolume(logger *log.Logger, v config.Volume, result *volume.Result) error {
+	if !result.Created {
+		_, filesystem, err := disk.Probe(result.DeviceName)
+		if err != nil {
+			return err
+		}
+
+		switch filesystem {
+		case v.Filesystem():
+			return nil
+		case "":
+			return fmt.Errorf("existing volume %q has no recognized filesystem, refusing to format it", result.DeviceName)
+		default:
+			return fmt.Errorf("existing volume %q has filesystem %q, but %q is configured", result.DeviceName, filesystem, v.Filesystem())
+		}
+	}
+
+	logger.Printf("formatting volume %q as %q", v.Name(), v.Filesystem())
+
+	opts := []makefs.Option{makefs.WithForce(true), makefs.WithLabel(v.Label())}
+
+	if v.Filesystem() == installer.FilesystemTypeExt4 {
+		return makefs.Ext4(result.DeviceName, opts...)
+	}
+
+	return makefs.XFS(result.DeviceName, opts...)
+}
+
+var (
+	volumeMonitorMu     sync.Mutex
+	volumeMonitorCancel context.CancelFunc
+)
+
+// startVolumeMonitor starts monitoring the user volumes until stopVolumeMonitor is called.
+func startVolumeMonitor(r runtime.Runtime, volumes []config.Volume) {
+	volumeMonitorMu.Lock()
+	defer volumeMonitorMu.Unlock()
+
+	if volumeMonitorCancel != nil {
+		volumeMonitorCancel()
+	}
+
+	var ctx context.Context
+
+	ctx, volumeMonitorCancel = context.WithCancel(context.Background())
+
+	go monitorVolumes(ctx, r, volumes)
+}
+
+// stopVolumeMonitor stops monitoring the user volumes.
+func stopVolumeMonitor() {
+	volumeMonitorMu.Lock()
+	defer volumeMonitorMu.Unlock()
+
+	if volumeMonitorCancel != nil {
+		volumeMonitorCancel()
+		volumeMonitorCancel = nil
+	}
+}
+
+// monitorVolumes publishes the state of the user volumes and publishes it again whenever it changes.
+func monitorVolumes(ctx context.Context, r runtime.Runtime, volumes []config.Volume) {
+	names := map[string]struct{}{}
+
+	for _, v := range volumes {
+		names[v.Name()] = struct{}{}
+	}
+
+	published := map[string]string{}
+
+	ticker := time.NewTicker(constants.VolumeMonitorInterval)
+	defer ticker.Stop()
+
+	for {
+		list, err := volume.List()
+		if err != nil {
+			log.Printf("failed to list volumes: %s", err)
+		}
+
+		for _, v := range list {
+			if _, ok := names[v.Name]; !ok {
+				continue
+			}
+
+			event := &machineapi.VolumeStateEvent{
+				Name:          v.Name,
+				Type:          v.Type,
+				DeviceName:    v.DeviceName,
+				State:         v.Status(),
+				Degraded:      v.Degraded,
+				FailedMembers: v.FailedMembers(),
+			}
+
+			key := fmt.Sprintf("%s %v", event.State, event.FailedMembers)
+			if published[v.Name] == key {
+				continue
+			}
+
+			if v.Degraded {
+				log.Printf("volume %q is degraded, failed members: %v", v.Name, event.FailedMembers)
+			}
+
+			r.Events().Publish(event)
+
+			published[v.Name] = key
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 // WriteUserFiles represents the WriteUserFiles task.
 //
 // nolint: gocyclo
@@ -1374,6 +1733,8 @@ func Upgrade(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc,
 			install.WithUpgrade(true),
 			install.WithForce(!in.GetPreserve()),
 			install.WithExtraKernelArgs(r.Config().Machine().Install().ExtraKernelArgs()),
+			install.WithEncryptedPartitions(encryptedPartitions(r)),
+			install.WithPullOptions(image.WithVerification(r.Config().Machine().Install().ImageVerification(), r.Events())),
 		)
 		if err != nil {
 			return err
@@ -1523,10 +1884,82 @@ func UnmountEFIPartition(seq runtime.Sequence, data interface{}) (runtime.TaskEx
 // MountStatePartition mounts the system partition.
 func MountStatePartition(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
-		return mount.SystemPartitionMount(constants.StatePartitionLabel, mount.WithSkipIfMounted(true))
+		opts := []mount.Option{mount.WithSkipIfMounted(true)}
+
+		if cfg := systemPartitionEncryption(r, constants.StatePartitionLabel); cfg != nil {
+			opts = append(opts, mount.WithEncryptionConfig(cfg))
+		}
+
+		return mount.SystemPartitionMount(constants.StatePartitionLabel, opts...)
 	}, "mountStatePartition"
 }
 
+// PersistEvents represents the task for persisting runtime events in the event log on the STATE partition.
+func PersistEvents(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+		events, ok := r.Events().(*Events)
+		if !ok {
+			return nil
+		}
+
+		return events.Persist(NewEventLog(constants.EventLogPath, constants.EventLogMaxSize))
+	}, "persistEvents"
+}
+
+// SavePreviousBootKernelLog represents the task for saving the kernel log of the previous boot from the pstore records.
+//
+// The task is best-effort: failures are logged and don't stop the boot.
+func SavePreviousBootKernelLog(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+		if err := savePreviousBootKernelLog(logger, r); err != nil {
+			logger.Printf("failed to save previous boot kernel log: %s", err)
+		}
+
+		return nil
+	}, "savePreviousBootKernelLog"
+}
+
+func savePreviousBootKernelLog(logger *log.Logger, r runtime.Runtime) error {
+	records, err := pstore.ReadRecords(constants.PstoreMountPoint)
+	if err != nil {
+		return fmt.Errorf("error reading pstore records: %w", err)
+	}
+
+	if len(records) == 0 {
+		// saved log is from the boot before the previous one
+		if err = os.Remove(constants.PreviousBootKernelLogPath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	if err = os.MkdirAll(filepath.Dir(constants.PreviousBootKernelLogPath), 0o700); err != nil {
+		return err
+	}
+
+	if err = ioutil.WriteFile(constants.PreviousBootKernelLogPath, pstore.Log(records), 0o600); err != nil {
+		return err
+	}
+
+	crash := &machineapi.KernelCrashEvent{}
+
+	for _, record := range records {
+		if record.Crash() {
+			crash.Reason = record.Reason
+			crash.Records = append(crash.Records, record.Name)
+		}
+	}
+
+	if len(crash.Records) > 0 {
+		logger.Printf("kernel crashed on the previous boot: %s", crash.Reason)
+
+		r.Events().Publish(crash)
+	}
+
+	return pstore.RemoveRecords(constants.PstoreMountPoint, records)
+}
+
 // UnmountStatePartition unmounts the system partition.
 func UnmountStatePartition(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
@@ -1537,7 +1970,13 @@ func UnmountStatePartition(seq runtime.Sequence, data interface{}) (runtime.Task
 // MountEphermeralPartition mounts the ephemeral partition.
 func MountEphermeralPartition(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
-		return mount.SystemPartitionMount(constants.EphemeralPartitionLabel)
+		var opts []mount.Option
+
+		if cfg := systemPartitionEncryption(r, constants.EphemeralPartitionLabel); cfg != nil {
+			opts = append(opts, mount.WithEncryptionConfig(cfg))
+		}
+
+		return mount.SystemPartitionMount(constants.EphemeralPartitionLabel, opts...)
 	}, "mountEphermeralPartition"
 }
 
@@ -1548,6 +1987,43 @@ func UnmountEphemeralPartition(seq runtime.Sequence, data interface{}) (runtime.
 	}, "unmountEphemeralPartition"
 }
 
+// stateNodeIDEncryption is used to open the encrypted STATE partition before the machine
+// configuration is loaded, so the STATE partition supports only the node ID key in slot 0.
+var stateNodeIDEncryption = &v1alpha1cfg.EncryptionConfig{
+	EncryptionProvider: encryption.ProviderLUKS2,
+	EncryptionKeys: []*v1alpha1cfg.EncryptionKey{
+		{
+			KeyNodeID: &v1alpha1cfg.EncryptionKeyNodeID{},
+		},
+	},
+}
+
+// systemPartitionEncryption returns the encryption config of the system partition.
+func systemPartitionEncryption(r runtime.Runtime, label string) config.Encryption {
+	if r.Config() == nil {
+		if label == constants.StatePartitionLabel {
+			return stateNodeIDEncryption
+		}
+
+		return nil
+	}
+
+	return r.Config().Machine().Install().SystemDiskEncryption().Get(label)
+}
+
+// encryptedPartitions returns the labels of the system partitions which should be encrypted.
+func encryptedPartitions(r runtime.Runtime) []string {
+	var labels []string
+
+	for _, label := range []string{constants.StatePartitionLabel, constants.EphemeralPartitionLabel} {
+		if systemPartitionEncryption(r, label) != nil {
+			labels = append(labels, label)
+		}
+	}
+
+	return labels
+}
+
 // Install mounts or installs the system partitions.
 func Install(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -1556,14 +2032,25 @@ func Install(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc,
 			installerImage = images.DefaultInstallerImage
 		}
 
+		var installDisk string
+
+		installDisk, err = disk.InstallDisk(r.Config().Machine().Install())
+		if err != nil {
+			return err
+		}
+
+		logger.Printf("installing to %s", installDisk)
+
 		err = install.RunInstallerContainer(
-			r.Config().Machine().Install().Disk(),
+			installDisk,
 			r.State().Platform().Name(),
 			installerImage,
 			r.Config().Machine().Registries(),
 			install.WithForce(true),
 			install.WithZero(r.Config().Machine().Install().Zero()),
 			install.WithExtraKernelArgs(r.Config().Machine().Install().ExtraKernelArgs()),
+			install.WithEncryptedPartitions(encryptedPartitions(r)),
+			install.WithPullOptions(image.WithVerification(r.Config().Machine().Install().ImageVerification(), r.Events())),
 		)
 		if err != nil {
 			return err
@@ -1667,6 +2154,11 @@ func BootstrapEtcd(seq runtime.Sequence, data interface{}) (runtime.TaskExecutio
 
 		svc := &services.Etcd{Bootstrap: true}
 
+		if in, ok := data.(*machineapi.BootstrapRequest); ok {
+			svc.RecoverFromSnapshot = in.GetRecoverEtcd()
+			svc.RecoverSkipHashCheck = in.GetRecoverSkipHashCheck()
+		}
+
 		if err = system.Services(r).Unload(ctx, svc.ID(r)); err != nil {
 			return err
 		}
@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	v1alpha1cfg "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestPartitionsMatch(t *testing.T) {
+	partitions := []config.Partition{
+		&v1alpha1cfg.DiskPartition{DiskLabel: "data", DiskMountPoint: "/var/mnt/data"},
+		// unformatted partition which might be used by Ceph, e.g. with the LVM2_member signature
+		&v1alpha1cfg.DiskPartition{DiskLabel: "osd", DiskFilesystem: "none"},
+		&v1alpha1cfg.DiskPartition{DiskMountPoint: "/var/mnt/extra"},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		existing []string
+		expected bool
+	}{
+		{
+			name:     "match",
+			existing: []string{"data", "osd", ""},
+			expected: true,
+		},
+		{
+			name:     "unlabeled partition",
+			existing: []string{"data", "osd", "anything"},
+			expected: true,
+		},
+		{
+			name:     "label mismatch",
+			existing: []string{"data", "ceph", ""},
+		},
+		{
+			name:     "fewer partitions",
+			existing: []string{"data", "osd"},
+		},
+		{
+			name:     "more partitions",
+			existing: []string{"data", "osd", "", ""},
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, partitionsMatch(tt.existing, partitions))
+		})
+	}
+}
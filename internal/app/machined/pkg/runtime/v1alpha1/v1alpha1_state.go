@@ -12,6 +12,7 @@ import (
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform"
+	"github.com/talos-systems/talos/internal/pkg/mount"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
@@ -36,7 +37,7 @@ type ClusterState struct {
 func NewState() (s *State, err error) {
 	var dev *probe.ProbedBlockDevice
 
-	dev, err = probe.GetDevWithFileSystemLabel(constants.EphemeralPartitionLabel)
+	dev, err = mount.SystemPartitionDevice(constants.EphemeralPartitionLabel)
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
 			return nil, err
@@ -85,7 +86,7 @@ func (s *MachineState) Disk() *probe.ProbedBlockDevice {
 	if s.disk == nil {
 		var dev *probe.ProbedBlockDevice
 
-		dev, err := probe.GetDevWithFileSystemLabel(constants.EphemeralPartitionLabel)
+		dev, err := mount.SystemPartitionDevice(constants.EphemeralPartitionLabel)
 		if err == nil {
 			s.disk = dev
 		}
@@ -108,7 +109,7 @@ func (s *MachineState) Installed() bool {
 	if s.disk == nil {
 		var dev *probe.ProbedBlockDevice
 
-		dev, err := probe.GetDevWithFileSystemLabel(constants.EphemeralPartitionLabel)
+		dev, err := mount.SystemPartitionDevice(constants.EphemeralPartitionLabel)
 		if err == nil {
 			s.disk = dev
 		}
@@ -0,0 +1,231 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/pkg/mount"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// BootWatchdog rolls back the upgrade if the node doesn't boot successfully after the upgrade.
+type BootWatchdog struct {
+	c *Controller
+
+	timeout  time.Duration
+	services []string
+
+	booted chan struct{}
+}
+
+// StartBootWatchdog reports the rollback recorded on the previous boot and starts the watchdog
+// on the first boot after the upgrade if the watchdog is enabled in the machine configuration.
+//
+// StartBootWatchdog returns nil watchdog if the watchdog is not started.
+func StartBootWatchdog(c *Controller) (*BootWatchdog, error) {
+	r := c.Runtime()
+
+	if r.State().Platform().Mode() == runtime.ModeContainer {
+		return nil, nil
+	}
+
+	enabled := r.Config() != nil && r.Config().Machine().Install().BootWatchdog() != nil
+
+	upgraded, err := prepareBootWatchdog(openBootMeta, r.Events(), enabled)
+	if err != nil || !upgraded {
+		return nil, err
+	}
+
+	w := &BootWatchdog{
+		c:        c,
+		timeout:  r.Config().Machine().Install().BootWatchdog().Timeout(),
+		services: r.Config().Machine().Install().BootWatchdog().Services(),
+		booted:   make(chan struct{}),
+	}
+
+	log.Printf("boot watchdog: waiting %s for the node to boot after the upgrade", w.timeout)
+
+	go w.run()
+
+	return w, nil
+}
+
+// bootMeta is the bootloader metadata used by the watchdog.
+type bootMeta interface {
+	ReadTag(t uint8) (string, bool)
+	DeleteTag(t uint8) bool
+	Write() (int, error)
+	Close() error
+}
+
+func openBootMeta() (bootMeta, error) {
+	meta, err := bootloader.NewMeta()
+	if err != nil {
+		return nil, err
+	}
+
+	return meta, nil
+}
+
+// prepareBootWatchdog publishes and clears the rollback reason recorded on the previous boot.
+//
+// prepareBootWatchdog returns true if the watchdog is enabled and this is the first boot after the upgrade.
+// If it fails, the fallback is removed, as the watchdog which is not started might otherwise roll back
+// the node on one of the next boots.
+func prepareBootWatchdog(open func() (bootMeta, error), events runtime.Publisher, enabled bool) (upgraded bool, err error) {
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		if removeErr := removeFallback(open); removeErr != nil {
+			log.Printf("boot watchdog: failed to remove fallback: %s", removeErr)
+		}
+	}()
+
+	meta, err := open()
+	if err != nil {
+		return false, err
+	}
+	//nolint: errcheck
+	defer meta.Close()
+
+	if reason, ok := meta.ReadTag(bootloader.AdvRollbackReason); ok {
+		log.Printf("upgrade was rolled back on the previous boot: %s", reason)
+
+		events.Publish(&machine.RollbackEvent{
+			Reason: reason,
+		})
+
+		meta.DeleteTag(bootloader.AdvRollbackReason)
+
+		if _, err = meta.Write(); err != nil {
+			return false, err
+		}
+	}
+
+	if !enabled {
+		return false, nil
+	}
+
+	// fallback label is recorded only by the upgrade
+	_, upgraded = meta.ReadTag(bootloader.AdvUpgrade)
+
+	return upgraded, nil
+}
+
+// Booted notifies the watchdog that the boot sequence finished successfully.
+func (w *BootWatchdog) Booted() {
+	if w == nil {
+		return
+	}
+
+	close(w.booted)
+}
+
+func (w *BootWatchdog) run() {
+	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
+	defer cancel()
+
+	if err := w.wait(ctx); err != nil {
+		w.rollback(fmt.Sprintf("boot watchdog: node didn't boot in %s after the upgrade: %s", w.timeout, err))
+
+		return
+	}
+
+	log.Printf("boot watchdog: node booted successfully, removing fallback")
+
+	if err := removeFallback(openBootMeta); err != nil {
+		log.Printf("boot watchdog: failed to remove fallback: %s", err)
+	}
+}
+
+func (w *BootWatchdog) wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("boot sequence didn't finish: %w", ctx.Err())
+	case <-w.booted:
+	}
+
+	// services are loaded by the boot sequence, so services which are not loaded don't run on this node
+	loaded := map[string]struct{}{}
+
+	for _, svc := range system.Services(nil).List() {
+		loaded[svc.AsProto().GetId()] = struct{}{}
+	}
+
+	for _, id := range w.services {
+		if _, ok := loaded[id]; !ok {
+			continue
+		}
+
+		if err := system.WaitForService(system.StateEventUp, id).Wait(ctx); err != nil {
+			return fmt.Errorf("service %q is not healthy: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *BootWatchdog) rollback(reason string) {
+	log.Printf("%s, rolling back", reason)
+
+	if err := revert(reason); err != nil {
+		// rebooting doesn't help if the previous installation can't be booted
+		log.Printf("boot watchdog: failed to revert upgrade: %s", err)
+
+		return
+	}
+
+	if err := w.c.Run(runtime.SequenceReboot, nil, runtime.WithForce()); err != nil {
+		log.Printf("boot watchdog: reboot failed: %s", err)
+	}
+}
+
+func revert(reason string) error {
+	if err := mount.SystemPartitionMount(constants.BootPartitionLabel); err != nil {
+		return fmt.Errorf("error mounting boot partition: %w", err)
+	}
+
+	defer func() {
+		if err := mount.SystemPartitionUnmount(constants.BootPartitionLabel); err != nil {
+			log.Printf("failed unmounting boot partition: %s", err)
+		}
+	}()
+
+	meta, err := bootloader.NewMeta()
+	if err != nil {
+		return err
+	}
+	//nolint: errcheck
+	defer meta.Close()
+
+	return meta.RevertWithReason(reason)
+}
+
+func removeFallback(open func() (bootMeta, error)) error {
+	meta, err := open()
+	if err != nil {
+		return err
+	}
+	//nolint: errcheck
+	defer meta.Close()
+
+	if ok := meta.DeleteTag(bootloader.AdvUpgrade); ok {
+		if _, err = meta.Write(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package v1alpha1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// fakeBootMeta keeps the ADV across the opens, as the META partition does.
+type fakeBootMeta struct {
+	bootloader.ADV
+
+	openErr     error
+	failWrites  int
+	writes      int
+	openedCount int
+}
+
+func newFakeBootMeta(tags map[uint8]string) *fakeBootMeta {
+	m := &fakeBootMeta{
+		ADV: make(bootloader.ADV, 2*bootloader.AdvSize),
+	}
+
+	for tag, val := range tags {
+		m.SetTag(tag, val)
+	}
+
+	return m
+}
+
+func (m *fakeBootMeta) open() (bootMeta, error) {
+	if m.openErr != nil {
+		return nil, m.openErr
+	}
+
+	m.openedCount++
+
+	return m, nil
+}
+
+func (m *fakeBootMeta) Write() (int, error) {
+	if m.failWrites > 0 {
+		m.failWrites--
+
+		return 0, errors.New("write failed")
+	}
+
+	m.writes++
+
+	return len(m.ADV), nil
+}
+
+func (m *fakeBootMeta) Close() error {
+	return nil
+}
+
+type fakePublisher struct {
+	messages []proto.Message
+}
+
+func (p *fakePublisher) Publish(msg proto.Message) {
+	p.messages = append(p.messages, msg)
+}
+
+func TestPrepareBootWatchdog(t *testing.T) {
+	tests := []struct {
+		name    string
+		tags    map[uint8]string
+		enabled bool
+
+		wantUpgraded bool
+		wantFallback bool
+		wantReason   string
+	}{
+		{
+			name:    "no upgrade",
+			enabled: true,
+		},
+		{
+			name:         "upgrade",
+			tags:         map[uint8]string{bootloader.AdvUpgrade: "A"},
+			enabled:      true,
+			wantUpgraded: true,
+			wantFallback: true,
+		},
+		{
+			name:         "disabled",
+			tags:         map[uint8]string{bootloader.AdvUpgrade: "A"},
+			wantFallback: true,
+		},
+		{
+			name:       "rolled back",
+			tags:       map[uint8]string{bootloader.AdvRollbackReason: "node didn't boot"},
+			enabled:    true,
+			wantReason: "node didn't boot",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			meta := newFakeBootMeta(tt.tags)
+			events := &fakePublisher{}
+
+			upgraded, err := prepareBootWatchdog(meta.open, events, tt.enabled)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantUpgraded, upgraded)
+
+			_, ok := meta.ReadTag(bootloader.AdvUpgrade)
+			assert.Equal(t, tt.wantFallback, ok)
+
+			_, ok = meta.ReadTag(bootloader.AdvRollbackReason)
+			assert.False(t, ok)
+
+			if tt.wantReason == "" {
+				assert.Empty(t, events.messages)
+
+				return
+			}
+
+			require.Len(t, events.messages, 1)
+			assert.Equal(t, tt.wantReason, events.messages[0].(*machine.RollbackEvent).GetReason())
+			assert.Equal(t, 1, meta.writes)
+		})
+	}
+}
+
+func TestPrepareBootWatchdogFailure(t *testing.T) {
+	meta := newFakeBootMeta(map[uint8]string{
+		bootloader.AdvUpgrade:        "A",
+		bootloader.AdvRollbackReason: "node didn't boot",
+	})
+	meta.failWrites = 1
+
+	upgraded, err := prepareBootWatchdog(meta.open, &fakePublisher{}, true)
+	require.Error(t, err)
+	assert.False(t, upgraded)
+
+	// watchdog is not started, so the fallback is removed
+	assert.Equal(t, 2, meta.openedCount)
+	assert.Equal(t, 1, meta.writes)
+
+	_, ok := meta.ReadTag(bootloader.AdvUpgrade)
+	assert.False(t, ok)
+
+	meta = newFakeBootMeta(nil)
+	meta.openErr = errors.New("no META partition")
+
+	_, err = prepareBootWatchdog(meta.open, &fakePublisher{}, true)
+	require.Error(t, err)
+}
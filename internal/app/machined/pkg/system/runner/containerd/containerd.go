@@ -236,16 +236,38 @@ func (c *containerdRunner) newContainerOpts(image containerd.Image, specOpts []o
 func (c *containerdRunner) newOCISpecOpts(image oci.Image) []oci.SpecOpts {
 	specOpts := []oci.SpecOpts{
 		oci.WithImageConfig(image),
-		oci.WithProcessArgs(c.args.ProcessArgs...),
 		oci.WithEnv(c.opts.Env),
 		oci.WithHostHostsFile,
 		oci.WithHostResolvconf,
 	}
+
+	// keep the image entrypoint if the process arguments are not set
+	if len(c.args.ProcessArgs) > 0 {
+		specOpts = append(specOpts, oci.WithProcessArgs(c.args.ProcessArgs...))
+	}
+
+	if c.opts.CgroupPath != "" {
+		specOpts = append(specOpts, oci.WithCgroup(c.opts.CgroupPath))
+	}
+
+	if c.opts.MemoryLimit > 0 {
+		specOpts = append(specOpts, oci.WithMemoryLimit(uint64(c.opts.MemoryLimit)))
+	}
+
+	if c.opts.CPUShares > 0 {
+		specOpts = append(specOpts, oci.WithCPUShares(c.opts.CPUShares))
+	}
+
 	specOpts = append(specOpts, c.opts.OCISpecOpts...)
 
 	return specOpts
 }
 
+// CgroupPath implements the runner.CgroupRunner interface.
+func (c *containerdRunner) CgroupPath() string {
+	return c.opts.CgroupPath
+}
+
 func (c *containerdRunner) String() string {
 	return fmt.Sprintf("Containerd(%v)", c.args.ID)
 }
@@ -15,6 +15,7 @@ import (
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/internal/pkg/cgroup"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/proc/reaper"
 )
@@ -117,7 +118,13 @@ func (p *processRunner) run(eventSink events.Recorder) error {
 		defer reaper.Stop(notifyCh)
 	}
 
-	if err = cmd.Start(); err != nil {
+	if p.opts.CgroupPath != "" {
+		err = cgroup.Start(cmd, p.opts.CgroupPath, cgroup.Resources(p.opts.MemoryLimit, p.opts.CPUShares))
+	} else {
+		err = cmd.Start()
+	}
+
+	if err != nil {
 		return fmt.Errorf("error starting process: %w", err)
 	}
 
@@ -159,6 +166,11 @@ func (p *processRunner) run(eventSink events.Recorder) error {
 	return logCloser.Close()
 }
 
+// CgroupPath implements the runner.CgroupRunner interface.
+func (p *processRunner) CgroupPath() string {
+	return p.opts.CgroupPath
+}
+
 func (p *processRunner) String() string {
 	return fmt.Sprintf("Process(%q)", p.args.ProcessArgs)
 }
@@ -173,3 +173,12 @@ func (r *restarter) Close() error {
 func (r *restarter) String() string {
 	return fmt.Sprintf("Restart(%s, %s)", r.opts.Type, r.wrappedRunner)
 }
+
+// CgroupPath implements the runner.CgroupRunner interface.
+func (r *restarter) CgroupPath() string {
+	if cgroupRunner, ok := r.wrappedRunner.(runner.CgroupRunner); ok {
+		return cgroupRunner.CgroupPath()
+	}
+
+	return ""
+}
@@ -28,6 +28,11 @@ type Runner interface {
 	Close() error
 }
 
+// CgroupRunner is implemented by the runners which place the process into the cgroup.
+type CgroupRunner interface {
+	CgroupPath() string
+}
+
 // Args represents the required options for services.
 type Args struct {
 	ID          string
@@ -56,6 +61,13 @@ type Options struct {
 	GracefulShutdownTimeout time.Duration
 	// Stdin is the process standard input.
 	Stdin io.ReadSeeker
+	// CgroupPath is the path of the cgroup the process is placed into,
+	// relative to the cgroup hierarchy root.
+	CgroupPath string
+	// MemoryLimit is the memory limit of the cgroup in bytes, zero means no limit.
+	MemoryLimit int64
+	// CPUShares is the relative CPU weight of the cgroup, zero means default weight.
+	CPUShares uint64
 }
 
 // Option is the functional option func.
@@ -135,3 +147,24 @@ func WithStdin(stdin io.ReadSeeker) Option {
 		args.Stdin = stdin
 	}
 }
+
+// WithCgroupPath sets the path of the cgroup the process is placed into.
+func WithCgroupPath(path string) Option {
+	return func(args *Options) {
+		args.CgroupPath = path
+	}
+}
+
+// WithMemoryLimit sets the memory limit of the cgroup in bytes.
+func WithMemoryLimit(limit int64) Option {
+	return func(args *Options) {
+		args.MemoryLimit = limit
+	}
+}
+
+// WithCPUShares sets the relative CPU weight of the cgroup.
+func WithCPUShares(shares uint64) Option {
+	return func(args *Options) {
+		args.CPUShares = shares
+	}
+}
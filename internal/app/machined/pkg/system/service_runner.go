@@ -15,6 +15,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/health"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/internal/pkg/cgroup"
 	"github.com/talos-systems/talos/pkg/conditions"
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 )
@@ -38,6 +39,8 @@ type ServiceRunner struct {
 
 	healthState health.State
 
+	cgroupPath string
+
 	stateSubscribers map[StateEvent][]chan<- struct{}
 
 	ctxMu     sync.Mutex
@@ -240,6 +243,12 @@ func (svcrunner *ServiceRunner) Start() {
 		return
 	}
 
+	if cgroupRunner, ok := runnr.(runner.CgroupRunner); ok {
+		svcrunner.mu.Lock()
+		svcrunner.cgroupPath = cgroupRunner.CgroupPath()
+		svcrunner.mu.Unlock()
+	}
+
 	if err := svcrunner.run(ctx, runnr); err != nil {
 		svcrunner.UpdateState(events.StateFailed, "Failed running service: %v", err)
 	} else {
@@ -344,14 +353,32 @@ func (svcrunner *ServiceRunner) Shutdown() {
 // AsProto returns protobuf struct with the state of the service runner.
 func (svcrunner *ServiceRunner) AsProto() *machineapi.ServiceInfo {
 	svcrunner.mu.Lock()
-	defer svcrunner.mu.Unlock()
 
-	return &machineapi.ServiceInfo{
+	info := &machineapi.ServiceInfo{
 		Id:     svcrunner.id,
 		State:  svcrunner.state.String(),
 		Events: svcrunner.events.AsProto(events.MaxEventsToKeep),
 		Health: svcrunner.healthState.AsProto(),
 	}
+
+	cgroupPath := svcrunner.cgroupPath
+	running := svcrunner.state == events.StateRunning
+
+	svcrunner.mu.Unlock()
+
+	// resource usage is read outside of the lock, as it reads cgroupfs
+	if cgroupPath != "" && running {
+		if usage, err := cgroup.Stat(cgroupPath); err == nil {
+			info.Resources = &machineapi.ServiceResources{
+				Cgroup:      cgroupPath,
+				MemoryUsage: usage.MemoryUsage,
+				MemoryLimit: usage.MemoryLimit,
+				CpuUsage:    usage.CPUUsage,
+			}
+		}
+	}
+
+	return info
 }
 
 // Subscribe to a specific event for this service.
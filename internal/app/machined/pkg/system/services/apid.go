@@ -25,6 +25,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/talos-systems/talos/internal/pkg/cgroup"
 	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/pkg/conditions"
 	"github.com/talos-systems/talos/pkg/kubernetes"
@@ -75,35 +76,14 @@ func (o *APID) DependsOn(r runtime.Runtime) []string {
 func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 	image := "talos/apid"
 
-	endpoints := []string{"127.0.0.1"}
-
 	// Ensure socket dir exists
 	if err := os.MkdirAll(filepath.Dir(constants.APISocketPath), 0o750); err != nil {
 		return nil, err
 	}
 
-	if r.Config().Machine().Type() == machine.TypeJoin {
-		opts := []retry.Option{retry.WithUnits(3 * time.Second), retry.WithJitter(time.Second)}
-
-		err := retry.Constant(4*time.Minute, opts...).Retry(func() error {
-			ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
-			defer ctxCancel()
-
-			h, err := kubernetes.NewClientFromKubeletKubeconfig()
-			if err != nil {
-				return retry.ExpectedError(fmt.Errorf("failed to create client: %w", err))
-			}
-
-			endpoints, err = h.MasterIPs(ctx)
-			if err != nil {
-				return retry.ExpectedError(err)
-			}
-
-			return nil
-		})
-		if err != nil {
-			return nil, err
-		}
+	endpoints, err := trustdEndpoints(r)
+	if err != nil {
+		return nil, err
 	}
 
 	// Set the process arguments.
@@ -150,6 +130,7 @@ func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 		&args,
 		runner.WithStdin(stdin),
 		runner.WithLoggingManager(r.Logging()),
+		runner.WithCgroupPath(cgroup.Path(o.ID(r))),
 		runner.WithContainerdAddress(constants.SystemContainerdAddress),
 		runner.WithContainerImage(image),
 		runner.WithEnv(env),
@@ -180,3 +161,34 @@ func (o *APID) HealthFunc(runtime.Runtime) health.Check {
 func (o *APID) HealthSettings(runtime.Runtime) *health.Settings {
 	return &health.DefaultSettings
 }
+
+// trustdEndpoints returns the endpoints of trustd which issues the certificates for the node.
+func trustdEndpoints(r runtime.Runtime) ([]string, error) {
+	endpoints := []string{"127.0.0.1"}
+
+	if r.Config().Machine().Type() == machine.TypeJoin {
+		opts := []retry.Option{retry.WithUnits(3 * time.Second), retry.WithJitter(time.Second)}
+
+		err := retry.Constant(4*time.Minute, opts...).Retry(func() error {
+			ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer ctxCancel()
+
+			h, err := kubernetes.NewClientFromKubeletKubeconfig()
+			if err != nil {
+				return retry.ExpectedError(fmt.Errorf("failed to create client: %w", err))
+			}
+
+			endpoints, err = h.MasterIPs(ctx)
+			if err != nil {
+				return retry.ExpectedError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return endpoints, nil
+}
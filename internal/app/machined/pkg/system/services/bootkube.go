@@ -23,6 +23,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
+	"github.com/talos-systems/talos/internal/pkg/cgroup"
 	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/internal/pkg/etcd"
 	"github.com/talos-systems/talos/pkg/conditions"
@@ -187,6 +188,7 @@ func (b *Bootkube) Runner(r runtime.Runtime) (runner.Runner, error) {
 		&args,
 		runner.WithStdin(stdin),
 		runner.WithLoggingManager(r.Logging()),
+		runner.WithCgroupPath(cgroup.Path(b.ID(r))),
 		runner.WithContainerdAddress(constants.SystemContainerdAddress),
 		runner.WithContainerImage(image),
 		runner.WithEnv(env),
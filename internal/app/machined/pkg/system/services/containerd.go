@@ -18,6 +18,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/process"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/talos-systems/talos/internal/pkg/cgroup"
 	"github.com/talos-systems/talos/pkg/conditions"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
@@ -73,6 +74,8 @@ func (c *Containerd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		r.Config().Debug(),
 		args,
 		runner.WithLoggingManager(r.Logging()),
+		runner.WithCgroupPath(cgroup.Path(c.ID(r))),
+		runner.WithCPUShares(constants.SystemContainerdCPUShares),
 		runner.WithEnv(env),
 	),
 		restart.WithType(restart.Forever),
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	stdlibnet "net"
 	"os"
 	goruntime "runtime"
@@ -26,6 +27,8 @@ import (
 	"github.com/talos-systems/go-retry/retry"
 	"github.com/talos-systems/net"
 	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/clientv3/snapshot"
+	"go.uber.org/zap"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
@@ -35,6 +38,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/talos-systems/talos/internal/pkg/cgroup"
 	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/internal/pkg/etcd"
 	"github.com/talos-systems/talos/pkg/argsbuilder"
@@ -46,7 +50,9 @@ import (
 // Etcd implements the Service interface. It serves as the concrete type with
 // the required methods.
 type Etcd struct {
-	Bootstrap bool
+	Bootstrap            bool
+	RecoverFromSnapshot  bool
+	RecoverSkipHashCheck bool
 
 	args []string
 }
@@ -115,6 +121,8 @@ func (e *Etcd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		r.Config().Debug(),
 		&args,
 		runner.WithLoggingManager(r.Logging()),
+		runner.WithCgroupPath(cgroup.Path(e.ID(r))),
+		runner.WithCPUShares(constants.EtcdCPUShares),
 		runner.WithNamespace(constants.SystemContainerdNamespace),
 		runner.WithContainerdAddress(constants.SystemContainerdAddress),
 		runner.WithContainerImage(r.Config().Cluster().Etcd().Image()),
@@ -461,10 +469,18 @@ func (e *Etcd) setup(ctx context.Context, r runtime.Runtime, errCh chan error) {
 
 		// Pull the image and unpack it.
 		containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
-		if _, err = image.Pull(containerdctx, r.Config().Machine().Registries(), client, r.Config().Cluster().Etcd().Image()); err != nil {
+		if _, err = image.Pull(containerdctx, r.Config().Machine().Registries(), client, r.Config().Cluster().Etcd().Image(),
+			image.WithVerification(r.Config().Machine().Install().ImageVerification(), r.Events()),
+		); err != nil {
 			return fmt.Errorf("failed to pull image %q: %w", r.Config().Cluster().Etcd().Image(), err)
 		}
 
+		if e.RecoverFromSnapshot {
+			if err = e.recoverFromSnapshot(); err != nil {
+				return fmt.Errorf("failed to recover etcd from snapshot: %w", err)
+			}
+		}
+
 		switch r.Config().Machine().Type() { //nolint: exhaustive
 		case machine.TypeInit:
 			err = e.argsForInit(ctx, r)
@@ -568,6 +584,63 @@ func (e *Etcd) argsForControlPlane(ctx context.Context, r runtime.Runtime) error
 	return nil
 }
 
+// recoverFromSnapshot restores etcd data directory from the snapshot uploaded
+// via the API.
+//
+// Restored data directory contains single member cluster with this node being
+// the only member, so further members can join as usual.
+func (e *Etcd) recoverFromSnapshot() error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+
+	primaryAddr, _, err := primaryAndListenAddresses()
+	if err != nil {
+		return fmt.Errorf("failed to calculate etcd addresses: %w", err)
+	}
+
+	manager := snapshot.NewV3(zap.NewNop())
+
+	status, err := manager.Status(constants.EtcdRecoverySnapshotPath)
+	if err != nil {
+		return fmt.Errorf("error verifying snapshot: %w", err)
+	}
+
+	log.Printf("recovering etcd from snapshot: hash %08x, revision %d, total keys %d, total size %d\n",
+		status.Hash, status.Revision, status.TotalKey, status.TotalSize)
+
+	// etcd refuses to restore into a non-empty data directory
+	if err = os.RemoveAll(constants.EtcdDataPath); err != nil {
+		return fmt.Errorf("failed to remove %s: %w", constants.EtcdDataPath, err)
+	}
+
+	if err = manager.Restore(snapshot.RestoreConfig{
+		SnapshotPath: constants.EtcdRecoverySnapshotPath,
+
+		Name:          hostname,
+		OutputDataDir: constants.EtcdDataPath,
+
+		PeerURLs: []string{"https://" + net.FormatAddress(primaryAddr) + ":2380"},
+
+		InitialCluster: fmt.Sprintf("%s=https://%s:2380", hostname, net.FormatAddress(primaryAddr)),
+
+		SkipHashCheck: e.RecoverSkipHashCheck,
+	}); err != nil {
+		return fmt.Errorf("error restoring snapshot: %w", err)
+	}
+
+	if err = os.Chmod(constants.EtcdDataPath, 0o700); err != nil {
+		return err
+	}
+
+	if err = os.Remove(constants.EtcdRecoverySnapshotPath); err != nil {
+		return fmt.Errorf("error deleting snapshot: %w", err)
+	}
+
+	return nil
+}
+
 // IsDirEmpty checks if a directory is empty or not.
 func IsDirEmpty(name string) (bool, error) {
 	f, err := os.Open(name)
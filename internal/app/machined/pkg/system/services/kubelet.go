@@ -35,6 +35,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/talos-systems/talos/internal/pkg/cgroup"
 	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/pkg/argsbuilder"
 	"github.com/talos-systems/talos/pkg/conditions"
@@ -115,7 +116,9 @@ func (k *Kubelet) PreFunc(ctx context.Context, r runtime.Runtime) error {
 	// Pull the image and unpack it.
 	containerdctx := namespaces.WithNamespace(ctx, "k8s.io")
 
-	_, err = image.Pull(containerdctx, r.Config().Machine().Registries(), client, r.Config().Machine().Kubelet().Image())
+	_, err = image.Pull(containerdctx, r.Config().Machine().Registries(), client, r.Config().Machine().Kubelet().Image(),
+		image.WithVerification(r.Config().Machine().Install().ImageVerification(), r.Events()),
+	)
 	if err != nil {
 		return err
 	}
@@ -135,11 +138,13 @@ func (k *Kubelet) Condition(r runtime.Runtime) conditions.Condition {
 
 // DependsOn implements the Service interface.
 func (k *Kubelet) DependsOn(r runtime.Runtime) []string {
-	if r.State().Platform().Mode() == runtime.ModeContainer || r.Config().Machine().Time().Disabled() {
-		return []string{"cri", "networkd"}
+	deps := []string{"cri", "networkd"}
+
+	if r.State().Platform().Mode() != runtime.ModeContainer && !r.Config().Machine().Time().Disabled() {
+		deps = append(deps, "timed")
 	}
 
-	return []string{"cri", "networkd", "timed"}
+	return append(deps, userServicesBefore(r, k.ID(r))...)
 }
 
 // Runner implements the Service interface.
@@ -185,6 +190,7 @@ func (k *Kubelet) Runner(r runtime.Runtime) (runner.Runner, error) {
 		r.Config().Debug(),
 		&args,
 		runner.WithLoggingManager(r.Logging()),
+		runner.WithCgroupPath(cgroup.Path(k.ID(r))),
 		runner.WithNamespace(criconstants.K8sContainerdNamespace),
 		runner.WithContainerImage(r.Config().Machine().Kubelet().Image()),
 		runner.WithEnv(env),
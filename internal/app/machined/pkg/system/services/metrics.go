@@ -0,0 +1,124 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// nolint: golint
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/apid/pkg/provider"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/metrics"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/goroutine"
+	"github.com/talos-systems/talos/pkg/conditions"
+)
+
+// Metrics implements the Service interface. It serves as the concrete type with
+// the required methods.
+//
+// Metrics serves Prometheus metrics of the node over HTTPS with mutual TLS.
+type Metrics struct{}
+
+// ID implements the Service interface.
+func (m *Metrics) ID(r runtime.Runtime) string {
+	return "metrics"
+}
+
+// PreFunc implements the Service interface.
+func (m *Metrics) PreFunc(ctx context.Context, r runtime.Runtime) error {
+	return nil
+}
+
+// PostFunc implements the Service interface.
+func (m *Metrics) PostFunc(r runtime.Runtime, state events.ServiceState) (err error) {
+	return nil
+}
+
+// Condition implements the Service interface.
+func (m *Metrics) Condition(r runtime.Runtime) conditions.Condition {
+	return nil
+}
+
+// DependsOn implements the Service interface.
+func (m *Metrics) DependsOn(r runtime.Runtime) []string {
+	return nil
+}
+
+// Runner implements the Service interface.
+func (m *Metrics) Runner(r runtime.Runtime) (runner.Runner, error) {
+	return goroutine.NewRunner(r, "metrics", m.main, runner.WithLoggingManager(r.Logging())), nil
+}
+
+// APIRestartAllowed implements the APIRestartableService interface.
+func (m *Metrics) APIRestartAllowed(r runtime.Runtime) bool {
+	return true
+}
+
+func (m *Metrics) main(ctx context.Context, r runtime.Runtime, logWriter io.Writer) error {
+	logger := log.New(logWriter, "", log.LstdFlags)
+
+	endpoints, err := trustdEndpoints(r)
+	if err != nil {
+		return fmt.Errorf("failed to discover trustd endpoints: %w", err)
+	}
+
+	tlsConfig, err := provider.NewTLSConfig(r.Config(), endpoints)
+	if err != nil {
+		return fmt.Errorf("failed to create TLS config: %w", err)
+	}
+
+	serverTLSConfig, err := tlsConfig.ServerConfig()
+	if err != nil {
+		return fmt.Errorf("failed to create server TLS config: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
+	server := &http.Server{
+		Addr:      net.JoinHostPort("", strconv.Itoa(r.Config().Machine().Metrics().Port())),
+		Handler:   mux,
+		TLSConfig: serverTLSConfig,
+		ErrorLog:  logger,
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		logger.Printf("serving metrics on %s", server.Addr)
+
+		// certificates are provided by the TLS config
+		errCh <- server.ListenAndServeTLS("", "")
+	}()
+
+	select {
+	case err = <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+
+	if err = server.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+
+	if err = <-errCh; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// nolint: golint
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	containerdapi "github.com/containerd/containerd"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/oci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/health"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/talos-systems/talos/internal/pkg/cgroup"
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
+	"github.com/talos-systems/talos/pkg/conditions"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// User implements the Service interface. It serves as the concrete type for
+// the services defined in the machine configuration.
+type User struct {
+	Config config.Service
+}
+
+// UserWithHealthCheck is the user-defined service with the health check.
+type UserWithHealthCheck struct {
+	User
+}
+
+// NewUser creates the user-defined service from the machine configuration.
+func NewUser(cfg config.Service) system.Service {
+	if cfg.HealthCheck() != nil {
+		return &UserWithHealthCheck{User{Config: cfg}}
+	}
+
+	return &User{Config: cfg}
+}
+
+// ID implements the Service interface.
+func (u *User) ID(r runtime.Runtime) string {
+	return u.Config.Name()
+}
+
+// PreFunc implements the Service interface.
+func (u *User) PreFunc(ctx context.Context, r runtime.Runtime) error {
+	client, err := containerdapi.New(constants.SystemContainerdAddress)
+	if err != nil {
+		return err
+	}
+	// nolint: errcheck
+	defer client.Close()
+
+	// Pull the image and unpack it.
+	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
+
+	if _, err = image.Pull(containerdctx, r.Config().Machine().Registries(), client, u.Config.Image()); err != nil {
+		return fmt.Errorf("failed to pull image %q: %w", u.Config.Image(), err)
+	}
+
+	return nil
+}
+
+// PostFunc implements the Service interface.
+func (u *User) PostFunc(r runtime.Runtime, state events.ServiceState) (err error) {
+	return nil
+}
+
+// Condition implements the Service interface.
+func (u *User) Condition(r runtime.Runtime) conditions.Condition {
+	return nil
+}
+
+// DependsOn implements the Service interface.
+func (u *User) DependsOn(r runtime.Runtime) []string {
+	deps := []string{"containerd", "networkd"}
+
+	if r.State().Platform().Mode() != runtime.ModeContainer && !r.Config().Machine().Time().Disabled() {
+		deps = append(deps, "timed")
+	}
+
+	for _, dep := range u.Config.DependsOn() {
+		if !contains(deps, dep) {
+			deps = append(deps, dep)
+		}
+	}
+
+	return deps
+}
+
+// Runner implements the Service interface.
+func (u *User) Runner(r runtime.Runtime) (runner.Runner, error) {
+	// Set the process arguments, image entrypoint is used if not set.
+	args := runner.Args{
+		ID:          u.ID(r),
+		ProcessArgs: u.Config.Args(),
+	}
+
+	env := []string{}
+	for key, val := range r.Config().Machine().Env() {
+		env = append(env, fmt.Sprintf("%s=%s", key, val))
+	}
+
+	for key, val := range u.Config.Env() {
+		env = append(env, fmt.Sprintf("%s=%s", key, val))
+	}
+
+	var restartType restart.Type
+
+	switch u.Config.Restart() {
+	case "always":
+		restartType = restart.Forever
+	case "untilSuccess":
+		restartType = restart.UntilSuccess
+	case "once":
+		restartType = restart.Once
+	default:
+		return nil, fmt.Errorf("unsupported restart policy %q", u.Config.Restart())
+	}
+
+	return restart.New(containerd.NewRunner(
+		r.Config().Debug(),
+		&args,
+		runner.WithLoggingManager(r.Logging()),
+		runner.WithCgroupPath(cgroup.Path(u.ID(r))),
+		runner.WithNamespace(constants.SystemContainerdNamespace),
+		runner.WithContainerdAddress(constants.SystemContainerdAddress),
+		runner.WithContainerImage(u.Config.Image()),
+		runner.WithEnv(env),
+		runner.WithOCISpecOpts(
+			oci.WithHostNamespace(specs.NetworkNamespace),
+			oci.WithMounts(u.Config.Mounts()),
+		),
+	),
+		restart.WithType(restartType),
+	), nil
+}
+
+// APIStartAllowed implements the APIStartableService interface.
+func (u *User) APIStartAllowed(r runtime.Runtime) bool {
+	return true
+}
+
+// APIStopAllowed implements the APIStoppableService interface.
+func (u *User) APIStopAllowed(r runtime.Runtime) bool {
+	return true
+}
+
+// APIRestartAllowed implements the APIRestartableService interface.
+func (u *User) APIRestartAllowed(r runtime.Runtime) bool {
+	return true
+}
+
+// HealthFunc implements the HealthcheckedService interface.
+func (u *UserWithHealthCheck) HealthFunc(runtime.Runtime) health.Check {
+	check := u.Config.HealthCheck()
+
+	return func(ctx context.Context) error {
+		if check.TCP() != "" {
+			var d net.Dialer
+
+			conn, err := d.DialContext(ctx, "tcp", check.TCP())
+			if err != nil {
+				return err
+			}
+
+			return conn.Close()
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, check.HTTP(), nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		// nolint: errcheck
+		defer resp.Body.Close()
+
+		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+			return fmt.Errorf("unexpected HTTP status %q", resp.Status)
+		}
+
+		return nil
+	}
+}
+
+// HealthSettings implements the HealthcheckedService interface.
+func (u *UserWithHealthCheck) HealthSettings(runtime.Runtime) *health.Settings {
+	return &health.Settings{
+		InitialDelay: health.DefaultSettings.InitialDelay,
+		Period:       u.Config.HealthCheck().Interval(),
+		Timeout:      u.Config.HealthCheck().Timeout(),
+	}
+}
+
+// userServicesBefore returns the user-defined services which should be up before the built-in service.
+//
+// Services which depend on the built-in service (directly or via other user-defined services) are skipped.
+func userServicesBefore(r runtime.Runtime, id string) []string {
+	services := r.Config().Machine().Services()
+
+	deps := map[string][]string{}
+
+	for _, svc := range services {
+		deps[svc.Name()] = svc.DependsOn()
+	}
+
+	var dependsOn func(name string, visited map[string]bool) bool
+
+	dependsOn = func(name string, visited map[string]bool) bool {
+		if visited[name] {
+			return false
+		}
+
+		visited[name] = true
+
+		for _, dep := range deps[name] {
+			if dep == id || dependsOn(dep, visited) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	result := []string{}
+
+	for _, svc := range services {
+		if !dependsOn(svc.Name(), map[string]bool{}) {
+			result = append(result, svc.Name())
+		}
+	}
+
+	return result
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/api/network"
 	"github.com/talos-systems/talos/pkg/machinery/api/storage"
+	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
 	v1alpha1machine "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 )
@@ -63,7 +64,7 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
 
-	if err = cfgProvider.Validate(s.runtime.State().Platform().Mode()); err != nil {
+	if err = cfgProvider.Validate(s.runtime.State().Platform().Mode(), config.WithLocal()); err != nil {
 		return nil, fmt.Errorf("configuration validation failed: %w", err)
 	}
 
@@ -11,8 +11,8 @@ import (
 )
 
 // Addressing provides an interface for abstracting the underlying network
-// addressing configuration. Currently dhcp(v4) and static methods are
-// supported.
+// addressing configuration. Currently dhcp(v4), dhcp(v6) and static methods
+// are supported.
 type Addressing interface {
 	Address() *net.IPNet
 	Discover(context.Context, *net.Interface) error
@@ -0,0 +1,343 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package address
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/insomniacslk/dhcp/dhcpv6/nclient6"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// DHCP6 implements the Addressing interface for DHCPv6.
+//
+// Default route is not provided by DHCPv6, it should be learned from
+// the router advertisements.
+type DHCP6 struct {
+	Reply     *dhcpv6.Message
+	NetIf     *net.Interface
+	Mtu       int
+	RouteList []config.Route
+}
+
+// Name returns back the name of the address method.
+func (d *DHCP6) Name() string {
+	return "dhcp6"
+}
+
+// Link returns the underlying net.Interface that this address
+// method is configured for.
+func (d *DHCP6) Link() *net.Interface {
+	return d.NetIf
+}
+
+// Discover handles the DHCPv6 client exchange and stores the DHCPv6 Reply.
+//
+// Prefix delegation is requested along with the address.
+// If the address is already assigned, the lease (including the delegated prefixes) is extended
+// with Renew and Rebind exchanges first, and the address is requested again only if both of them fail.
+func (d *DHCP6) Discover(ctx context.Context, link *net.Interface) error {
+	d.NetIf = link
+
+	previous := d.DelegatedPrefixes()
+
+	reply, err := d.discover(ctx)
+	if err != nil {
+		return err
+	}
+
+	d.Reply = reply
+
+	logPrefixChanges(d.NetIf.Name, previous, d.DelegatedPrefixes())
+
+	return nil
+}
+
+// Address returns back the IP address from the received DHCPv6 reply.
+func (d *DHCP6) Address() *net.IPNet {
+	addr := d.iaAddress()
+	if addr == nil {
+		return nil
+	}
+
+	return &net.IPNet{
+		IP:   addr.IPv6Addr,
+		Mask: d.Mask(),
+	}
+}
+
+// Mask returns the netmask for the address: DHCPv6 assigns single addresses,
+// on-link prefixes are learned from the router advertisements.
+func (d *DHCP6) Mask() net.IPMask {
+	return net.CIDRMask(128, 128)
+}
+
+// MTU returs the MTU size for the interface.
+func (d *DHCP6) MTU() uint32 {
+	mtu := uint32(d.Mtu)
+	if mtu == 0 {
+		mtu = uint32(d.NetIf.MTU)
+	}
+
+	return mtu
+}
+
+// TTL denotes how long a DHCPv6 lease is valid for.
+//
+// The lease is renewed at half of the TTL, so if the server sets the renewal time (T1),
+// the TTL is reported as twice the renewal time. Delegated prefixes are renewed together
+// with the address, so the earliest renewal time of the address and the prefixes is used.
+func (d *DHCP6) TTL() time.Duration {
+	addr := d.iaAddress()
+	if addr == nil {
+		return 0
+	}
+
+	t1 := d.Reply.Options.OneIANA().T1
+
+	if iapd := d.Reply.Options.OneIAPD(); iapd != nil && len(d.DelegatedPrefixes()) > 0 {
+		if iapd.T1 > 0 && (t1 == 0 || iapd.T1 < t1) {
+			t1 = iapd.T1
+		}
+	}
+
+	if t1 > 0 && t1 < addr.PreferredLifetime {
+		return 2 * t1
+	}
+
+	return addr.PreferredLifetime
+}
+
+// Family qualifies the address as ipv4 or ipv6.
+func (d *DHCP6) Family() int {
+	return unix.AF_INET6
+}
+
+// Scope sets the address scope.
+func (d *DHCP6) Scope() uint8 {
+	return unix.RT_SCOPE_UNIVERSE
+}
+
+// Valid denotes if this address method should be used.
+func (d *DHCP6) Valid() bool {
+	return d.iaAddress() != nil
+}
+
+// Routes returns the routes provided in config, DHCPv6 doesn't provide any routes.
+func (d *DHCP6) Routes() (routes []*Route) {
+	for _, route := range d.RouteList {
+		_, ipnet, err := net.ParseCIDR(route.Network())
+		if err != nil {
+			continue
+		}
+
+		routes = append(routes, &Route{
+			Destination: ipnet,
+			Gateway:     net.ParseIP(route.Gateway()),
+			Metric:      staticRouteDefaultMetric,
+		})
+	}
+
+	return routes
+}
+
+// Resolvers returns the DNS resolvers from the DHCPv6 reply.
+func (d *DHCP6) Resolvers() []net.IP {
+	if d.Reply == nil {
+		return nil
+	}
+
+	return d.Reply.Options.DNS()
+}
+
+// Hostname returns the hostname, DHCPv6 hostname is not supported, so the
+// hostname is picked from other address methods.
+func (d *DHCP6) Hostname() string {
+	return ""
+}
+
+// DelegatedPrefixes returns the prefixes delegated to the host.
+//
+// Prefixes with zero valid lifetime (released by the server on renewal) are skipped.
+func (d *DHCP6) DelegatedPrefixes() (prefixes []*net.IPNet) {
+	if d.Reply == nil {
+		return nil
+	}
+
+	iapd := d.Reply.Options.OneIAPD()
+	if iapd == nil {
+		return nil
+	}
+
+	for _, prefix := range iapd.Options.Prefixes() {
+		if prefix.Prefix != nil && prefix.ValidLifetime > 0 {
+			prefixes = append(prefixes, prefix.Prefix)
+		}
+	}
+
+	return prefixes
+}
+
+// logPrefixChanges logs the delegated prefixes which were received or released.
+func logPrefixChanges(ifname string, previous, current []*net.IPNet) {
+	contains := func(prefixes []*net.IPNet, prefix *net.IPNet) bool {
+		for _, p := range prefixes {
+			if p.String() == prefix.String() {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, prefix := range current {
+		if !contains(previous, prefix) {
+			log.Printf("received delegated prefix %s on %s", prefix, ifname)
+		}
+	}
+
+	for _, prefix := range previous {
+		if !contains(current, prefix) {
+			log.Printf("delegated prefix %s on %s was released", prefix, ifname)
+		}
+	}
+}
+
+func (d *DHCP6) iaAddress() *dhcpv6.OptIAAddress {
+	if d.Reply == nil {
+		return nil
+	}
+
+	iana := d.Reply.Options.OneIANA()
+	if iana == nil {
+		return nil
+	}
+
+	return iana.Options.OneAddress()
+}
+
+func (d *DHCP6) modifiers() []dhcpv6.Modifier {
+	mods := []dhcpv6.Modifier{
+		dhcpv6.WithRequestedOptions(
+			dhcpv6.OptionDNSRecursiveNameServer,
+			dhcpv6.OptionDomainSearchList,
+		),
+	}
+
+	// request prefix delegation as well, servers which don't support it still assign the address
+	if iaid, ok := d.iaid(); ok {
+		mods = append(mods, dhcpv6.WithIAPD(iaid))
+	}
+
+	return mods
+}
+
+// iaid returns the identity association ID for the prefix delegation derived from the hardware address.
+func (d *DHCP6) iaid() (iaid [4]byte, ok bool) {
+	if len(d.NetIf.HardwareAddr) < 4 {
+		return iaid, false
+	}
+
+	copy(iaid[:], d.NetIf.HardwareAddr[len(d.NetIf.HardwareAddr)-4:])
+
+	return iaid, true
+}
+
+// discover handles the actual DHCPv6 conversation.
+func (d *DHCP6) discover(ctx context.Context) (*dhcpv6.Message, error) {
+	cli, err := nclient6.New(d.NetIf.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer cli.Close()
+
+	if d.Valid() {
+		// Renew goes to the server which assigned the address, Rebind to any server
+		for _, msgType := range []dhcpv6.MessageType{dhcpv6.MessageTypeRenew, dhcpv6.MessageTypeRebind} {
+			reply, err := d.extend(ctx, cli, msgType)
+			if err == nil {
+				return reply, nil
+			}
+
+			log.Printf("failed dhcp6 %s for %s: %s", msgType, d.NetIf.Name, err)
+		}
+	}
+
+	advertise, err := cli.Solicit(ctx, d.modifiers()...)
+	if err != nil {
+		log.Println("failed dhcp6 solicit for", d.NetIf.Name)
+
+		return nil, err
+	}
+
+	reply, err := cli.Request(ctx, advertise, d.modifiers()...)
+	if err != nil {
+		log.Println("failed dhcp6 request for", d.NetIf.Name)
+
+		return nil, err
+	}
+
+	return reply, checkReply(reply, d.NetIf.Name)
+}
+
+// extend sends Renew or Rebind message for the address and the prefixes delegated in the current reply.
+func (d *DHCP6) extend(ctx context.Context, cli *nclient6.Client, msgType dhcpv6.MessageType) (*dhcpv6.Message, error) {
+	mods := d.modifiers()
+
+	// delegated prefixes are renewed along with the address, prefix delegation is requested again otherwise
+	if iapd := d.Reply.Options.OneIAPD(); iapd != nil && len(d.DelegatedPrefixes()) > 0 {
+		mods = append(mods, dhcpv6.WithIAPD(iapd.IaId, iapd.Options.Prefixes()...))
+	}
+
+	msg, err := dhcpv6.NewMessage(mods...)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.MessageType = msgType
+
+	clientID := d.Reply.Options.GetOne(dhcpv6.OptionClientID)
+	if clientID == nil {
+		return nil, fmt.Errorf("no client ID in the dhcp6 reply")
+	}
+
+	msg.AddOption(clientID)
+
+	if msgType == dhcpv6.MessageTypeRenew {
+		serverID := d.Reply.Options.GetOne(dhcpv6.OptionServerID)
+		if serverID == nil {
+			return nil, fmt.Errorf("no server ID in the dhcp6 reply")
+		}
+
+		msg.AddOption(serverID)
+	}
+
+	msg.AddOption(d.Reply.Options.OneIANA())
+	msg.AddOption(dhcpv6.OptElapsedTime(0))
+
+	reply, err := cli.SendAndRead(ctx, nclient6.AllDHCPRelayAgentsAndServers, msg, nclient6.IsMessageType(dhcpv6.MessageTypeReply))
+	if err != nil {
+		return nil, err
+	}
+
+	return reply, checkReply(reply, d.NetIf.Name)
+}
+
+func checkReply(reply *dhcpv6.Message, ifname string) error {
+	if reply.Options.OneIANA() == nil || reply.Options.OneIANA().Options.OneAddress() == nil {
+		return fmt.Errorf("no address assigned via dhcp6 for %s", ifname)
+	}
+
+	return nil
+}
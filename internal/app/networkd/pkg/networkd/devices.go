@@ -5,8 +5,12 @@
 package networkd
 
 import (
+	"log"
 	"net"
 
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/talos-systems/talos/internal/app/networkd/pkg/nic"
 	networkapi "github.com/talos-systems/talos/pkg/machinery/api/network"
 )
 
@@ -39,6 +43,12 @@ func GetDevices() (reply *networkapi.InterfacesResponse, err error) {
 			Ipaddress:    addrs,
 		}
 
+		if nic.IsWireguardDevice(iface.Name) {
+			if ifmsg.Wireguard, err = getWireguardState(iface.Name); err != nil {
+				log.Printf("failed to get wireguard device %q state: %s", iface.Name, err)
+			}
+		}
+
 		resp.Interfaces = append(resp.Interfaces, ifmsg)
 	}
 
@@ -48,3 +58,40 @@ func GetDevices() (reply *networkapi.InterfacesResponse, err error) {
 		},
 	}, nil
 }
+
+func getWireguardState(ifname string) (*networkapi.WireguardDevice, error) {
+	device, err := nic.GetWireguardDevice(ifname)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &networkapi.WireguardDevice{
+		PublicKey:  nic.FormatWireguardKey(device.PublicKey),
+		ListenPort: uint32(device.ListenPort),
+	}
+
+	for _, peer := range device.Peers {
+		allowedIPs := make([]string, 0, len(peer.AllowedIPs))
+		for _, ipNet := range peer.AllowedIPs {
+			allowedIPs = append(allowedIPs, ipNet.String())
+		}
+
+		peerState := &networkapi.WireguardPeer{
+			PublicKey:     nic.FormatWireguardKey(peer.PublicKey),
+			Endpoint:      peer.Endpoint,
+			AllowedIps:    allowedIPs,
+			ReceiveBytes:  peer.ReceiveBytes,
+			TransmitBytes: peer.TransmitBytes,
+		}
+
+		if !peer.LastHandshakeTime.IsZero() {
+			if peerState.LastHandshake, err = ptypes.TimestampProto(peer.LastHandshakeTime); err != nil {
+				return nil, err
+			}
+		}
+
+		state.Peers = append(state.Peers, peerState)
+	}
+
+	return state, nil
+}
@@ -47,8 +47,15 @@ func buildOptions(device config.Device, hostname string) (name string, opts []ni
 
 		opts = append(opts, nic.WithAddressing(s))
 	case device.DHCP():
-		d := &address.DHCP{DHCPOptions: device.DHCPOptions(), RouteList: device.Routes(), Mtu: device.MTU()}
-		opts = append(opts, nic.WithAddressing(d))
+		if device.DHCPOptions().IPv4() {
+			d := &address.DHCP4{DHCPOptions: device.DHCPOptions(), RouteList: device.Routes(), Mtu: device.MTU()}
+			opts = append(opts, nic.WithAddressing(d))
+		}
+
+		if device.DHCPOptions().IPv6() {
+			d := &address.DHCP6{RouteList: device.Routes(), Mtu: device.MTU()}
+			opts = append(opts, nic.WithAddressing(d))
+		}
 	default:
 		// Allow master interface without any addressing if VLANs exist
 		if len(device.Vlans()) > 0 {
@@ -63,6 +70,10 @@ func buildOptions(device config.Device, hostname string) (name string, opts []ni
 		}
 	}
 
+	if acceptRA := device.AcceptRA(); acceptRA != nil {
+		opts = append(opts, nic.WithAcceptRA(*acceptRA))
+	}
+
 	// Configure Vlan interfaces
 	for _, vlan := range device.Vlans() {
 		opts = append(opts, nic.WithVlan(vlan.ID()))
@@ -80,6 +91,10 @@ func buildOptions(device config.Device, hostname string) (name string, opts []ni
 		opts = append(opts, nic.WithDummy())
 	}
 
+	if device.WireguardConfig() != nil {
+		opts = append(opts, nic.WithWireguardConfig(device.WireguardConfig()))
+	}
+
 	// Configure Bonding
 	if device.Bond() == nil {
 		return device.Interface(), opts, err
@@ -70,6 +70,52 @@ func (suite *NetconfSuite) TestKernelNetconfIncomplete() {
 	suite.Assert().Equal(len(addr.Routes()), 1)
 }
 
+func (suite *NetconfSuite) TestDHCPNetconf() {
+	disabled := false
+
+	for _, test := range []struct {
+		device   *v1alpha1.Device
+		expected []string
+	}{
+		{
+			device:   &v1alpha1.Device{DeviceInterface: "eth0", DeviceDHCP: true},
+			expected: []string{"dhcp4"},
+		},
+		{
+			device: &v1alpha1.Device{
+				DeviceInterface:   "eth0",
+				DeviceDHCP:        true,
+				DeviceDHCPOptions: &v1alpha1.DHCPOptions{DHCPIPv6: true},
+			},
+			expected: []string{"dhcp4", "dhcp6"},
+		},
+		{
+			device: &v1alpha1.Device{
+				DeviceInterface:   "eth0",
+				DeviceDHCP:        true,
+				DeviceDHCPOptions: &v1alpha1.DHCPOptions{DHCPIPv4: &disabled, DHCPIPv6: true},
+				DeviceAcceptRA:    &disabled,
+			},
+			expected: []string{"dhcp6"},
+		},
+	} {
+		_, opts, err := buildOptions(test.device, "")
+		suite.Require().NoError(err)
+
+		iface, err := nic.New(opts...)
+		suite.Require().NoError(err)
+
+		names := make([]string, 0, len(iface.AddressMethod))
+
+		for _, method := range iface.AddressMethod {
+			names = append(names, method.Name())
+		}
+
+		suite.Assert().Equal(test.expected, names)
+		suite.Assert().Equal(test.device.DeviceAcceptRA, iface.AcceptRA)
+	}
+}
+
 func sampleConfig() []config.Device {
 	return []config.Device{
 		&v1alpha1.Device{
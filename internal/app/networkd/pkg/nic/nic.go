@@ -9,9 +9,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net"
 	"os"
+	"path/filepath"
 	"strconv"
 	"syscall"
 	"time"
@@ -46,12 +48,16 @@ type NetworkInterface struct {
 	Ignore        bool
 	Dummy         bool
 	Bonded        bool
+	Wireguard     bool
 	MTU           uint32
 	Link          *net.Interface
 	SubInterfaces []*net.Interface
 	AddressMethod []address.Addressing
 	BondSettings  *netlink.AttributeEncoder
 	Vlans         []*Vlan
+	AcceptRA      *bool
+
+	WireguardConfig *WireguardDevice
 
 	rtConn   *rtnetlink.Conn
 	rtnlConn *rtnl.Conn
@@ -77,7 +83,7 @@ func New(setters ...Option) (*NetworkInterface, error) {
 	// If no addressing methods have been configured, default to DHCP.
 	// If VLANs exist do not force DHCP on master device
 	if len(iface.AddressMethod) == 0 && len(iface.Vlans) == 0 {
-		iface.AddressMethod = append(iface.AddressMethod, &address.DHCP{})
+		iface.AddressMethod = append(iface.AddressMethod, &address.DHCP4{})
 	}
 
 	// Handle netlink connection
@@ -131,6 +137,10 @@ func (n *NetworkInterface) Create() error {
 		info = &rtnetlink.LinkInfo{Kind: "dummy"}
 	}
 
+	if n.Wireguard {
+		info = &rtnetlink.LinkInfo{Kind: "wireguard"}
+	}
+
 	if err = n.createLink(n.Name, info); err != nil {
 		return err
 	}
@@ -212,6 +222,18 @@ func (n *NetworkInterface) Configure() (err error) {
 		}
 	}
 
+	if n.Wireguard {
+		if err = ConfigureWireguard(n.Link.Name, n.WireguardConfig); err != nil {
+			return fmt.Errorf("failed to configure wireguard device %q: %w", n.Link.Name, err)
+		}
+	}
+
+	if n.AcceptRA != nil {
+		if err = n.setAcceptRA(*n.AcceptRA); err != nil {
+			return fmt.Errorf("failed to configure router advertisements on %q: %w", n.Link.Name, err)
+		}
+	}
+
 	if err = n.rtnlConn.LinkUp(n.Link); err != nil {
 		return err
 	}
@@ -234,6 +256,20 @@ func (n *NetworkInterface) Configure() (err error) {
 	return nil
 }
 
+// setAcceptRA enables or disables processing of IPv6 router advertisements (and SLAAC).
+//
+// Router advertisements are accepted even if forwarding is enabled, as it is
+// enabled on Kubernetes nodes.
+func (n *NetworkInterface) setAcceptRA(accept bool) error {
+	value := "0"
+	if accept {
+		value = "2"
+	}
+
+	// interface name might contain dots, so sysctl package can't be used
+	return ioutil.WriteFile(filepath.Join("/proc/sys/net/ipv6/conf", n.Link.Name, "accept_ra"), []byte(value), 0o644)
+}
+
 func (n *NetworkInterface) waitForLinkToBeUp(linkDev *net.Interface) error {
 	// Wait for link to report up
 	var link rtnetlink.LinkMessage
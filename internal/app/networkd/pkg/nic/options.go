@@ -5,9 +5,13 @@
 package nic
 
 import (
+	"fmt"
+	"net"
+
 	"github.com/mdlayher/netlink"
 
 	"github.com/talos-systems/talos/internal/app/networkd/pkg/address"
+	"github.com/talos-systems/talos/pkg/machinery/config"
 )
 
 // Option is the functional option func.
@@ -69,3 +73,55 @@ func WithNoAddressing() Option {
 		return err
 	}
 }
+
+// WithAcceptRA defines whether IPv6 router advertisements should be accepted
+// on the interface.
+func WithAcceptRA(accept bool) Option {
+	return func(n *NetworkInterface) (err error) {
+		n.AcceptRA = &accept
+
+		return err
+	}
+}
+
+// WithWireguardConfig defines if the interface should be a WireGuard device and its configuration.
+func WithWireguardConfig(cfg config.WireguardConfig) Option {
+	return func(n *NetworkInterface) (err error) {
+		device := &WireguardDevice{
+			ListenPort:   cfg.ListenPort(),
+			FirewallMark: cfg.FirewallMark(),
+		}
+
+		if device.PrivateKey, err = ParseWireguardKey(cfg.PrivateKey()); err != nil {
+			return fmt.Errorf("invalid private key: %w", err)
+		}
+
+		for _, peerConfig := range cfg.Peers() {
+			peer := &WireguardPeer{
+				Endpoint:                    peerConfig.Endpoint(),
+				PersistentKeepaliveInterval: peerConfig.PersistentKeepaliveInterval(),
+			}
+
+			if peer.PublicKey, err = ParseWireguardKey(peerConfig.PublicKey()); err != nil {
+				return fmt.Errorf("invalid peer public key: %w", err)
+			}
+
+			for _, allowedIP := range peerConfig.AllowedIPs() {
+				var ipNet *net.IPNet
+
+				if _, ipNet, err = net.ParseCIDR(allowedIP); err != nil {
+					return fmt.Errorf("invalid peer allowed IP %q: %w", allowedIP, err)
+				}
+
+				peer.AllowedIPs = append(peer.AllowedIPs, ipNet)
+			}
+
+			device.Peers = append(device.Peers, peer)
+		}
+
+		n.Wireguard = true
+		n.WireguardConfig = device
+
+		return nil
+	}
+}
@@ -49,7 +49,7 @@ func WithVlanDhcp(id uint16) Option {
 	return func(n *NetworkInterface) (err error) {
 		for _, vlan := range n.Vlans {
 			if vlan.ID == id {
-				vlan.AddressMethod = append(vlan.AddressMethod, &address.DHCP{})
+				vlan.AddressMethod = append(vlan.AddressMethod, &address.DHCP4{})
 
 				return nil
 			}
@@ -0,0 +1,461 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nic
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mdlayher/genetlink"
+	"github.com/mdlayher/netlink"
+	"github.com/mdlayher/netlink/nlenc"
+	"golang.org/x/sys/unix"
+)
+
+// WireGuard generic netlink protocol, see include/uapi/linux/wireguard.h.
+//
+// nolint: golint,stylecheck
+const (
+	WG_GENL_NAME    = "wireguard"
+	WG_GENL_VERSION = 1
+
+	WG_CMD_GET_DEVICE = 0
+	WG_CMD_SET_DEVICE = 1
+
+	WGDEVICE_F_REPLACE_PEERS = 1
+
+	WGDEVICE_A_IFINDEX     = 1
+	WGDEVICE_A_IFNAME      = 2
+	WGDEVICE_A_PRIVATE_KEY = 3
+	WGDEVICE_A_PUBLIC_KEY  = 4
+	WGDEVICE_A_FLAGS       = 5
+	WGDEVICE_A_LISTEN_PORT = 6
+	WGDEVICE_A_FWMARK      = 7
+	WGDEVICE_A_PEERS       = 8
+
+	WGPEER_F_REPLACE_ALLOWEDIPS = 2
+
+	WGPEER_A_PUBLIC_KEY                    = 1
+	WGPEER_A_PRESHARED_KEY                 = 2
+	WGPEER_A_FLAGS                         = 3
+	WGPEER_A_ENDPOINT                      = 4
+	WGPEER_A_PERSISTENT_KEEPALIVE_INTERVAL = 5
+	WGPEER_A_LAST_HANDSHAKE_TIME           = 6
+	WGPEER_A_RX_BYTES                      = 7
+	WGPEER_A_TX_BYTES                      = 8
+	WGPEER_A_ALLOWEDIPS                    = 9
+	WGPEER_A_PROTOCOL_VERSION              = 10
+
+	WGALLOWEDIP_A_FAMILY    = 1
+	WGALLOWEDIP_A_IPADDR    = 2
+	WGALLOWEDIP_A_CIDR_MASK = 3
+)
+
+// WireguardKeyLen is the length of the WireGuard keys.
+const WireguardKeyLen = 32
+
+// WireguardDevice is the configuration and the state of the WireGuard device.
+type WireguardDevice struct {
+	PrivateKey   []byte
+	PublicKey    []byte
+	ListenPort   int
+	FirewallMark int
+	Peers        []*WireguardPeer
+}
+
+// WireguardPeer is the configuration and the state of the WireGuard device peer.
+type WireguardPeer struct {
+	PublicKey                   []byte
+	Endpoint                    string
+	PersistentKeepaliveInterval time.Duration
+	AllowedIPs                  []*net.IPNet
+
+	// State fields, ignored when configuring the device.
+	LastHandshakeTime time.Time
+	ReceiveBytes      uint64
+	TransmitBytes     uint64
+}
+
+// ParseWireguardKey decodes base64 encoded WireGuard key.
+func ParseWireguardKey(key string) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode WireGuard key: %w", err)
+	}
+
+	if len(b) != WireguardKeyLen {
+		return nil, fmt.Errorf("invalid WireGuard key length %d", len(b))
+	}
+
+	return b, nil
+}
+
+// FormatWireguardKey encodes WireGuard key as base64.
+func FormatWireguardKey(key []byte) string {
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// IsWireguardDevice checks whether the link is a WireGuard device.
+func IsWireguardDevice(ifname string) bool {
+	uevent, err := ioutil.ReadFile(filepath.Join("/sys/class/net", ifname, "uevent"))
+	if err != nil {
+		return false
+	}
+
+	for _, line := range strings.Split(string(uevent), "\n") {
+		if line == "DEVTYPE="+WG_GENL_NAME {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConfigureWireguard applies the configuration to the WireGuard device.
+//
+// Peers which are not in the configuration are removed from the device.
+func ConfigureWireguard(ifname string, device *WireguardDevice) error {
+	data, err := encodeWireguardDevice(ifname, device)
+	if err != nil {
+		return err
+	}
+
+	_, err = wireguardExecute(WG_CMD_SET_DEVICE, data, netlink.Request|netlink.Acknowledge)
+
+	return err
+}
+
+// GetWireguardDevice returns the configuration and the state of the WireGuard device.
+func GetWireguardDevice(ifname string) (*WireguardDevice, error) {
+	ae := netlink.NewAttributeEncoder()
+	ae.String(WGDEVICE_A_IFNAME, ifname)
+
+	data, err := ae.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := wireguardExecute(WG_CMD_GET_DEVICE, data, netlink.Request|netlink.Dump)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeWireguardDevice(msgs)
+}
+
+func wireguardExecute(command uint8, data []byte, flags netlink.HeaderFlags) ([]genetlink.Message, error) {
+	conn, err := genetlink.Dial(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial generic netlink: %w", err)
+	}
+
+	//nolint: errcheck
+	defer conn.Close()
+
+	family, err := conn.GetFamily(WG_GENL_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get WireGuard generic netlink family: %w", err)
+	}
+
+	msgs, err := conn.Execute(genetlink.Message{
+		Header: genetlink.Header{
+			Command: command,
+			Version: WG_GENL_VERSION,
+		},
+		Data: data,
+	}, family.ID, flags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute WireGuard command: %w", err)
+	}
+
+	return msgs, nil
+}
+
+func encodeWireguardDevice(ifname string, device *WireguardDevice) ([]byte, error) {
+	ae := netlink.NewAttributeEncoder()
+
+	ae.String(WGDEVICE_A_IFNAME, ifname)
+	ae.Uint32(WGDEVICE_A_FLAGS, WGDEVICE_F_REPLACE_PEERS)
+	ae.Uint16(WGDEVICE_A_LISTEN_PORT, uint16(device.ListenPort))
+	ae.Uint32(WGDEVICE_A_FWMARK, uint32(device.FirewallMark))
+
+	if device.PrivateKey != nil {
+		ae.Bytes(WGDEVICE_A_PRIVATE_KEY, device.PrivateKey)
+	}
+
+	if len(device.Peers) > 0 {
+		ae.Nested(WGDEVICE_A_PEERS, func(nae *netlink.AttributeEncoder) error {
+			for i, peer := range device.Peers {
+				peer := peer
+
+				nae.Nested(uint16(i), func(pae *netlink.AttributeEncoder) error {
+					return encodeWireguardPeer(pae, peer)
+				})
+			}
+
+			return nil
+		})
+	}
+
+	return ae.Encode()
+}
+
+func encodeWireguardPeer(ae *netlink.AttributeEncoder, peer *WireguardPeer) error {
+	ae.Bytes(WGPEER_A_PUBLIC_KEY, peer.PublicKey)
+	ae.Uint32(WGPEER_A_FLAGS, WGPEER_F_REPLACE_ALLOWEDIPS)
+	ae.Uint16(WGPEER_A_PERSISTENT_KEEPALIVE_INTERVAL, uint16(peer.PersistentKeepaliveInterval/time.Second))
+
+	if peer.Endpoint != "" {
+		addr, err := net.ResolveUDPAddr("udp", peer.Endpoint)
+		if err != nil {
+			return fmt.Errorf("failed to resolve peer endpoint %q: %w", peer.Endpoint, err)
+		}
+
+		b, err := encodeSockaddr(addr)
+		if err != nil {
+			return err
+		}
+
+		ae.Bytes(WGPEER_A_ENDPOINT, b)
+	}
+
+	if len(peer.AllowedIPs) > 0 {
+		ae.Nested(WGPEER_A_ALLOWEDIPS, func(nae *netlink.AttributeEncoder) error {
+			for i, ipNet := range peer.AllowedIPs {
+				ipNet := ipNet
+
+				nae.Nested(uint16(i), func(iae *netlink.AttributeEncoder) error {
+					family, ip := ipFamily(ipNet.IP)
+					ones, _ := ipNet.Mask.Size()
+
+					iae.Uint16(WGALLOWEDIP_A_FAMILY, family)
+					iae.Bytes(WGALLOWEDIP_A_IPADDR, ip)
+					iae.Uint8(WGALLOWEDIP_A_CIDR_MASK, uint8(ones))
+
+					return nil
+				})
+			}
+
+			return nil
+		})
+	}
+
+	return nil
+}
+
+func decodeWireguardDevice(msgs []genetlink.Message) (*WireguardDevice, error) {
+	device := &WireguardDevice{}
+
+	// large devices are split across multiple messages, each carrying a subset of the peers
+	for _, msg := range msgs {
+		ad, err := netlink.NewAttributeDecoder(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		for ad.Next() {
+			switch ad.Type() {
+			case WGDEVICE_A_PRIVATE_KEY:
+				device.PrivateKey = ad.Bytes()
+			case WGDEVICE_A_PUBLIC_KEY:
+				device.PublicKey = ad.Bytes()
+			case WGDEVICE_A_LISTEN_PORT:
+				device.ListenPort = int(ad.Uint16())
+			case WGDEVICE_A_FWMARK:
+				device.FirewallMark = int(ad.Uint32())
+			case WGDEVICE_A_PEERS:
+				ad.Nested(func(nad *netlink.AttributeDecoder) error {
+					for nad.Next() {
+						nad.Nested(func(pad *netlink.AttributeDecoder) error {
+							peer, err := decodeWireguardPeer(pad)
+							if err != nil {
+								return err
+							}
+
+							device.Peers = mergeWireguardPeer(device.Peers, peer)
+
+							return nil
+						})
+					}
+
+					return nil
+				})
+			}
+		}
+
+		if err = ad.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return device, nil
+}
+
+func decodeWireguardPeer(ad *netlink.AttributeDecoder) (*WireguardPeer, error) {
+	peer := &WireguardPeer{}
+
+	for ad.Next() {
+		switch ad.Type() {
+		case WGPEER_A_PUBLIC_KEY:
+			peer.PublicKey = ad.Bytes()
+		case WGPEER_A_ENDPOINT:
+			ad.Do(func(b []byte) error {
+				addr, err := decodeSockaddr(b)
+				if addr != nil {
+					peer.Endpoint = addr.String()
+				}
+
+				return err
+			})
+		case WGPEER_A_PERSISTENT_KEEPALIVE_INTERVAL:
+			peer.PersistentKeepaliveInterval = time.Duration(ad.Uint16()) * time.Second
+		case WGPEER_A_LAST_HANDSHAKE_TIME:
+			ad.Do(func(b []byte) error {
+				// struct __kernel_timespec
+				if len(b) != 16 {
+					return fmt.Errorf("unexpected handshake time length %d", len(b))
+				}
+
+				sec := int64(nlenc.Uint64(b[:8]))
+				nsec := int64(nlenc.Uint64(b[8:]))
+
+				if sec != 0 || nsec != 0 {
+					peer.LastHandshakeTime = time.Unix(sec, nsec)
+				}
+
+				return nil
+			})
+		case WGPEER_A_RX_BYTES:
+			peer.ReceiveBytes = ad.Uint64()
+		case WGPEER_A_TX_BYTES:
+			peer.TransmitBytes = ad.Uint64()
+		case WGPEER_A_ALLOWEDIPS:
+			ad.Nested(func(nad *netlink.AttributeDecoder) error {
+				for nad.Next() {
+					nad.Nested(func(iad *netlink.AttributeDecoder) error {
+						var (
+							ip   net.IP
+							ones int
+							bits int
+						)
+
+						for iad.Next() {
+							switch iad.Type() {
+							case WGALLOWEDIP_A_FAMILY:
+								if iad.Uint16() == unix.AF_INET6 {
+									bits = 128
+								} else {
+									bits = 32
+								}
+							case WGALLOWEDIP_A_IPADDR:
+								ip = net.IP(iad.Bytes())
+							case WGALLOWEDIP_A_CIDR_MASK:
+								ones = int(iad.Uint8())
+							}
+						}
+
+						if ip != nil {
+							peer.AllowedIPs = append(peer.AllowedIPs, &net.IPNet{
+								IP:   ip,
+								Mask: net.CIDRMask(ones, bits),
+							})
+						}
+
+						return nil
+					})
+				}
+
+				return nil
+			})
+		}
+	}
+
+	return peer, ad.Err()
+}
+
+// mergeWireguardPeer appends the peer to the list, a peer might be split across messages
+// if it has too many allowed IPs.
+func mergeWireguardPeer(peers []*WireguardPeer, peer *WireguardPeer) []*WireguardPeer {
+	if len(peers) > 0 {
+		last := peers[len(peers)-1]
+
+		if string(last.PublicKey) == string(peer.PublicKey) {
+			last.AllowedIPs = append(last.AllowedIPs, peer.AllowedIPs...)
+
+			return peers
+		}
+	}
+
+	return append(peers, peer)
+}
+
+func ipFamily(ip net.IP) (uint16, net.IP) {
+	if ip4 := ip.To4(); ip4 != nil {
+		return unix.AF_INET, ip4
+	}
+
+	return unix.AF_INET6, ip.To16()
+}
+
+// encodeSockaddr encodes the address as struct sockaddr_in or struct sockaddr_in6.
+func encodeSockaddr(addr *net.UDPAddr) ([]byte, error) {
+	family, ip := ipFamily(addr.IP)
+
+	var b []byte
+
+	switch {
+	case ip == nil:
+		return nil, fmt.Errorf("invalid endpoint address %s", addr)
+	case family == unix.AF_INET:
+		b = make([]byte, unix.SizeofSockaddrInet4)
+		copy(b[4:8], ip)
+	default:
+		b = make([]byte, unix.SizeofSockaddrInet6)
+		copy(b[8:24], ip)
+	}
+
+	nlenc.PutUint16(b[0:2], family)
+	binary.BigEndian.PutUint16(b[2:4], uint16(addr.Port))
+
+	return b, nil
+}
+
+// decodeSockaddr decodes struct sockaddr_in or struct sockaddr_in6.
+func decodeSockaddr(b []byte) (*net.UDPAddr, error) {
+	if len(b) < 2 {
+		return nil, errors.New("sockaddr is too short")
+	}
+
+	switch nlenc.Uint16(b[0:2]) {
+	case unix.AF_INET:
+		if len(b) < unix.SizeofSockaddrInet4 {
+			return nil, errors.New("sockaddr_in is too short")
+		}
+
+		return &net.UDPAddr{
+			IP:   net.IP(append([]byte(nil), b[4:8]...)),
+			Port: int(binary.BigEndian.Uint16(b[2:4])),
+		}, nil
+	case unix.AF_INET6:
+		if len(b) < unix.SizeofSockaddrInet6 {
+			return nil, errors.New("sockaddr_in6 is too short")
+		}
+
+		return &net.UDPAddr{
+			IP:   net.IP(append([]byte(nil), b[8:24]...)),
+			Port: int(binary.BigEndian.Uint16(b[2:4])),
+		}, nil
+	default:
+		// endpoint is not known yet
+		return nil, nil
+	}
+}
@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package nic
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/mdlayher/genetlink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWireguardEncodeDecode(t *testing.T) {
+	privateKey, err := ParseWireguardKey("cFvsHDkyaPVlDNRzlZu4ITqmO2vB1AQi3Fuxv5xbTU0=")
+	require.NoError(t, err)
+
+	publicKey, err := ParseWireguardKey("gXdHKrVcTR6wCbhjU2dIOCqdJ9hrqYKpDOzpPUhYKgA=")
+	require.NoError(t, err)
+
+	_, allowed4, err := net.ParseCIDR("192.168.1.0/24")
+	require.NoError(t, err)
+
+	_, allowed6, err := net.ParseCIDR("fd00::/64")
+	require.NoError(t, err)
+
+	device := &WireguardDevice{
+		PrivateKey:   privateKey,
+		ListenPort:   51820,
+		FirewallMark: 5,
+		Peers: []*WireguardPeer{
+			{
+				PublicKey:                   publicKey,
+				Endpoint:                    "10.5.0.2:51821",
+				PersistentKeepaliveInterval: 25 * time.Second,
+				AllowedIPs:                  []*net.IPNet{allowed4, allowed6},
+			},
+			{
+				PublicKey: privateKey,
+				Endpoint:  "[2001:db8::1]:51822",
+			},
+		},
+	}
+
+	data, err := encodeWireguardDevice("wg0", device)
+	require.NoError(t, err)
+
+	decoded, err := decodeWireguardDevice([]genetlink.Message{{Data: data}})
+	require.NoError(t, err)
+
+	assert.Equal(t, device.PrivateKey, decoded.PrivateKey)
+	assert.Equal(t, device.ListenPort, decoded.ListenPort)
+	assert.Equal(t, device.FirewallMark, decoded.FirewallMark)
+
+	require.Len(t, decoded.Peers, 2)
+
+	assert.Equal(t, publicKey, decoded.Peers[0].PublicKey)
+	assert.Equal(t, "10.5.0.2:51821", decoded.Peers[0].Endpoint)
+	assert.Equal(t, 25*time.Second, decoded.Peers[0].PersistentKeepaliveInterval)
+	require.Len(t, decoded.Peers[0].AllowedIPs, 2)
+	assert.Equal(t, "192.168.1.0/24", decoded.Peers[0].AllowedIPs[0].String())
+	assert.Equal(t, "fd00::/64", decoded.Peers[0].AllowedIPs[1].String())
+
+	assert.Equal(t, "[2001:db8::1]:51822", decoded.Peers[1].Endpoint)
+	assert.Empty(t, decoded.Peers[1].AllowedIPs)
+}
+
+func TestParseWireguardKey(t *testing.T) {
+	_, err := ParseWireguardKey("not base64")
+	assert.Error(t, err)
+
+	_, err = ParseWireguardKey("YWJj")
+	assert.Error(t, err)
+
+	key, err := ParseWireguardKey("cFvsHDkyaPVlDNRzlZu4ITqmO2vB1AQi3Fuxv5xbTU0=")
+	require.NoError(t, err)
+
+	assert.Equal(t, "cFvsHDkyaPVlDNRzlZu4ITqmO2vB1AQi3Fuxv5xbTU0=", FormatWireguardKey(key))
+}
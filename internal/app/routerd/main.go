@@ -33,6 +33,7 @@ func main() {
 	router.RegisterLocalBackend("time.TimeService", backend.NewLocal("timed", constants.TimeSocketPath))
 	router.RegisterLocalBackend("network.NetworkService", backend.NewLocal("networkd", constants.NetworkSocketPath))
 	router.RegisterLocalBackend("cluster.ClusterService", machinedBackend)
+	router.RegisterLocalBackend("storage.StorageService", machinedBackend)
 
 	err := factory.ListenAndServe(
 		router,
@@ -8,8 +8,9 @@ import (
 	"context"
 
 	"github.com/golang/protobuf/ptypes/empty"
-	"github.com/talos-systems/go-blockdevice/blockdevice/util"
 
+	"github.com/talos-systems/talos/internal/pkg/disk"
+	"github.com/talos-systems/talos/internal/pkg/volume"
 	"github.com/talos-systems/talos/pkg/machinery/api/storage"
 )
 
@@ -17,26 +18,125 @@ import (
 // TODO: this is not a full blown service yet, it's used as the common base in the machine and the maintenance services.
 type Server struct{}
 
-// Disks implements machine.MaintenanceService.
+// Disks implements storage.StorageService.
+//
+// Disks is kept for the compatibility with the older clients.
 func (s *Server) Disks(ctx context.Context, in *empty.Empty) (reply *storage.DisksResponse, err error) {
-	disks, err := util.GetDisks()
+	disks, err := listDisks()
+	if err != nil {
+		return nil, err
+	}
+
+	reply = &storage.DisksResponse{
+		Disks: disks,
+	}
+
+	return reply, nil
+}
+
+// ListDisks implements storage.StorageService.
+func (s *Server) ListDisks(ctx context.Context, in *empty.Empty) (reply *storage.ListDisksResponse, err error) {
+	disks, err := listDisks()
+	if err != nil {
+		return nil, err
+	}
+
+	volumes, err := listVolumes()
+	if err != nil {
+		return nil, err
+	}
+
+	reply = &storage.ListDisksResponse{
+		Messages: []*storage.Disks{
+			{
+				Disks:   disks,
+				Volumes: volumes,
+			},
+		},
+	}
+
+	return reply, nil
+}
+
+func listDisks() ([]*storage.Disk, error) {
+	disks, err := disk.List()
 	if err != nil {
 		return nil, err
 	}
 
 	diskList := make([]*storage.Disk, len(disks))
 
-	for i, disk := range disks {
+	for i, d := range disks {
 		diskList[i] = &storage.Disk{
-			DeviceName: disk.DeviceName,
-			Model:      disk.Model,
-			Size:       disk.Size,
+			DeviceName:      d.DeviceName,
+			Model:           d.Model,
+			Size:            d.Size,
+			Serial:          d.Serial,
+			Wwid:            d.WWID,
+			Rotational:      d.Rotational,
+			Bus:             d.Bus,
+			PartitionTable:  d.PartitionTable,
+			Filesystem:      d.Filesystem,
+			FilesystemLabel: d.FilesystemLabel,
+			MountPoint:      d.MountPoint,
+		}
+
+		for _, p := range d.Partitions {
+			diskList[i].Partitions = append(diskList[i].Partitions, &storage.Partition{
+				Number:          p.Number,
+				DeviceName:      p.DeviceName,
+				Label:           p.Label,
+				Type:            p.Type,
+				Offset:          p.Offset,
+				Size:            p.Size,
+				Filesystem:      p.Filesystem,
+				FilesystemLabel: p.FilesystemLabel,
+				MountPoint:      p.MountPoint,
+			})
+		}
+
+		if d.SMART != nil {
+			diskList[i].Smart = &storage.SMART{
+				Passed:          d.SMART.Passed,
+				Temperature:     d.SMART.Temperature,
+				PercentageUsed:  d.SMART.PercentageUsed,
+				PowerOnHours:    d.SMART.PowerOnHours,
+				CriticalWarning: d.SMART.CriticalWarning,
+			}
 		}
 	}
 
-	reply = &storage.DisksResponse{
-		Disks: diskList,
+	return diskList, nil
+}
+
+func listVolumes() ([]*storage.Volume, error) {
+	volumes, err := volume.List()
+	if err != nil {
+		return nil, err
 	}
 
-	return reply, nil
+	volumeList := make([]*storage.Volume, len(volumes))
+
+	for i, v := range volumes {
+		volumeList[i] = &storage.Volume{
+			Name:       v.Name,
+			Type:       v.Type,
+			Level:      v.Level,
+			DeviceName: v.DeviceName,
+			Size:       v.Size,
+			State:      v.State,
+			SyncAction: v.SyncAction,
+			Degraded:   v.Degraded,
+			MountPoint: v.MountPoint,
+		}
+
+		for _, m := range v.Members {
+			volumeList[i].Members = append(volumeList[i].Members, &storage.VolumeMember{
+				DeviceName: m.DeviceName,
+				State:      m.State,
+			})
+		}
+	}
+
+	return volumeList, nil
 }
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
@@ -20,17 +22,23 @@ import (
 	"github.com/talos-systems/talos/internal/app/timed/pkg/timex"
 )
 
-// NTP contains a server address.
+// NTP contains the list of time sources.
 type NTP struct {
-	Server  string
+	Servers []string
 	MinPoll time.Duration
 	MaxPoll time.Duration
 
 	ready uint32
+
+	query func(server string) (*ntp.Response, error)
+
+	mu       sync.Mutex
+	sources  []*Source
+	selected *Source
 }
 
 // NewNTPClient instantiates a new ntp client for the
-// specified server.
+// specified servers.
 func NewNTPClient(opts ...Option) (*NTP, error) {
 	ntp := defaultOptions()
 
@@ -39,6 +47,14 @@ func NewNTPClient(opts ...Option) (*NTP, error) {
 		result = multierror.Append(setter(ntp))
 	}
 
+	ntp.sources = make([]*Source, len(ntp.Servers))
+
+	for i, server := range ntp.Servers {
+		ntp.sources[i] = &Source{
+			Server: server,
+		}
+	}
+
 	return ntp, result.ErrorOrNil()
 }
 
@@ -47,13 +63,21 @@ func (n *NTP) Ready() bool {
 	return atomic.LoadUint32(&n.ready) > 0
 }
 
-// Daemon runs the control loop for query and set time
+// Daemon runs the control loop which queries all the time sources,
+// selects the best one and adjusts the time by its offset.
 // We dont ever want the daemon to stop, so we only log
 // errors.
 func (n *NTP) Daemon() (err error) {
-	if err = n.QueryAndSetTime(); err != nil {
-		log.Println(err)
+	err = retry.Constant(n.MaxPoll, retry.WithUnits(n.MinPoll), retry.WithJitter(250*time.Millisecond)).Retry(func() error {
+		if err = n.QueryAndSetTime(); err != nil {
+			log.Println(err)
+
+			return retry.ExpectedError(err)
+		}
 
+		return nil
+	})
+	if err != nil {
 		// if initial time sync fails, restart the service for more aggressive retry
 		return err
 	}
@@ -71,10 +95,44 @@ func (n *NTP) Daemon() (err error) {
 	}
 }
 
-// Query polls the ntp server and verifies a successful response.
+// Server returns the address of the selected time source.
+//
+// If no source is selected yet, first configured server is returned.
+func (n *NTP) Server() string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.selected != nil {
+		return n.selected.Server
+	}
+
+	if len(n.Servers) > 0 {
+		return n.Servers[0]
+	}
+
+	return ""
+}
+
+// Sources returns a snapshot of the time sources state.
+func (n *NTP) Sources() []Source {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	sources := make([]Source, len(n.sources))
+
+	for i := range n.sources {
+		sources[i] = *n.sources[i]
+	}
+
+	return sources
+}
+
+// Query polls the selected ntp server and verifies a successful response.
 func (n *NTP) Query() (resp *ntp.Response, err error) {
+	server := n.Server()
+
 	err = retry.Constant(n.MaxPoll, retry.WithUnits(n.MinPoll), retry.WithJitter(250*time.Millisecond)).Retry(func() error {
-		resp, err = ntp.Query(n.Server)
+		resp, err = n.query(server)
 		if err != nil {
 			log.Printf("query error: %v", err)
 
@@ -95,20 +153,68 @@ func (n *NTP) Query() (resp *ntp.Response, err error) {
 	return resp, nil
 }
 
+// Poll queries all the time sources once and selects the best source.
+func (n *NTP) Poll() (*Source, error) {
+	var wg sync.WaitGroup
+
+	for _, source := range n.sources {
+		wg.Add(1)
+
+		go func(source *Source) {
+			defer wg.Done()
+
+			resp, err := n.query(source.Server)
+			if err == nil {
+				err = resp.Validate()
+			}
+
+			if err != nil {
+				log.Printf("query error for %s: %v", source.Server, err)
+			}
+
+			n.mu.Lock()
+			defer n.mu.Unlock()
+
+			source.update(resp, err)
+		}(source)
+	}
+
+	wg.Wait()
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	previous := n.selected
+	n.selected = selectSource(n.sources, previous)
+
+	if n.selected == nil {
+		return nil, fmt.Errorf("no suitable time source found")
+	}
+
+	if previous != n.selected {
+		log.Printf("selected time source %s (stratum %d, offset %s, root distance %s)",
+			n.selected.Server, n.selected.Stratum, n.selected.Offset, n.selected.RootDistance)
+	}
+
+	selected := *n.selected
+
+	return &selected, nil
+}
+
 // GetTime returns the current system time.
 func (n *NTP) GetTime() time.Time {
 	return time.Now()
 }
 
-// QueryAndSetTime queries the NTP server and sets the time.
+// QueryAndSetTime queries the NTP servers and sets the time.
 func (n *NTP) QueryAndSetTime() (err error) {
-	var resp *ntp.Response
+	var source *Source
 
-	if resp, err = n.Query(); err != nil {
-		return fmt.Errorf("error querying %s for time, %s", n.Server, err)
+	if source, err = n.Poll(); err != nil {
+		return fmt.Errorf("error querying %s for time, %s", strings.Join(n.Servers, ", "), err)
 	}
 
-	if err = adjustTime(resp.ClockOffset); err != nil {
+	if err = adjustTime(source.Offset); err != nil {
 		return fmt.Errorf("failed to set time, %s", err)
 	}
 
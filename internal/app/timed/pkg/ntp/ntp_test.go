@@ -33,21 +33,22 @@ func (suite *NtpSuite) TestQuery() {
 }
 
 func (suite *NtpSuite) TestNtpConfig() {
-	server := "time.cloudflare.com"
-
 	// Test unset config, single server config, multiple server config
 	for _, conf := range []config.Provider{&v1alpha1.Config{MachineConfig: &v1alpha1.MachineConfig{}}, sampleConfigSingleServer(), sampleConfigMultipleServers()} {
+		servers := []string{"time.cloudflare.com"}
+
 		// Check if ntp servers are defined
-		// Support for only a single time server currently
 		if len(conf.Machine().Time().Servers()) >= 1 {
-			server = conf.Machine().Time().Servers()[0]
+			servers = conf.Machine().Time().Servers()
 		}
 
 		n, err := ntp.NewNTPClient(
-			ntp.WithServer(server),
+			ntp.WithServers(servers...),
 		)
 		suite.Assert().NoError(err)
-		suite.Assert().Equal(server, n.Server)
+		suite.Assert().Equal(servers, n.Servers)
+		suite.Assert().Equal(servers[0], n.Server())
+		suite.Assert().Len(n.Sources(), len(servers))
 	}
 }
 
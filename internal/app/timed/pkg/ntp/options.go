@@ -7,6 +7,8 @@ package ntp
 import (
 	"fmt"
 	"time"
+
+	"github.com/beevik/ntp"
 )
 
 // Option allows for the configuration of the ntp client.
@@ -25,16 +27,26 @@ func defaultOptions() *NTP {
 	// defaults for minpoll + maxpoll
 	// http://www.ntp.org/ntpfaq/NTP-s-algo.htm#AEN2082
 	return &NTP{
-		Server:  "pool.ntp.org",
+		Servers: []string{"pool.ntp.org"},
 		MaxPoll: MaxAllowablePoll * time.Second,
 		MinPoll: 64 * time.Second,
+		query:   ntp.Query,
 	}
 }
 
 // WithServer configures the ntp client to use the specified server.
 func WithServer(o string) Option {
+	return WithServers(o)
+}
+
+// WithServers configures the ntp client to use the specified list of servers.
+func WithServers(o ...string) Option {
 	return func(n *NTP) (err error) {
-		n.Server = o
+		if len(o) == 0 {
+			return fmt.Errorf("at least one server should be specified")
+		}
+
+		n.Servers = append([]string(nil), o...)
 
 		return err
 	}
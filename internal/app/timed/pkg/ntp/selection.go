@@ -0,0 +1,172 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ntp
+
+import (
+	"sort"
+	"time"
+
+	"github.com/beevik/ntp"
+)
+
+// SourceState describes the state of the time source after the selection.
+type SourceState int
+
+// Source states.
+const (
+	// SourceUnreachable is a source which didn't respond to the last query.
+	SourceUnreachable SourceState = iota
+	// SourceFalseticker is a source which doesn't agree with the majority of sources.
+	SourceFalseticker
+	// SourceCandidate is a source which agrees with the majority of sources, but wasn't selected.
+	SourceCandidate
+	// SourceSelected is a source used to adjust the system time.
+	SourceSelected
+)
+
+// String implements fmt.Stringer.
+func (state SourceState) String() string {
+	return [...]string{"unreachable", "falseticker", "candidate", "selected"}[state]
+}
+
+// Source is a time source (NTP server) state.
+type Source struct {
+	Server string
+	State  SourceState
+
+	// Reach is a reachability register, lowest bit is set if the last query succeeded.
+	Reach uint8
+
+	Stratum      uint8
+	Offset       time.Duration
+	RootDistance time.Duration
+
+	LastError error
+}
+
+// update records the result of the query to the source.
+func (source *Source) update(resp *ntp.Response, err error) {
+	source.Reach <<= 1
+	source.LastError = err
+
+	if err != nil {
+		source.State = SourceUnreachable
+
+		return
+	}
+
+	source.Reach |= 1
+	source.Stratum = resp.Stratum
+	source.Offset = resp.ClockOffset
+	source.RootDistance = resp.RootDistance
+}
+
+func (source *Source) reachable() bool {
+	return source.Reach&1 != 0
+}
+
+// low and high return bounds of the interval which should contain the true time.
+func (source *Source) low() time.Duration {
+	return source.Offset - source.RootDistance
+}
+
+func (source *Source) high() time.Duration {
+	return source.Offset + source.RootDistance
+}
+
+// selectSource picks the source to synchronize the time to.
+//
+// Selection follows the approach of chrony: first, the intersection of the correctness intervals
+// of reachable sources is found (Marzullo's algorithm), and the sources which don't overlap
+// with the intersection are marked as falsetickers. If the intersection doesn't contain majority of
+// the sources, no source is selected. Among the remaining sources the one with the lowest
+// stratum and root distance is selected, previously selected source is preferred if it is as good.
+//
+// nolint: gocyclo
+func selectSource(sources []*Source, previous *Source) *Source {
+	type endpoint struct {
+		offset time.Duration
+		delta  int
+	}
+
+	var (
+		candidates []*Source
+		endpoints  []endpoint
+	)
+
+	for _, source := range sources {
+		if !source.reachable() {
+			source.State = SourceUnreachable
+
+			continue
+		}
+
+		candidates = append(candidates, source)
+		endpoints = append(endpoints, endpoint{source.low(), 1}, endpoint{source.high(), -1})
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// interval start goes before interval end at the same offset, so that touching intervals intersect
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].offset == endpoints[j].offset {
+			return endpoints[i].delta > endpoints[j].delta
+		}
+
+		return endpoints[i].offset < endpoints[j].offset
+	})
+
+	var (
+		count, best int
+		low, high   time.Duration
+	)
+
+	for i, e := range endpoints {
+		count += e.delta
+
+		if count > best {
+			best = count
+			low = e.offset
+			high = endpoints[i+1].offset
+		}
+	}
+
+	if best*2 <= len(candidates) {
+		// no majority agrees on the time
+		for _, source := range candidates {
+			source.State = SourceFalseticker
+		}
+
+		return nil
+	}
+
+	var selected *Source
+
+	for _, source := range candidates {
+		if source.high() < low || source.low() > high {
+			source.State = SourceFalseticker
+
+			continue
+		}
+
+		source.State = SourceCandidate
+
+		if selected == nil || source.Stratum < selected.Stratum ||
+			(source.Stratum == selected.Stratum && source.RootDistance < selected.RootDistance) {
+			selected = source
+		}
+	}
+
+	if previous != nil && previous.State == SourceCandidate && previous.Stratum == selected.Stratum {
+		// avoid switching between sources of equal quality
+		selected = previous
+	}
+
+	selected.State = SourceSelected
+
+	return selected
+}
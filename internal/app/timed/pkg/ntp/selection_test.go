@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// nolint: testpackage
+package ntp
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/beevik/ntp"
+	"github.com/stretchr/testify/suite"
+)
+
+type SelectionSuite struct {
+	suite.Suite
+}
+
+func TestSelectionSuite(t *testing.T) {
+	suite.Run(t, new(SelectionSuite))
+}
+
+func reachableSource(server string, stratum uint8, offset, distance time.Duration) *Source {
+	source := &Source{Server: server}
+	source.update(&ntp.Response{Stratum: stratum, ClockOffset: offset, RootDistance: distance}, nil)
+
+	return source
+}
+
+func (suite *SelectionSuite) TestNoSources() {
+	unreachable := &Source{Server: "a"}
+	unreachable.update(nil, fmt.Errorf("timeout"))
+
+	suite.Assert().Nil(selectSource([]*Source{unreachable}, nil))
+	suite.Assert().Equal(SourceUnreachable, unreachable.State)
+}
+
+func (suite *SelectionSuite) TestSelectByStratum() {
+	a := reachableSource("a", 3, 10*time.Millisecond, 20*time.Millisecond)
+	b := reachableSource("b", 1, 12*time.Millisecond, 30*time.Millisecond)
+	c := reachableSource("c", 2, 8*time.Millisecond, 10*time.Millisecond)
+
+	suite.Assert().Equal(b, selectSource([]*Source{a, b, c}, nil))
+	suite.Assert().Equal(SourceCandidate, a.State)
+	suite.Assert().Equal(SourceSelected, b.State)
+	suite.Assert().Equal(SourceCandidate, c.State)
+}
+
+func (suite *SelectionSuite) TestFalseticker() {
+	a := reachableSource("a", 2, 10*time.Millisecond, 5*time.Millisecond)
+	b := reachableSource("b", 2, 12*time.Millisecond, 5*time.Millisecond)
+	c := reachableSource("c", 1, 5*time.Second, 5*time.Millisecond)
+
+	suite.Assert().Equal(a, selectSource([]*Source{a, b, c}, nil))
+	suite.Assert().Equal(SourceFalseticker, c.State)
+}
+
+func (suite *SelectionSuite) TestNoMajority() {
+	a := reachableSource("a", 2, 10*time.Millisecond, 5*time.Millisecond)
+	b := reachableSource("b", 2, 5*time.Second, 5*time.Millisecond)
+
+	suite.Assert().Nil(selectSource([]*Source{a, b}, nil))
+	suite.Assert().Equal(SourceFalseticker, a.State)
+	suite.Assert().Equal(SourceFalseticker, b.State)
+}
+
+func (suite *SelectionSuite) TestFailover() {
+	a := reachableSource("a", 1, 10*time.Millisecond, 5*time.Millisecond)
+	b := reachableSource("b", 2, 12*time.Millisecond, 5*time.Millisecond)
+
+	suite.Require().Equal(a, selectSource([]*Source{a, b}, nil))
+
+	a.update(nil, fmt.Errorf("timeout"))
+	b.update(&ntp.Response{Stratum: 2, ClockOffset: time.Millisecond, RootDistance: 5 * time.Millisecond}, nil)
+
+	suite.Assert().Equal(b, selectSource([]*Source{a, b}, a))
+	suite.Assert().Equal(SourceUnreachable, a.State)
+	suite.Assert().EqualValues(0b10, a.Reach)
+	suite.Assert().EqualValues(0b11, b.Reach)
+}
+
+func (suite *SelectionSuite) TestPreferPrevious() {
+	a := reachableSource("a", 2, 10*time.Millisecond, 5*time.Millisecond)
+	b := reachableSource("b", 2, 12*time.Millisecond, 4*time.Millisecond)
+
+	suite.Assert().Equal(a, selectSource([]*Source{a, b}, a))
+}
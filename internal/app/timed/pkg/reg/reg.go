@@ -37,16 +37,40 @@ func (r *Registrator) Register(s *grpc.Server) {
 	healthapi.RegisterHealthServer(s, r)
 }
 
-// Time issues a query to the configured ntp server and displays the results.
+// Time issues a query to the selected ntp server and displays the results along with the state of all time sources.
 func (r *Registrator) Time(ctx context.Context, in *empty.Empty) (reply *timeapi.TimeResponse, err error) {
 	reply = &timeapi.TimeResponse{}
 
+	server := r.Timed.Server()
+
 	rt, err := r.Timed.Query()
 	if err != nil {
 		return reply, err
 	}
 
-	return genProtobufTimeResponse(r.Timed.GetTime(), rt.Time, r.Timed.Server)
+	reply, err = genProtobufTimeResponse(r.Timed.GetTime(), rt.Time, server)
+	if err != nil {
+		return reply, err
+	}
+
+	for _, source := range r.Timed.Sources() {
+		pbSource := &timeapi.TimeSource{
+			Server:       source.Server,
+			State:        source.State.String(),
+			Reach:        uint32(source.Reach),
+			Stratum:      uint32(source.Stratum),
+			Offset:       ptypes.DurationProto(source.Offset),
+			RootDistance: ptypes.DurationProto(source.RootDistance),
+		}
+
+		if source.LastError != nil {
+			pbSource.Error = source.LastError.Error()
+		}
+
+		reply.Messages[0].Sources = append(reply.Messages[0].Sources, pbSource)
+	}
+
+	return reply, nil
 }
 
 // TimeCheck issues a query to the specified ntp server and displays the results.
@@ -8,7 +8,10 @@ package api
 
 import (
 	"context"
+	"crypto/sha256"
 	"io"
+	"io/ioutil"
+	"sync"
 	"testing"
 	"time"
 
@@ -137,6 +140,43 @@ func (suite *EtcdSuite) TestEtcdLeaveCluster() {
 	}, 10*time.Minute)
 }
 
+// TestEtcdSnapshot tests taking etcd snapshot from the control plane node.
+func (suite *EtcdSuite) TestEtcdSnapshot() {
+	nodes := suite.DiscoverNodes().NodesByType(machine.TypeInit)
+	nodes = append(nodes, suite.DiscoverNodes().NodesByType(machine.TypeControlPlane)...)
+
+	if len(nodes) == 0 {
+		suite.T().Skip("no control plane nodes found")
+	}
+
+	nodeCtx := client.WithNodes(suite.ctx, nodes[0])
+
+	r, errCh, err := suite.Client.EtcdSnapshot(nodeCtx, &machineapi.EtcdSnapshotRequest{})
+	suite.Require().NoError(err)
+
+	defer r.Close() //nolint: errcheck
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		for err := range errCh {
+			suite.Assert().NoError(err)
+		}
+	}()
+
+	size, err := io.Copy(ioutil.Discard, r)
+	suite.Require().NoError(err)
+
+	wg.Wait()
+
+	// snapshot is a bbolt database with appended sha256 hash
+	suite.Assert().EqualValues(sha256.Size, size%512)
+}
+
 func init() {
 	allSuites = append(allSuites, new(EtcdSuite))
 }
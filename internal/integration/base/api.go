@@ -247,13 +247,30 @@ func (apiSuite *APISuite) AssertRebooted(ctx context.Context, node string, reboo
 }
 
 // WaitForBootDone waits for boot phase done event.
+//
+// Events of the previous boots are replayed as well, so only the events of the current boot of each node are considered.
 func (apiSuite *APISuite) WaitForBootDone(ctx context.Context) {
 	nodes := apiSuite.DiscoverNodes().Nodes()
 
 	nodesNotDoneBooting := make(map[string]struct{})
+	bootIDs := make(map[string]string)
 
 	for _, node := range nodes {
+		node := node
+
 		nodesNotDoneBooting[node] = struct{}{}
+
+		apiSuite.Require().NoError(retry.Constant(time.Minute, retry.WithUnits(time.Second)).Retry(func() error {
+			bootID, err := apiSuite.ReadBootID(client.WithNodes(ctx, node))
+			if err != nil {
+				// API might be unresponsive if the node is still booting
+				return retry.ExpectedError(err)
+			}
+
+			bootIDs[node] = bootID
+
+			return nil
+		}))
 	}
 
 	ctx, cancel := context.WithTimeout(client.WithNodes(ctx, nodes...), 3*time.Minute)
@@ -263,6 +280,10 @@ func (apiSuite *APISuite) WaitForBootDone(ctx context.Context) {
 		defer cancel()
 
 		for event := range ch {
+			if event.BootID != bootIDs[event.Node] {
+				continue
+			}
+
 			if msg, ok := event.Payload.(*machineapi.SequenceEvent); ok {
 				if msg.GetAction() == machineapi.SequenceEvent_STOP && msg.GetSequence() == runtime.SequenceBoot.String() {
 					delete(nodesNotDoneBooting, event.Node)
@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package cgroup provides helpers to place processes into cgroups and to read cgroup resource usage.
+package cgroup
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"path"
+	"runtime"
+
+	"github.com/containerd/cgroups"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// Path returns the cgroup path of the system service.
+func Path(id string) string {
+	return path.Join(constants.CgroupSystem, id)
+}
+
+// Resources builds the cgroup resources from the memory limit in bytes and the relative CPU weight.
+//
+// Zero values are not set.
+func Resources(memoryLimit int64, cpuShares uint64) *specs.LinuxResources {
+	resources := &specs.LinuxResources{}
+
+	if memoryLimit > 0 {
+		resources.Memory = &specs.LinuxMemory{
+			Limit: &memoryLimit,
+		}
+	}
+
+	if cpuShares > 0 {
+		resources.CPU = &specs.LinuxCPU{
+			Shares: &cpuShares,
+		}
+	}
+
+	return resources
+}
+
+// Start starts the command in the cgroup, creating the cgroup if it doesn't exist.
+//
+// Resource limits are updated for the existing cgroup.
+// The command is forked from an OS thread placed into the cgroup, so that the process
+// never runs outside of the cgroup.
+func Start(cmd *exec.Cmd, cgroupPath string, resources *specs.LinuxResources) error {
+	cg, err := cgroups.Load(cgroups.V1, cgroups.StaticPath(cgroupPath))
+
+	switch {
+	case err == nil:
+		if err = cg.Update(resources); err != nil {
+			return fmt.Errorf("error updating cgroup %q: %w", cgroupPath, err)
+		}
+	case errors.Is(err, cgroups.ErrCgroupDeleted):
+		if cg, err = cgroups.New(cgroups.V1, cgroups.StaticPath(cgroupPath), resources); err != nil {
+			return fmt.Errorf("error creating cgroup %q: %w", cgroupPath, err)
+		}
+	default:
+		return fmt.Errorf("error loading cgroup %q: %w", cgroupPath, err)
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		// the thread is never unlocked, so it is terminated by the Go runtime when the goroutine exits
+		runtime.LockOSThread()
+
+		if err := cg.AddTask(cgroups.Process{Pid: unix.Gettid()}); err != nil {
+			errCh <- fmt.Errorf("error adding thread to cgroup %q: %w", cgroupPath, err)
+
+			return
+		}
+
+		errCh <- cmd.Start()
+	}()
+
+	return <-errCh
+}
+
+// Usage describes the resource usage of the cgroup.
+type Usage struct {
+	// MemoryUsage is the current memory usage in bytes.
+	MemoryUsage uint64
+	// MemoryLimit is the memory limit in bytes.
+	MemoryLimit uint64
+	// CPUUsage is the total CPU time consumed in nanoseconds.
+	CPUUsage uint64
+}
+
+// Stat returns the resource usage of the cgroup.
+func Stat(cgroupPath string) (*Usage, error) {
+	cg, err := cgroups.Load(cgroups.V1, cgroups.StaticPath(cgroupPath))
+	if err != nil {
+		return nil, fmt.Errorf("error loading cgroup %q: %w", cgroupPath, err)
+	}
+
+	metrics, err := cg.Stat(cgroups.IgnoreNotExist)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cgroup %q stats: %w", cgroupPath, err)
+	}
+
+	usage := &Usage{}
+
+	if metrics.Memory != nil && metrics.Memory.Usage != nil {
+		usage.MemoryUsage = metrics.Memory.Usage.Usage
+		usage.MemoryLimit = metrics.Memory.Usage.Limit
+	}
+
+	if metrics.CPU != nil && metrics.CPU.Usage != nil {
+		usage.CPUUsage = metrics.CPU.Usage.Total
+	}
+
+	return usage, nil
+}
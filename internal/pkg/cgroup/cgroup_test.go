@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cgroup_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/containerd/cgroups"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/cgroup"
+)
+
+func TestPath(t *testing.T) {
+	assert.Equal(t, "/system/etcd", cgroup.Path("etcd"))
+}
+
+func TestResources(t *testing.T) {
+	resources := cgroup.Resources(0, 0)
+	assert.Nil(t, resources.Memory)
+	assert.Nil(t, resources.CPU)
+
+	resources = cgroup.Resources(512*1024*1024, 512)
+	assert.Equal(t, int64(512*1024*1024), *resources.Memory.Limit)
+	assert.Equal(t, uint64(512), *resources.CPU.Shares)
+}
+
+func TestStart(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("can't run the test as non-root")
+	}
+
+	if mode := cgroups.Mode(); mode != cgroups.Legacy && mode != cgroups.Hybrid {
+		t.Skip("cgroups v1 hierarchy is not available")
+	}
+
+	cgroupPath := fmt.Sprintf("/talos-test-%d", os.Getpid())
+
+	defer func() {
+		if cg, err := cgroups.Load(cgroups.V1, cgroups.StaticPath(cgroupPath)); err == nil {
+			assert.NoError(t, cg.Delete())
+		}
+	}()
+
+	for _, memoryLimit := range []int64{64 * 1024 * 1024, 128 * 1024 * 1024} {
+		var out bytes.Buffer
+
+		cmd := exec.Command("/bin/cat", "/proc/self/cgroup")
+		cmd.Stdout = &out
+
+		require.NoError(t, cgroup.Start(cmd, cgroupPath, cgroup.Resources(memoryLimit, 512)))
+		require.NoError(t, cmd.Wait())
+
+		// the process is created in the cgroup of every controller
+		for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+			fields := strings.SplitN(line, ":", 3)
+			require.Len(t, fields, 3)
+
+			if strings.Contains(fields[1], "memory") || strings.Contains(fields[1], "cpu,") {
+				assert.Equal(t, cgroupPath, fields[2])
+			}
+		}
+
+		// the limits of the existing cgroup are updated
+		usage, err := cgroup.Stat(cgroupPath)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(memoryLimit), usage.MemoryLimit)
+
+		shares, err := ioutil.ReadFile(filepath.Join("/sys/fs/cgroup/cpu", cgroupPath, "cpu.shares"))
+		require.NoError(t, err)
+		assert.Equal(t, "512", strings.TrimSpace(string(shares)))
+	}
+}
+
+func TestStartOwnCgroup(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("can't run the test as non-root")
+	}
+
+	if mode := cgroups.Mode(); mode != cgroups.Legacy && mode != cgroups.Hybrid {
+		t.Skip("cgroups v1 hierarchy is not available")
+	}
+
+	before, err := ioutil.ReadFile("/proc/self/cgroup")
+	require.NoError(t, err)
+
+	cgroupPath := fmt.Sprintf("/talos-test-own-%d", os.Getpid())
+
+	defer func() {
+		if cg, err := cgroups.Load(cgroups.V1, cgroups.StaticPath(cgroupPath)); err == nil {
+			assert.NoError(t, cg.Delete())
+		}
+	}()
+
+	cmd := exec.Command("/bin/true")
+
+	require.NoError(t, cgroup.Start(cmd, cgroupPath, cgroup.Resources(0, 0)))
+	require.NoError(t, cmd.Wait())
+
+	// the caller is never moved into the cgroup
+	after, err := ioutil.ReadFile("/proc/self/cgroup")
+	require.NoError(t, err)
+	assert.Equal(t, string(before), string(after))
+}
@@ -26,6 +26,9 @@ type Buffer struct {
 	// write offset, always goes up, actual offset in data slice
 	// is (off % cap(data))
 	off int64
+
+	// timestamps of the writes, see time.go
+	marks []mark
 }
 
 // NewBuffer creates new Buffer with specified options.
@@ -61,6 +64,10 @@ func (buf *Buffer) Write(p []byte) (n int, err error) {
 
 	l := len(p)
 
+	if l > 0 {
+		buf.addMark(buf.opt.TimeSource())
+	}
+
 	if buf.off < int64(buf.opt.MaxCapacity) {
 		if buf.off+int64(l) > int64(cap(buf.data)) && cap(buf.data) < buf.opt.MaxCapacity {
 			// grow buffer to ensure write fits, but limit with max capacity
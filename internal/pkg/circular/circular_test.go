@@ -419,6 +419,58 @@ func (suite *CircularSuite) TestRegularSeek() {
 	suite.Require().Equal(circular.ErrOutOfSync, err)
 }
 
+func (suite *CircularSuite) TestTimeBounds() {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	buf, err := circular.NewBuffer(circular.WithInitialCapacity(2048), circular.WithMaxCapacity(65536), circular.WithSafetyGap(256),
+		circular.WithTimeSource(func() time.Time { return now }))
+	suite.Require().NoError(err)
+
+	for _, line := range []string{"first\n", "second\n", "third\n"} {
+		_, err = buf.Write([]byte(line))
+		suite.Require().NoError(err)
+
+		now = now.Add(time.Minute)
+	}
+
+	start := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	r := buf.GetReader()
+
+	off, err := r.SeekTime(start.Add(30 * time.Second))
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(6, off)
+
+	r.LimitTime(start.Add(time.Minute))
+
+	data, err := ioutil.ReadAll(r)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("second\n", string(data))
+
+	r = buf.GetReader()
+
+	off, err = r.SeekTime(start.Add(-time.Hour))
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(0, off)
+
+	data, err = ioutil.ReadAll(r)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("first\nsecond\nthird\n", string(data))
+
+	sr := buf.GetStreamingReader()
+
+	off, err = sr.SeekTime(start.Add(time.Minute))
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(6, off)
+
+	// limit has already passed, so the streaming reader doesn't block
+	sr.LimitTime(start.Add(2 * time.Minute))
+
+	data, err = ioutil.ReadAll(sr)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("second\nthird\n", string(data))
+}
+
 func TestCircularSuite(t *testing.T) {
 	suite.Run(t, new(CircularSuite))
 }
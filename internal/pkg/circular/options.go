@@ -4,13 +4,18 @@
 
 package circular
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // Options defines settings for Buffer.
 type Options struct {
 	InitialCapacity int
 	MaxCapacity     int
 	SafetyGap       int
+
+	TimeSource func() time.Time
 }
 
 // defaultOptions returns default initial values.
@@ -19,6 +24,7 @@ func defaultOptions() Options {
 		InitialCapacity: 16384,
 		MaxCapacity:     1048576,
 		SafetyGap:       1024,
+		TimeSource:      time.Now,
 	}
 }
 
@@ -67,3 +73,18 @@ func WithSafetyGap(gap int) OptionFunc {
 		return nil
 	}
 }
+
+// WithTimeSource sets the source of the timestamps of the writes.
+//
+// Default is time.Now.
+func WithTimeSource(now func() time.Time) OptionFunc {
+	return func(opt *Options) error {
+		if now == nil {
+			return fmt.Errorf("time source should be set")
+		}
+
+		opt.TimeSource = now
+
+		return nil
+	}
+}
@@ -40,7 +40,7 @@ func (r *Reader) Read(p []byte) (n int, err error) {
 		return
 	}
 
-	if r.off == r.endOff {
+	if r.off >= r.endOff {
 		err = io.EOF
 
 		return
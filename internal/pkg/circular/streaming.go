@@ -7,6 +7,7 @@ package circular
 import (
 	"io"
 	"sync/atomic"
+	"time"
 )
 
 // StreamingReader implements seekable reader with local position in the Buffer.
@@ -20,6 +21,10 @@ type StreamingReader struct {
 	initialOff int64
 	off        int64
 
+	// time limit set by LimitTime, protected by buf.mu
+	until *time.Time
+	timer *time.Timer
+
 	closed uint32
 }
 
@@ -44,6 +49,12 @@ func (r *StreamingReader) Read(p []byte) (n int, err error) {
 	}
 
 	for r.off == r.buf.off {
+		if r.untilPassed() {
+			err = io.EOF
+
+			return
+		}
+
 		r.buf.cond.Wait()
 
 		if atomic.LoadUint32(&r.closed) > 0 {
@@ -53,7 +64,19 @@ func (r *StreamingReader) Read(p []byte) (n int, err error) {
 		}
 	}
 
-	n = int(r.buf.off - r.off)
+	end := r.buf.off
+
+	if r.until != nil {
+		end = r.buf.offsetUntil(*r.until)
+
+		if r.off >= end {
+			err = io.EOF
+
+			return
+		}
+	}
+
+	n = int(end - r.off)
 	if n > len(p) {
 		n = len(p)
 	}
@@ -76,6 +99,12 @@ func (r *StreamingReader) Read(p []byte) (n int, err error) {
 // Close implements io.Closer.
 func (r *StreamingReader) Close() error {
 	if atomic.CompareAndSwapUint32(&r.closed, 0, 1) {
+		r.buf.mu.Lock()
+		if r.timer != nil {
+			r.timer.Stop()
+		}
+		r.buf.mu.Unlock()
+
 		// wake up readers
 		r.buf.cond.Broadcast()
 	}
@@ -0,0 +1,153 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package circular
+
+import (
+	"io"
+	"sort"
+	"time"
+)
+
+// TimestampResolution is the precision of the write timestamps.
+const TimestampResolution = time.Second
+
+// mark records the offset of the first write made in the second.
+type mark struct {
+	off int64
+	sec int64
+}
+
+// addMark records the timestamp of the write at the current offset.
+//
+// addMark should be called with buf.mu held.
+func (buf *Buffer) addMark(t time.Time) {
+	sec := t.Unix()
+
+	// marks are kept sorted, so the write in the same second (or with the clock going back)
+	// is covered by the last mark
+	if len(buf.marks) > 0 && buf.marks[len(buf.marks)-1].sec >= sec {
+		return
+	}
+
+	// drop the marks for the overwritten data, keeping the one which covers the oldest available byte
+	minOff := buf.off - int64(buf.opt.MaxCapacity)
+
+	i := 0
+	for i+1 < len(buf.marks) && buf.marks[i+1].off <= minOff {
+		i++
+	}
+
+	buf.marks = append(buf.marks[i:], mark{off: buf.off, sec: sec})
+}
+
+// offsetSince returns the offset of the first byte written at or after t.
+//
+// offsetSince should be called with buf.mu held.
+func (buf *Buffer) offsetSince(t time.Time) int64 {
+	sec := t.Unix()
+
+	i := sort.Search(len(buf.marks), func(i int) bool { return buf.marks[i].sec >= sec })
+	if i == len(buf.marks) {
+		return buf.off
+	}
+
+	return buf.marks[i].off
+}
+
+// offsetUntil returns the offset after the last byte written at or before t.
+//
+// offsetUntil should be called with buf.mu held.
+func (buf *Buffer) offsetUntil(t time.Time) int64 {
+	sec := t.Unix()
+
+	i := sort.Search(len(buf.marks), func(i int) bool { return buf.marks[i].sec > sec })
+	if i == len(buf.marks) {
+		return buf.off
+	}
+
+	return buf.marks[i].off
+}
+
+// SeekTime positions the reader at the first byte written at or after t.
+//
+// Timestamps have TimestampResolution precision.
+func (r *Reader) SeekTime(t time.Time) (int64, error) {
+	r.buf.mu.Lock()
+	off := r.buf.offsetSince(t)
+	r.buf.mu.Unlock()
+
+	if off < r.startOff {
+		off = r.startOff
+	}
+
+	return r.Seek(off-r.startOff, io.SeekStart)
+}
+
+// LimitTime stops reading at the data written after t.
+//
+// Timestamps have TimestampResolution precision.
+func (r *Reader) LimitTime(t time.Time) {
+	r.buf.mu.Lock()
+	off := r.buf.offsetUntil(t)
+	r.buf.mu.Unlock()
+
+	if off < r.endOff {
+		r.endOff = off
+	}
+}
+
+// SeekTime positions the reader at the first byte written at or after t.
+//
+// Timestamps have TimestampResolution precision.
+func (r *StreamingReader) SeekTime(t time.Time) (int64, error) {
+	r.buf.mu.Lock()
+	off := r.buf.offsetSince(t)
+	r.buf.mu.Unlock()
+
+	if off < r.initialOff {
+		off = r.initialOff
+	}
+
+	return r.Seek(off-r.initialOff, io.SeekStart)
+}
+
+// LimitTime stops reading at the data written after t.
+//
+// Once the data written after t is reached, or t passes while waiting for new data,
+// Read returns io.EOF.
+//
+// Timestamps have TimestampResolution precision.
+func (r *StreamingReader) LimitTime(t time.Time) {
+	r.buf.mu.Lock()
+	defer r.buf.mu.Unlock()
+
+	until := t.Truncate(TimestampResolution)
+	r.until = &until
+
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+
+	// wake up the reader once the limit passes, as there might be no writes
+	if d := until.Add(TimestampResolution).Sub(r.buf.opt.TimeSource()); d > 0 {
+		r.timer = time.AfterFunc(d, func() {
+			r.buf.mu.Lock()
+			defer r.buf.mu.Unlock()
+
+			r.buf.cond.Broadcast()
+		})
+	}
+}
+
+// untilPassed returns true if all the following writes are going to be after the time limit.
+//
+// untilPassed should be called with buf.mu held.
+func (r *StreamingReader) untilPassed() bool {
+	if r.until == nil {
+		return false
+	}
+
+	return r.buf.opt.TimeSource().Unix() > r.until.Unix()
+}
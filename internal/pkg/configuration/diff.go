@@ -0,0 +1,401 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configuration
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// Paths of the configuration sections which might be applied without a reboot.
+const (
+	PathSysctls           = "machine.sysctls"
+	PathFiles             = "machine.files"
+	PathRegistries        = "machine.registries"
+	PathKubeletExtraArgs  = "machine.kubelet.extraArgs"
+	PathNetworkInterfaces = "machine.network.interfaces"
+	PathLogging           = "machine.logging"
+)
+
+// Changes describes the difference between the current and the new machine configuration.
+type Changes struct {
+	// Sysctls which were added or changed.
+	Sysctls map[string]string
+	// Files which were added or changed.
+	Files []config.File
+
+	Registries        bool
+	KubeletExtraArgs  bool
+	NetworkInterfaces bool
+	Logging           bool
+
+	// RebootRequired lists configuration paths which can't be applied without a reboot.
+	RebootRequired []string
+}
+
+// Live lists configuration paths which can be applied without a reboot.
+func (c *Changes) Live() []string {
+	var live []string
+
+	sysctls := make([]string, 0, len(c.Sysctls))
+
+	for key := range c.Sysctls {
+		sysctls = append(sysctls, PathSysctls+"."+key)
+	}
+
+	sort.Strings(sysctls)
+
+	live = append(live, sysctls...)
+
+	for _, f := range c.Files {
+		live = append(live, PathFiles+"."+f.Path())
+	}
+
+	if c.Registries {
+		live = append(live, PathRegistries)
+	}
+
+	if c.KubeletExtraArgs {
+		live = append(live, PathKubeletExtraArgs)
+	}
+
+	if c.NetworkInterfaces {
+		live = append(live, PathNetworkInterfaces)
+	}
+
+	if c.Logging {
+		live = append(live, PathLogging)
+	}
+
+	return live
+}
+
+// Diff compares current and new machine configuration.
+//
+// Changes to the sections which can be applied live are returned as part of Changes,
+// all other differences are listed in RebootRequired.
+func Diff(current, next config.Provider) (*Changes, error) {
+	changes := &Changes{
+		Sysctls: map[string]string{},
+	}
+
+	currentSysctls := current.Machine().Sysctls()
+
+	for key, value := range next.Machine().Sysctls() {
+		if currentValue, ok := currentSysctls[key]; !ok || currentValue != value {
+			changes.Sysctls[key] = value
+		}
+	}
+
+	for key := range currentSysctls {
+		if _, ok := next.Machine().Sysctls()[key]; !ok {
+			// there's no way to find out the default value of the removed sysctl
+			changes.RebootRequired = append(changes.RebootRequired, PathSysctls+"."+key)
+		}
+	}
+
+	if err := diffFiles(current, next, changes); err != nil {
+		return nil, err
+	}
+
+	changes.KubeletExtraArgs = !reflect.DeepEqual(current.Machine().Kubelet().ExtraArgs(), next.Machine().Kubelet().ExtraArgs())
+
+	currentTree, err := toTree(current)
+	if err != nil {
+		return nil, err
+	}
+
+	nextTree, err := toTree(next)
+	if err != nil {
+		return nil, err
+	}
+
+	changes.Registries = !reflect.DeepEqual(pluck(currentTree, PathRegistries), pluck(nextTree, PathRegistries))
+	changes.NetworkInterfaces = !reflect.DeepEqual(pluck(currentTree, PathNetworkInterfaces), pluck(nextTree, PathNetworkInterfaces))
+	changes.Logging = !reflect.DeepEqual(pluck(currentTree, PathLogging), pluck(nextTree, PathLogging))
+
+	for _, path := range []string{PathSysctls, PathFiles, PathKubeletExtraArgs} {
+		pluck(currentTree, path)
+		pluck(nextTree, path)
+	}
+
+	changes.RebootRequired = append(changes.RebootRequired, diffTrees("", currentTree, nextTree, 2)...)
+
+	sort.Strings(changes.RebootRequired)
+
+	return changes, nil
+}
+
+func diffFiles(current, next config.Provider, changes *Changes) error {
+	currentFiles, err := current.Machine().Files()
+	if err != nil {
+		return fmt.Errorf("error listing current machine files: %w", err)
+	}
+
+	nextFiles, err := next.Machine().Files()
+	if err != nil {
+		return fmt.Errorf("error listing new machine files: %w", err)
+	}
+
+	currentByPath := filesByPath(currentFiles)
+	nextByPath := filesByPath(nextFiles)
+
+	for _, f := range nextFiles {
+		path := f.Path()
+		nextEntries := nextByPath[path]
+		currentEntries := currentByPath[path]
+
+		if nextEntries[0] != f || filesEqual(currentEntries, nextEntries) {
+			continue
+		}
+
+		// appending to the file again would duplicate the contents, and several
+		// entries for the same path depend on the order they are applied at boot
+		if len(nextEntries) > 1 || len(currentEntries) > 1 ||
+			nextEntries[0].Op() == "append" || (len(currentEntries) == 1 && currentEntries[0].Op() == "append") {
+			changes.RebootRequired = append(changes.RebootRequired, PathFiles+"."+path)
+
+			continue
+		}
+
+		changes.Files = append(changes.Files, nextEntries[0])
+	}
+
+	for path := range currentByPath {
+		if _, ok := nextByPath[path]; !ok {
+			changes.RebootRequired = append(changes.RebootRequired, PathFiles+"."+path)
+		}
+	}
+
+	return nil
+}
+
+func filesByPath(files []config.File) map[string][]config.File {
+	result := map[string][]config.File{}
+
+	for _, f := range files {
+		result[f.Path()] = append(result[f.Path()], f)
+	}
+
+	return result
+}
+
+func filesEqual(a, b []config.File) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Content() != b[i].Content() || a[i].Permissions() != b[i].Permissions() || a[i].Op() != b[i].Op() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func toTree(cfg config.Provider) (map[string]interface{}, error) {
+	b, err := cfg.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	tree := map[string]interface{}{}
+
+	if err = yaml.Unmarshal(b, &tree); err != nil {
+		return nil, fmt.Errorf("error decoding configuration: %w", err)
+	}
+
+	return tree, nil
+}
+
+// pluck removes the value at the dotted path from the tree and returns it.
+func pluck(tree map[string]interface{}, path string) interface{} {
+	parts := strings.Split(path, ".")
+
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := tree[part].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		tree = next
+	}
+
+	key := parts[len(parts)-1]
+	value := tree[key]
+
+	delete(tree, key)
+
+	return value
+}
+
+// diffTrees returns paths which differ down to the specified depth.
+func diffTrees(prefix string, a, b map[string]interface{}, depth int) []string {
+	var paths []string
+
+	keys := map[string]struct{}{}
+
+	for key := range a {
+		keys[key] = struct{}{}
+	}
+
+	for key := range b {
+		keys[key] = struct{}{}
+	}
+
+	for key := range keys {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		if reflect.DeepEqual(a[key], b[key]) || (isEmpty(a[key]) && isEmpty(b[key])) {
+			continue
+		}
+
+		subA, okA := a[key].(map[string]interface{})
+		subB, okB := b[key].(map[string]interface{})
+
+		if depth > 1 && (okA || a[key] == nil) && (okB || b[key] == nil) {
+			paths = append(paths, diffTrees(path, subA, subB, depth-1)...)
+
+			continue
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths
+}
+
+func isEmpty(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	m, ok := v.(map[string]interface{})
+
+	return ok && len(m) == 0
+}
+
+// Merge returns the configuration the machine should run with until the next reboot:
+// the current configuration with only the live changes from the next configuration applied.
+//
+// Sections listed in RebootRequired keep their current values.
+func Merge(current, next config.Provider, changes *Changes) ([]byte, error) {
+	currentTree, err := toTree(current)
+	if err != nil {
+		return nil, err
+	}
+
+	nextTree, err := toTree(next)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(changes.Sysctls) > 0 {
+		sysctls, _ := lookup(currentTree, PathSysctls).(map[string]interface{})
+		if sysctls == nil {
+			sysctls = map[string]interface{}{}
+		}
+
+		for key, value := range changes.Sysctls {
+			sysctls[key] = value
+		}
+
+		place(currentTree, PathSysctls, sysctls)
+	}
+
+	if len(changes.Files) > 0 {
+		currentFiles, _ := lookup(currentTree, PathFiles).([]interface{})
+		nextFiles, _ := lookup(nextTree, PathFiles).([]interface{})
+
+		for _, f := range changes.Files {
+			entry := nextFiles[indexFile(nextFiles, f.Path())]
+
+			if i := indexFile(currentFiles, f.Path()); i >= 0 {
+				currentFiles[i] = entry
+			} else {
+				currentFiles = append(currentFiles, entry)
+			}
+		}
+
+		place(currentTree, PathFiles, currentFiles)
+	}
+
+	for _, section := range []struct {
+		path    string
+		changed bool
+	}{
+		{PathRegistries, changes.Registries},
+		{PathKubeletExtraArgs, changes.KubeletExtraArgs},
+		{PathNetworkInterfaces, changes.NetworkInterfaces},
+		{PathLogging, changes.Logging},
+	} {
+		if section.changed {
+			place(currentTree, section.path, lookup(nextTree, section.path))
+		}
+	}
+
+	return yaml.Marshal(currentTree)
+}
+
+// lookup returns the value at the dotted path in the tree.
+func lookup(tree map[string]interface{}, path string) interface{} {
+	parts := strings.Split(path, ".")
+
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := tree[part].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		tree = next
+	}
+
+	return tree[parts[len(parts)-1]]
+}
+
+// place sets the value at the dotted path in the tree, nil value removes the key.
+func place(tree map[string]interface{}, path string, value interface{}) {
+	parts := strings.Split(path, ".")
+
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := tree[part].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			tree[part] = next
+		}
+
+		tree = next
+	}
+
+	key := parts[len(parts)-1]
+
+	if value == nil {
+		delete(tree, key)
+
+		return
+	}
+
+	tree[key] = value
+}
+
+func indexFile(files []interface{}, path string) int {
+	for i, f := range files {
+		if entry, ok := f.(map[string]interface{}); ok && entry["path"] == path {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,196 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configuration_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/pkg/configuration"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+type DiffSuite struct {
+	suite.Suite
+}
+
+func TestDiffSuite(t *testing.T) {
+	suite.Run(t, new(DiffSuite))
+}
+
+func (suite *DiffSuite) TestNoChanges() {
+	changes, err := configuration.Diff(sampleConfig(), sampleConfig())
+	suite.Require().NoError(err)
+
+	suite.Assert().Empty(changes.Live())
+	suite.Assert().Empty(changes.RebootRequired)
+}
+
+func (suite *DiffSuite) TestLiveChanges() {
+	next := sampleConfig()
+	next.MachineConfig.MachineSysctls["net.ipv4.ip_forward"] = "0"
+	next.MachineConfig.MachineSysctls["vm.swappiness"] = "10"
+	next.MachineConfig.MachineFiles[0].FileContent = "updated"
+	next.MachineConfig.MachineKubelet.KubeletExtraArgs["node-labels"] = "foo=bar"
+	next.MachineConfig.MachineNetwork.NetworkInterfaces[0].DeviceMTU = 9000
+	next.MachineConfig.MachineRegistries.RegistryMirrors = map[string]*v1alpha1.RegistryMirrorConfig{
+		"docker.io": {
+			MirrorEndpoints: []string{"http://127.0.0.1:5000"},
+		},
+	}
+	next.MachineConfig.MachineLogging = &v1alpha1.LoggingConfig{
+		LoggingDestinations: []*v1alpha1.LoggingDestination{
+			{
+				LoggingEndpoint: &v1alpha1.Endpoint{URL: &url.URL{Scheme: "udp", Host: "10.5.0.1:514"}},
+				LoggingFormat:   "rfc5424",
+			},
+		},
+	}
+
+	changes, err := configuration.Diff(sampleConfig(), next)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(map[string]string{
+		"net.ipv4.ip_forward": "0",
+		"vm.swappiness":       "10",
+	}, changes.Sysctls)
+	suite.Require().Len(changes.Files, 1)
+	suite.Assert().Equal("updated", changes.Files[0].Content())
+	suite.Assert().True(changes.KubeletExtraArgs)
+	suite.Assert().True(changes.NetworkInterfaces)
+	suite.Assert().True(changes.Registries)
+	suite.Assert().True(changes.Logging)
+	suite.Assert().Empty(changes.RebootRequired)
+
+	suite.Assert().Equal([]string{
+		"machine.sysctls.net.ipv4.ip_forward",
+		"machine.sysctls.vm.swappiness",
+		"machine.files./var/etc/motd",
+		"machine.registries",
+		"machine.kubelet.extraArgs",
+		"machine.network.interfaces",
+		"machine.logging",
+	}, changes.Live())
+}
+
+func (suite *DiffSuite) TestRebootRequired() {
+	next := sampleConfig()
+	delete(next.MachineConfig.MachineSysctls, "net.ipv4.ip_forward")
+	next.MachineConfig.MachineFiles[1].FileContent = "nameserver 1.1.1.1"
+	next.MachineConfig.MachineInstall.InstallDisk = "/dev/sdb"
+	next.MachineConfig.MachineKubelet.KubeletImage = "kubelet:v2"
+	next.MachineConfig.MachineNetwork.NetworkHostname = "other"
+
+	changes, err := configuration.Diff(sampleConfig(), next)
+	suite.Require().NoError(err)
+
+	suite.Assert().Empty(changes.Live())
+	suite.Assert().Equal([]string{
+		"machine.files./etc/resolv.conf",
+		"machine.install",
+		"machine.kubelet",
+		"machine.network",
+		"machine.sysctls.net.ipv4.ip_forward",
+	}, changes.RebootRequired)
+}
+
+func (suite *DiffSuite) TestMerge() {
+	next := sampleConfig()
+	next.MachineConfig.MachineSysctls["vm.swappiness"] = "10"
+	next.MachineConfig.MachineFiles[0].FileContent = "updated"
+	next.MachineConfig.MachineKubelet.KubeletExtraArgs["node-labels"] = "foo=bar"
+	next.MachineConfig.MachineKubelet.KubeletImage = "kubelet:v2"
+	next.MachineConfig.MachineInstall.InstallDisk = "/dev/sdb"
+	next.MachineConfig.MachineNetwork.NetworkHostname = "other"
+	next.MachineConfig.MachineNetwork.NetworkInterfaces[0].DeviceMTU = 9000
+
+	changes, err := configuration.Diff(sampleConfig(), next)
+	suite.Require().NoError(err)
+
+	b, err := configuration.Merge(sampleConfig(), next, changes)
+	suite.Require().NoError(err)
+
+	merged, err := configloader.NewFromBytes(b)
+	suite.Require().NoError(err)
+
+	// live changes are applied
+	suite.Assert().Equal("10", merged.Machine().Sysctls()["vm.swappiness"])
+	suite.Assert().Equal("1", merged.Machine().Sysctls()["net.ipv4.ip_forward"])
+	suite.Assert().Equal("foo=bar", merged.Machine().Kubelet().ExtraArgs()["node-labels"])
+	suite.Assert().Equal(9000, merged.Machine().Network().Devices()[0].MTU())
+
+	files, err := merged.Machine().Files()
+	suite.Require().NoError(err)
+	suite.Require().Len(files, 2)
+	suite.Assert().Equal("updated", files[0].Content())
+
+	// changes which require a reboot keep the current values
+	suite.Assert().Equal("kubelet:v1", merged.Machine().Kubelet().Image())
+	suite.Assert().Equal("/dev/sda", merged.Machine().Install().Disk())
+	suite.Assert().Equal("worker-1", merged.Machine().Network().Hostname())
+
+	changes, err = configuration.Diff(merged, next)
+	suite.Require().NoError(err)
+
+	suite.Assert().Empty(changes.Live())
+	suite.Assert().Equal([]string{
+		"machine.install",
+		"machine.kubelet",
+		"machine.network",
+	}, changes.RebootRequired)
+}
+
+func sampleConfig() *v1alpha1.Config {
+	return &v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: "worker",
+			MachineInstall: &v1alpha1.InstallConfig{
+				InstallDisk: "/dev/sda",
+			},
+			MachineKubelet: &v1alpha1.KubeletConfig{
+				KubeletImage: "kubelet:v1",
+				KubeletExtraArgs: map[string]string{
+					"feature-gates": "ServerSideApply=true",
+				},
+			},
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkHostname: "worker-1",
+				NetworkInterfaces: []*v1alpha1.Device{
+					{
+						DeviceInterface: "eth0",
+						DeviceDHCP:      true,
+					},
+				},
+			},
+			MachineFiles: []*v1alpha1.MachineFile{
+				{
+					FileContent:     "hello",
+					FilePermissions: 0o644,
+					FilePath:        "/var/etc/motd",
+					FileOp:          "create",
+				},
+				{
+					FileContent:     "nameserver 8.8.8.8",
+					FilePermissions: 0o644,
+					FilePath:        "/etc/resolv.conf",
+					FileOp:          "append",
+				},
+			},
+			MachineSysctls: map[string]string{
+				"net.ipv4.ip_forward": "1",
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ClusterName: "test",
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{URL: &url.URL{Scheme: "https", Host: "10.5.0.1:6443"}},
+			},
+		},
+	}
+}
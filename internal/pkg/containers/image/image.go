@@ -6,15 +6,22 @@ package image
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"time"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/reference/docker"
+	"github.com/containerd/containerd/remotes"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/talos-systems/go-retry/retry"
 
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	containerdrunner "github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
@@ -32,14 +39,60 @@ const (
 	ImportRetryJitter   = time.Second
 )
 
+// PullOption configures Pull.
+type PullOption func(*PullOptions)
+
+// PullOptions describes the pull options.
+type PullOptions struct {
+	Verification config.ImageVerification
+	Events       runtime.Publisher
+}
+
+// WithVerification enforces the image signature verification policy.
+//
+// Verification is skipped if the policy is nil. Result of the verification is published
+// as machine.ImageVerificationEvent if the publisher is not nil.
+func WithVerification(verification config.ImageVerification, events runtime.Publisher) PullOption {
+	return func(o *PullOptions) {
+		o.Verification = verification
+		o.Events = events
+	}
+}
+
 // Pull is a convenience function that wraps the containerd image pull func with
 // retry functionality.
-func Pull(ctx context.Context, reg config.Registries, client *containerd.Client, ref string) (img containerd.Image, err error) {
+//
+// If the verification is enabled, the image is pulled by the digest of the verified image,
+// and the pulled image is stored under the original reference.
+//
+//nolint: gocyclo
+func Pull(ctx context.Context, reg config.Registries, client *containerd.Client, ref string, opts ...PullOption) (img containerd.Image, err error) {
+	var options PullOptions
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	resolver := NewResolver(reg)
 
+	pullRef := ref
+
+	if options.Verification != nil {
+		var verified *ocispec.Descriptor
+
+		if verified, err = verify(ctx, resolver, ref, nil, nil, options); err != nil {
+			return nil, err
+		}
+
+		// tag might be moved after the verification, so the verified digest is pulled
+		if pullRef, err = digestRef(ref, *verified); err != nil {
+			return nil, err
+		}
+	}
+
 	err = retry.Exponential(PullTimeout, retry.WithUnits(PullRetryInterval), retry.WithErrorLogging(true)).Retry(func() error {
-		if img, err = client.Pull(ctx, ref, containerd.WithPullUnpack, containerd.WithResolver(resolver)); err != nil {
-			err = fmt.Errorf("failed to pull image %q: %w", ref, err)
+		if img, err = client.Pull(ctx, pullRef, containerd.WithPullUnpack, containerd.WithResolver(resolver)); err != nil {
+			err = fmt.Errorf("failed to pull image %q: %w", pullRef, err)
 
 			if errdefs.IsNotFound(err) {
 				return retry.UnexpectedError(err)
@@ -55,7 +108,182 @@ func Pull(ctx context.Context, reg config.Registries, client *containerd.Client,
 		return nil, err
 	}
 
-	return img, nil
+	if pullRef == ref {
+		return img, nil
+	}
+
+	return tagImage(ctx, client, img, ref)
+}
+
+// digestRef returns the reference of the image by the digest of the descriptor.
+func digestRef(ref string, desc ocispec.Descriptor) (string, error) {
+	named, err := docker.ParseDockerRef(ref)
+	if err != nil {
+		return "", fmt.Errorf("error parsing image reference %q: %w", ref, err)
+	}
+
+	canonical, err := docker.WithDigest(docker.TrimNamed(named), desc.Digest)
+	if err != nil {
+		return "", err
+	}
+
+	return canonical.String(), nil
+}
+
+// tagImage stores the image pulled by the digest under the reference.
+func tagImage(ctx context.Context, client *containerd.Client, img containerd.Image, ref string) (containerd.Image, error) {
+	record := images.Image{
+		Name:   ref,
+		Target: img.Target(),
+	}
+
+	updated, err := client.ImageService().Update(ctx, record, "target")
+	if err != nil {
+		if !errdefs.IsNotFound(err) {
+			return nil, fmt.Errorf("error tagging image %q: %w", ref, err)
+		}
+
+		if updated, err = client.ImageService().Create(ctx, record); err != nil {
+			return nil, fmt.Errorf("error tagging image %q: %w", ref, err)
+		}
+	}
+
+	// the content is still referenced by the tagged image
+	//nolint: errcheck
+	client.ImageService().Delete(ctx, img.Name())
+
+	return containerd.NewImage(client, updated), nil
+}
+
+// Labels of the image which carry the detached image signature, see AttachSignature.
+const (
+	SignaturePayloadLabel = "talos.dev/signature-payload"
+	SignatureLabel        = "talos.dev/signature"
+)
+
+// Signature is the detached image signature in the cosign format.
+type Signature struct {
+	// Payload is the simple signing payload which binds the signature to the image digest.
+	Payload []byte
+	// Signature is the base64-encoded signature of the payload.
+	Signature string
+}
+
+// AttachSignature stores the detached signature in the image labels.
+//
+// Images with the attached signature are verified offline, e.g. the image imported from the archive
+// on the node without the registry access. Attached signature is not trusted by itself,
+// it's verified against the trusted keys of the verification policy.
+func AttachSignature(ctx context.Context, client *containerd.Client, img containerd.Image, sig *Signature) (containerd.Image, error) {
+	record := images.Image{
+		Name: img.Name(),
+		Labels: map[string]string{
+			SignaturePayloadLabel: string(sig.Payload),
+			SignatureLabel:        sig.Signature,
+		},
+	}
+
+	updated, err := client.ImageService().Update(ctx, record, "labels."+SignaturePayloadLabel, "labels."+SignatureLabel)
+	if err != nil {
+		return nil, fmt.Errorf("error attaching signature to image %q: %w", img.Name(), err)
+	}
+
+	return containerd.NewImage(client, updated), nil
+}
+
+// attachedSignature returns the signature attached to the image, nil if there is none.
+func attachedSignature(img containerd.Image) *Signature {
+	labels := img.Labels()
+
+	signature, ok := labels[SignatureLabel]
+	if !ok {
+		return nil
+	}
+
+	return &Signature{
+		Payload:   []byte(labels[SignaturePayloadLabel]),
+		Signature: signature,
+	}
+}
+
+// Verify enforces the image signature verification policy for the image which is already present
+// in the containerd image store, e.g. imported from the archive.
+//
+// Verification is skipped if the policy is nil. The image is verified with the attached signature
+// (see AttachSignature) if there is one, otherwise signatures of the local image digest are fetched
+// from the repository of the image name.
+func Verify(ctx context.Context, reg config.Registries, img containerd.Image, opts ...PullOption) error {
+	var options PullOptions
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.Verification == nil {
+		return nil
+	}
+
+	target := img.Target()
+
+	_, err := verify(ctx, NewResolver(reg), img.Name(), &target, attachedSignature(img), options)
+
+	return err
+}
+
+// verify checks the image signature retrying on the registry errors.
+//
+// If the local image descriptor is nil, the image reference is resolved in the registry.
+// If the signature is not nil, the local image is verified with it without the registry access.
+func verify(ctx context.Context, resolver remotes.Resolver, ref string, local *ocispec.Descriptor, sig *Signature, options PullOptions) (*ocispec.Descriptor, error) {
+	verifier, err := NewVerifier(options.Verification.PublicKeys())
+	if err != nil {
+		return nil, fmt.Errorf("invalid image verification policy: %w", err)
+	}
+
+	var desc ocispec.Descriptor
+
+	err = retry.Exponential(PullTimeout, retry.WithUnits(PullRetryInterval), retry.WithErrorLogging(true)).Retry(func() error {
+		switch {
+		case local != nil && sig != nil:
+			desc = *local
+			err = verifier.VerifyPayload(sig.Payload, sig.Signature, desc.Digest.String())
+		case local != nil:
+			desc = *local
+			err = verifier.VerifyDigest(ctx, resolver, ref, desc)
+		default:
+			desc, err = verifier.Verify(ctx, resolver, ref)
+		}
+
+		if err != nil {
+			if errors.Is(err, ErrVerificationFailed) || errdefs.IsNotFound(err) {
+				return retry.UnexpectedError(err)
+			}
+
+			return retry.ExpectedError(err)
+		}
+
+		return nil
+	})
+
+	if options.Events != nil {
+		event := &machine.ImageVerificationEvent{
+			Image:    ref,
+			Digest:   desc.Digest.String(),
+			Verified: err == nil,
+		}
+
+		if err != nil {
+			event.Error = err.Error()
+		}
+
+		options.Events.Publish(event)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc, nil
 }
 
 // Import is a convenience function that wraps containerd image import with retries.
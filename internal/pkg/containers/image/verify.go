@@ -0,0 +1,245 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/reference/docker"
+	"github.com/containerd/containerd/remotes"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// ErrVerificationFailed is returned when the image signature can't be verified.
+var ErrVerificationFailed = errors.New("image signature verification failed")
+
+// Signature format follows cosign conventions: signatures are stored in the same repository
+// as an image tagged "<algorithm>-<hex>.sig", each layer of the signature image is a simple signing
+// payload with the signature in the layer annotation.
+const (
+	signatureTagSuffix  = ".sig"
+	signatureAnnotation = "dev.cosignproject.cosign/signature"
+
+	maxSignatureManifestSize = 1 << 20
+	maxSignaturePayloadSize  = 1 << 20
+)
+
+// simpleSigningPayload is the signed payload which binds the signature to the image digest.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// Verifier verifies image signatures against the trusted public keys.
+type Verifier struct {
+	keys []crypto.PublicKey
+}
+
+// NewVerifier creates Verifier from the PEM-encoded public keys.
+func NewVerifier(pemKeys []string) (*Verifier, error) {
+	if len(pemKeys) == 0 {
+		return nil, errors.New("no trusted public keys")
+	}
+
+	v := &Verifier{}
+
+	for i, pemKey := range pemKeys {
+		block, _ := pem.Decode([]byte(pemKey))
+		if block == nil {
+			return nil, fmt.Errorf("public key %d is not PEM-encoded", i)
+		}
+
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing public key %d: %w", i, err)
+		}
+
+		v.keys = append(v.keys, key)
+	}
+
+	return v, nil
+}
+
+// Verify resolves the image reference and checks that the image digest is signed with one of the trusted keys.
+//
+// Verify returns the descriptor of the verified image.
+func (v *Verifier) Verify(ctx context.Context, resolver remotes.Resolver, ref string) (ocispec.Descriptor, error) {
+	_, desc, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return desc, fmt.Errorf("error resolving image %q: %w", ref, err)
+	}
+
+	return desc, v.VerifyDigest(ctx, resolver, ref, desc)
+}
+
+// VerifyDigest checks that the image digest is signed with one of the trusted keys.
+//
+// The image described by desc might be already present locally (e.g. imported from the archive),
+// signatures are fetched from the repository of the image reference.
+func (v *Verifier) VerifyDigest(ctx context.Context, resolver remotes.Resolver, ref string, desc ocispec.Descriptor) error {
+	sigRef, err := signatureRef(ref, desc.Digest.String())
+	if err != nil {
+		return err
+	}
+
+	name, sigDesc, err := resolver.Resolve(ctx, sigRef)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return fmt.Errorf("%w: image %s is not signed", ErrVerificationFailed, desc.Digest)
+		}
+
+		return fmt.Errorf("error resolving signature %q: %w", sigRef, err)
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	manifestData, err := fetchBlob(ctx, fetcher, sigDesc, maxSignatureManifestSize)
+	if err != nil {
+		return fmt.Errorf("error fetching signature manifest: %w", err)
+	}
+
+	var manifest ocispec.Manifest
+
+	if err = json.Unmarshal(manifestData, &manifest); err != nil {
+		return fmt.Errorf("error parsing signature manifest: %w", err)
+	}
+
+	verifyErr := fmt.Errorf("%w: no signatures found for image %s", ErrVerificationFailed, desc.Digest)
+
+	for _, layer := range manifest.Layers {
+		signature, ok := layer.Annotations[signatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		payload, err := fetchBlob(ctx, fetcher, layer, maxSignaturePayloadSize)
+		if err != nil {
+			return fmt.Errorf("error fetching signature payload: %w", err)
+		}
+
+		if verifyErr = v.VerifyPayload(payload, signature, desc.Digest.String()); verifyErr == nil {
+			return nil
+		}
+	}
+
+	return verifyErr
+}
+
+// VerifyPayload checks the base64-encoded signature of the simple signing payload and that
+// the payload was issued for the image digest.
+func (v *Verifier) VerifyPayload(payload []byte, signature, digest string) error {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("%w: error decoding signature: %s", ErrVerificationFailed, err)
+	}
+
+	verified := false
+
+	for _, key := range v.keys {
+		if verifySignature(key, payload, sig) {
+			verified = true
+
+			break
+		}
+	}
+
+	if !verified {
+		return fmt.Errorf("%w: signature of image %s doesn't match any of the trusted keys", ErrVerificationFailed, digest)
+	}
+
+	var p simpleSigningPayload
+
+	if err = json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("%w: error parsing signature payload: %s", ErrVerificationFailed, err)
+	}
+
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("%w: signature was issued for image %s, expected %s", ErrVerificationFailed, p.Critical.Image.DockerManifestDigest, digest)
+	}
+
+	return nil
+}
+
+func verifySignature(key crypto.PublicKey, payload, sig []byte) bool {
+	hash := sha256.Sum256(payload)
+
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, hash[:], sig)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], sig) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, payload, sig)
+	default:
+		return false
+	}
+}
+
+// signatureRef builds the reference of the signature image for the image digest.
+func signatureRef(ref, digest string) (string, error) {
+	named, err := docker.ParseDockerRef(ref)
+	if err != nil {
+		return "", fmt.Errorf("error parsing image reference %q: %w", ref, err)
+	}
+
+	return named.Name() + ":" + strings.Replace(digest, ":", "-", 1) + signatureTagSuffix, nil
+}
+
+// fetchBlob fetches the blob and verifies the digest of the contents.
+func fetchBlob(ctx context.Context, fetcher remotes.Fetcher, desc ocispec.Descriptor, maxSize int64) ([]byte, error) {
+	if err := desc.Digest.Validate(); err != nil {
+		return nil, err
+	}
+
+	if desc.Size > maxSize {
+		return nil, fmt.Errorf("blob %s is too big: %d bytes", desc.Digest, desc.Size)
+	}
+
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint: errcheck
+	defer rc.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(rc, maxSize))
+	if err != nil {
+		return nil, err
+	}
+
+	verifier := desc.Digest.Verifier()
+
+	if _, err = verifier.Write(data); err != nil {
+		return nil, err
+	}
+
+	if !verifier.Verified() {
+		return nil, fmt.Errorf("digest mismatch for blob %s", desc.Digest)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
+)
+
+const testDigest = "sha256:2d1b1ed9b9cdcdd1bc8a2ae4d8ad08d1d3fdd6a6e0b0ad1d25e6c9c6d1e4f2a7"
+
+type VerifySuite struct {
+	suite.Suite
+
+	key    *ecdsa.PrivateKey
+	pemKey string
+}
+
+func (suite *VerifySuite) SetupSuite() {
+	suite.key, suite.pemKey = suite.generateKey()
+}
+
+func (suite *VerifySuite) generateKey() (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	suite.Require().NoError(err)
+
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func (suite *VerifySuite) sign(key *ecdsa.PrivateKey, payload []byte) string {
+	hash := sha256.Sum256(payload)
+
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	suite.Require().NoError(err)
+
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func payload(digest string) []byte {
+	return []byte(`{"critical":{"identity":{"docker-reference":"ghcr.io/talos-systems/installer"},"image":{"docker-manifest-digest":"` + digest + `"},"type":"cosign container image signature"},"optional":null}`)
+}
+
+func (suite *VerifySuite) TestNewVerifier() {
+	_, err := image.NewVerifier(nil)
+	suite.Require().Error(err)
+
+	_, err = image.NewVerifier([]string{"not a key"})
+	suite.Require().Error(err)
+
+	_, err = image.NewVerifier([]string{suite.pemKey})
+	suite.Require().NoError(err)
+}
+
+func (suite *VerifySuite) TestVerifyPayload() {
+	_, otherPEMKey := suite.generateKey()
+
+	verifier, err := image.NewVerifier([]string{otherPEMKey, suite.pemKey})
+	suite.Require().NoError(err)
+
+	p := payload(testDigest)
+
+	suite.Require().NoError(verifier.VerifyPayload(p, suite.sign(suite.key, p), testDigest))
+}
+
+func (suite *VerifySuite) TestVerifyPayloadWrongKey() {
+	otherKey, otherPEMKey := suite.generateKey()
+
+	verifier, err := image.NewVerifier([]string{otherPEMKey})
+	suite.Require().NoError(err)
+
+	p := payload(testDigest)
+
+	err = verifier.VerifyPayload(p, suite.sign(suite.key, p), testDigest)
+	suite.Require().Error(err)
+	suite.Assert().True(errors.Is(err, image.ErrVerificationFailed))
+
+	// signature of the other payload
+	err = verifier.VerifyPayload(p, suite.sign(otherKey, payload("sha256:0000")), testDigest)
+	suite.Require().Error(err)
+	suite.Assert().True(errors.Is(err, image.ErrVerificationFailed))
+
+	err = verifier.VerifyPayload(p, "!!!", testDigest)
+	suite.Require().Error(err)
+	suite.Assert().True(errors.Is(err, image.ErrVerificationFailed))
+}
+
+func (suite *VerifySuite) TestVerifyPayloadDigestMismatch() {
+	verifier, err := image.NewVerifier([]string{suite.pemKey})
+	suite.Require().NoError(err)
+
+	p := payload("sha256:0000")
+
+	err = verifier.VerifyPayload(p, suite.sign(suite.key, p), testDigest)
+	suite.Require().Error(err)
+	suite.Assert().True(errors.Is(err, image.ErrVerificationFailed))
+}
+
+func TestVerifySuite(t *testing.T) {
+	suite.Run(t, new(VerifySuite))
+}
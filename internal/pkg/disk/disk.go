@@ -0,0 +1,356 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package disk provides the inventory of the block devices of the node.
+package disk
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Bus types.
+const (
+	BusATA    = "ata"
+	BusSCSI   = "scsi"
+	BusNVMe   = "nvme"
+	BusVirtio = "virtio"
+	BusUSB    = "usb"
+	BusMMC    = "mmc"
+)
+
+const (
+	sysBlockPath = "/sys/block"
+	mountsPath   = "/proc/self/mounts"
+)
+
+// Disk describes a block device.
+type Disk struct {
+	// DeviceName is the path to the device (e.g. `/dev/sda`).
+	DeviceName string
+	Model      string
+	Serial     string
+	WWID       string
+	Size       uint64
+	Rotational bool
+	// Bus is one of the Bus* constants, empty if the bus is not known.
+	Bus string
+	// PartitionTable is `gpt`, `dos` or empty if the disk is not partitioned.
+	PartitionTable string
+	Partitions     []*Partition
+	// Filesystem is set if the whole disk is formatted (without a partition table).
+	Filesystem      string
+	FilesystemLabel string
+	MountPoint      string
+	// SMART is nil if the health can't be read.
+	SMART *SMART
+}
+
+// Partition describes a partition of the disk.
+type Partition struct {
+	Number     uint32
+	DeviceName string
+	// Label is the GPT partition name.
+	Label string
+	// Type is the GPT partition type GUID or the MBR partition type.
+	Type            string
+	Offset          uint64
+	Size            uint64
+	Filesystem      string
+	FilesystemLabel string
+	MountPoint      string
+}
+
+// List returns the disks of the node sorted by the device name.
+//
+// Virtual block devices (loop, device mapper, md, etc.) and optical drives are skipped.
+func List() ([]*Disk, error) {
+	entries, err := ioutil.ReadDir(sysBlockPath)
+	if err != nil {
+		return nil, err
+	}
+
+	mounts, err := readMounts(mountsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	disks := []*Disk{}
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if strings.HasPrefix(name, "sr") {
+			continue
+		}
+
+		sysPath := filepath.Join(sysBlockPath, name)
+
+		if _, err = os.Stat(filepath.Join(sysPath, "device")); err != nil {
+			// virtual device
+			continue
+		}
+
+		d := readDisk(sysPath, name, mounts)
+		if d.Size == 0 {
+			continue
+		}
+
+		disks = append(disks, d)
+	}
+
+	sort.Slice(disks, func(i, j int) bool { return disks[i].DeviceName < disks[j].DeviceName })
+
+	return disks, nil
+}
+
+// Size returns the size of the block device in bytes.
+func Size(devPath string) (uint64, error) {
+	f, err := os.Open(devPath)
+	if err != nil {
+		return 0, err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+
+	if size <= 0 {
+		return 0, fmt.Errorf("failed to get the size of %q", devPath)
+	}
+
+	return uint64(size), nil
+}
+
+// Probe returns the partition table type and the filesystem type of the block device.
+//
+// Both are empty if the device is blank or the filesystem is not recognized.
+// Read errors are returned, so that the device which can't be read is never treated as blank.
+func Probe(devPath string) (partitionTable, filesystem string, err error) {
+	resolved, err := filepath.EvalSymlinks(devPath)
+	if err != nil {
+		return "", "", err
+	}
+
+	f, err := os.Open(resolved)
+	if err != nil {
+		return "", "", err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	sectorSize := int64(readUint(filepath.Join("/sys/class/block", filepath.Base(resolved), "queue", "logical_block_size")))
+	if sectorSize == 0 {
+		sectorSize = 512
+	}
+
+	partitionTable, _, err = readPartitionTable(f, sectorSize)
+	if err != nil {
+		return "", "", fmt.Errorf("error reading partition table of %q: %w", devPath, err)
+	}
+
+	if partitionTable == "" {
+		filesystem, _, err = probeFilesystem(f)
+		if err != nil {
+			return "", "", fmt.Errorf("error probing filesystem of %q: %w", devPath, err)
+		}
+	}
+
+	return partitionTable, filesystem, nil
+}
+
+func readDisk(sysPath, name string, mounts map[string]string) *Disk {
+	d := &Disk{
+		DeviceName: "/dev/" + name,
+		Size:       readUint(filepath.Join(sysPath, "size")) * 512,
+		Model:      readString(filepath.Join(sysPath, "device", "model")),
+		Serial:     readSerial(sysPath),
+		WWID:       firstString(filepath.Join(sysPath, "wwid"), filepath.Join(sysPath, "device", "wwid")),
+		Rotational: readString(filepath.Join(sysPath, "queue", "rotational")) == "1",
+		Bus:        readBus(sysPath, name),
+		MountPoint: mounts["/dev/"+name],
+	}
+
+	sectorSize := int64(readUint(filepath.Join(sysPath, "queue", "logical_block_size")))
+	if sectorSize == 0 {
+		sectorSize = 512
+	}
+
+	var entries map[uint32]partitionEntry
+
+	// the inventory is best effort, the device which can't be read is listed without the details
+	if f, err := os.Open(d.DeviceName); err == nil {
+		d.PartitionTable, entries, _ = readPartitionTable(f, sectorSize) //nolint: errcheck
+
+		if d.PartitionTable == "" {
+			d.Filesystem, d.FilesystemLabel, _ = probeFilesystem(f) //nolint: errcheck
+		}
+
+		f.Close() //nolint: errcheck
+	}
+
+	d.Partitions = readPartitions(sysPath, name, entries, mounts)
+	d.SMART = readSMART(d.DeviceName, d.Bus)
+
+	return d
+}
+
+func readPartitions(sysPath, name string, entries map[uint32]partitionEntry, mounts map[string]string) []*Partition {
+	dirs, err := ioutil.ReadDir(sysPath)
+	if err != nil {
+		return nil
+	}
+
+	var partitions []*Partition
+
+	for _, dir := range dirs {
+		if !strings.HasPrefix(dir.Name(), name) {
+			continue
+		}
+
+		partPath := filepath.Join(sysPath, dir.Name())
+
+		if _, err = os.Stat(filepath.Join(partPath, "partition")); err != nil {
+			continue
+		}
+
+		p := &Partition{
+			Number:     uint32(readUint(filepath.Join(partPath, "partition"))),
+			DeviceName: "/dev/" + dir.Name(),
+			Offset:     readUint(filepath.Join(partPath, "start")) * 512,
+			Size:       readUint(filepath.Join(partPath, "size")) * 512,
+			MountPoint: mounts["/dev/"+dir.Name()],
+		}
+
+		if entry, ok := entries[p.Number]; ok {
+			p.Label = entry.label
+			p.Type = entry.typ
+		}
+
+		if f, err := os.Open(p.DeviceName); err == nil {
+			p.Filesystem, p.FilesystemLabel, _ = probeFilesystem(f) //nolint: errcheck
+
+			f.Close() //nolint: errcheck
+		}
+
+		partitions = append(partitions, p)
+	}
+
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i].Number < partitions[j].Number })
+
+	return partitions
+}
+
+// readSerial reads the serial number from sysfs, falling back to the SCSI VPD page 0x80.
+func readSerial(sysPath string) string {
+	if serial := firstString(filepath.Join(sysPath, "device", "serial"), filepath.Join(sysPath, "serial")); serial != "" {
+		return serial
+	}
+
+	vpd, err := ioutil.ReadFile(filepath.Join(sysPath, "device", "vpd_pg80"))
+	if err != nil || len(vpd) < 4 {
+		return ""
+	}
+
+	length := int(binary.BigEndian.Uint16(vpd[2:4]))
+	if 4+length > len(vpd) {
+		length = len(vpd) - 4
+	}
+
+	return strings.TrimSpace(string(vpd[4 : 4+length]))
+}
+
+// readBus detects the bus by the device name and the sysfs device path.
+func readBus(sysPath, name string) string {
+	switch {
+	case strings.HasPrefix(name, "nvme"):
+		return BusNVMe
+	case strings.HasPrefix(name, "mmcblk"):
+		return BusMMC
+	}
+
+	devPath, err := filepath.EvalSymlinks(sysPath)
+	if err != nil {
+		return ""
+	}
+
+	switch {
+	case strings.Contains(devPath, "/usb"):
+		return BusUSB
+	case strings.Contains(devPath, "/virtio"):
+		return BusVirtio
+	case strings.Contains(devPath, "/ata"):
+		return BusATA
+	case strings.Contains(devPath, "/host"):
+		return BusSCSI
+	}
+
+	return ""
+}
+
+// readMounts returns the map of the mounted devices to the mount points.
+func readMounts(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	mounts := map[string]string{}
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		if _, ok := mounts[fields[0]]; !ok {
+			mounts[fields[0]] = fields[1]
+		}
+	}
+
+	return mounts, scanner.Err()
+}
+
+func readString(path string) string {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(b))
+}
+
+func firstString(paths ...string) string {
+	for _, path := range paths {
+		if s := readString(path); s != "" {
+			return s
+		}
+	}
+
+	return ""
+}
+
+func readUint(path string) uint64 {
+	v, err := strconv.ParseUint(readString(path), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
@@ -0,0 +1,305 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// nolint: testpackage
+package disk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gptImage(sectorSize int) []byte {
+	img := make([]byte, 64*sectorSize)
+
+	// protective MBR
+	img[mbrPartitionOffset+4] = mbrGPTProtective
+	img[510], img[511] = 0x55, 0xaa
+
+	header := img[sectorSize:]
+	copy(header, gptSignature)
+	binary.LittleEndian.PutUint64(header[72:], 2)
+	binary.LittleEndian.PutUint32(header[80:], 4)
+	binary.LittleEndian.PutUint32(header[84:], 128)
+
+	entries := img[2*sectorSize:]
+
+	// Linux filesystem data
+	copy(entries[0:], []byte{0xaf, 0x3d, 0xc6, 0x0f, 0x83, 0x84, 0x72, 0x47, 0x8e, 0x79, 0x3d, 0x69, 0xd8, 0x47, 0x7d, 0xe4})
+
+	for i, c := range utf16.Encode([]rune("STATE")) {
+		binary.LittleEndian.PutUint16(entries[56+2*i:], c)
+	}
+
+	// third entry, second is unused
+	copy(entries[2*128:], entries[:128])
+
+	return img
+}
+
+func TestReadPartitionTableGPT(t *testing.T) {
+	for _, sectorSize := range []int{512, 4096} {
+		table, entries, err := readPartitionTable(bytes.NewReader(gptImage(sectorSize)), int64(sectorSize))
+		require.NoError(t, err)
+
+		assert.Equal(t, PartitionTableGPT, table)
+		require.Len(t, entries, 2)
+		assert.Equal(t, partitionEntry{label: "STATE", typ: "0FC63DAF-8483-4772-8E79-3D69D8477DE4"}, entries[1])
+		assert.Contains(t, entries, uint32(3))
+	}
+}
+
+func TestReadPartitionTableDOS(t *testing.T) {
+	img := make([]byte, 4096)
+	img[mbrPartitionOffset+16+4] = 0x83
+	img[510], img[511] = 0x55, 0xaa
+
+	table, entries, err := readPartitionTable(bytes.NewReader(img), 512)
+	require.NoError(t, err)
+
+	assert.Equal(t, PartitionTableDOS, table)
+	assert.Equal(t, map[uint32]partitionEntry{2: {typ: "0x83"}}, entries)
+
+	table, _, err = readPartitionTable(bytes.NewReader(make([]byte, 4096)), 512)
+	require.NoError(t, err)
+	assert.Empty(t, table)
+}
+
+// failingReader fails the reads past the offset, as the device with the bad sectors does.
+type failingReader struct {
+	*bytes.Reader
+
+	offset int64
+}
+
+func (r failingReader) ReadAt(b []byte, off int64) (int, error) {
+	if off+int64(len(b)) > r.offset {
+		return 0, errors.New("input/output error")
+	}
+
+	return r.Reader.ReadAt(b, off)
+}
+
+func TestReadPartitionTableError(t *testing.T) {
+	img := gptImage(512)
+
+	// GPT header can't be read
+	_, _, err := readPartitionTable(failingReader{bytes.NewReader(img), 512}, 512)
+	assert.Error(t, err)
+
+	// GPT entries can't be read
+	_, _, err = readPartitionTable(failingReader{bytes.NewReader(img), 1024}, 512)
+	assert.Error(t, err)
+
+	// device is too short for the partition table
+	table, _, err := readPartitionTable(bytes.NewReader(img[:256]), 512)
+	require.NoError(t, err)
+	assert.Empty(t, table)
+}
+
+func TestProbeFilesystem(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		setup         func(img []byte)
+		expectedType  string
+		expectedLabel string
+	}{
+		{
+			name: "xfs",
+			setup: func(img []byte) {
+				copy(img, "XFSB")
+				copy(img[108:], "EPHEMERAL")
+			},
+			expectedType:  FilesystemXFS,
+			expectedLabel: "EPHEMERAL",
+		},
+		{
+			name: "ext4",
+			setup: func(img []byte) {
+				img[0x438], img[0x439] = 0x53, 0xef
+				binary.LittleEndian.PutUint32(img[0x460:], 0x40)
+				copy(img[0x478:], "data")
+			},
+			expectedType:  FilesystemExt4,
+			expectedLabel: "data",
+		},
+		{
+			name: "ext3",
+			setup: func(img []byte) {
+				img[0x438], img[0x439] = 0x53, 0xef
+				binary.LittleEndian.PutUint32(img[0x45c:], 0x4)
+			},
+			expectedType: FilesystemExt3,
+		},
+		{
+			name: "vfat",
+			setup: func(img []byte) {
+				copy(img[0x47:], "EFI        ")
+				copy(img[0x52:], "FAT32   ")
+				img[510], img[511] = 0x55, 0xaa
+			},
+			expectedType:  FilesystemVFAT,
+			expectedLabel: "EFI",
+		},
+		{
+			name: "swap",
+			setup: func(img []byte) {
+				copy(img[4096-10:], "SWAPSPACE2")
+			},
+			expectedType: FilesystemSwap,
+		},
+		{
+			name: "md",
+			setup: func(img []byte) {
+				binary.LittleEndian.PutUint32(img[4096:], 0xa92b4efc)
+				copy(img[4096+32:], "talos:0")
+			},
+			expectedType:  FilesystemRAIDMember,
+			expectedLabel: "talos:0",
+		},
+		{
+			name:  "empty",
+			setup: func(img []byte) {},
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			img := make([]byte, superblockProbeSize)
+			tt.setup(img)
+
+			fsType, label, err := probeFilesystem(bytes.NewReader(img))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedType, fsType)
+			assert.Equal(t, tt.expectedLabel, label)
+		})
+	}
+}
+
+func TestProbeFilesystemShort(t *testing.T) {
+	fsType, _, err := probeFilesystem(bytes.NewReader([]byte("XFSB")))
+	require.NoError(t, err)
+	assert.Equal(t, FilesystemXFS, fsType)
+}
+
+func TestProbeFilesystemError(t *testing.T) {
+	img := make([]byte, superblockProbeSize)
+	copy(img, "XFSB")
+
+	_, _, err := probeFilesystem(failingReader{bytes.NewReader(img), 4096})
+	assert.Error(t, err)
+}
+
+func TestSize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	path := filepath.Join(dir, "disk")
+	require.NoError(t, ioutil.WriteFile(path, make([]byte, 1024*1024), 0o600))
+
+	size, err := Size(path)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1024*1024), size)
+
+	require.NoError(t, ioutil.WriteFile(path, nil, 0o600))
+
+	_, err = Size(path)
+	assert.Error(t, err)
+
+	_, err = Size(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestParseNVMeSMARTLog(t *testing.T) {
+	buf := make([]byte, nvmeSMARTLogSize)
+	binary.LittleEndian.PutUint16(buf[1:], 273+42)
+	buf[5] = 3
+	binary.LittleEndian.PutUint64(buf[128:], 1234)
+
+	assert.Equal(t, &SMART{
+		Passed:         true,
+		Temperature:    42,
+		PercentageUsed: 3,
+		PowerOnHours:   1234,
+	}, parseNVMeSMARTLog(buf))
+
+	buf[0] = 0x4
+
+	assert.False(t, parseNVMeSMARTLog(buf).Passed)
+}
+
+func TestParseATASMARTStatus(t *testing.T) {
+	sense := make([]byte, 32)
+	sense[0] = senseDescriptorFmt
+	sense[8] = senseATAReturn
+	sense[8+9], sense[8+11] = 0x4f, 0xc2
+
+	assert.Equal(t, &SMART{Passed: true}, parseATASMARTStatus(sense))
+
+	sense[8+9], sense[8+11] = 0xf4, 0x2c
+
+	assert.Equal(t, &SMART{Passed: false}, parseATASMARTStatus(sense))
+
+	assert.Nil(t, parseATASMARTStatus(make([]byte, 32)))
+}
+
+type selector struct {
+	minSize, maxSize   uint64
+	model, serial, bus string
+}
+
+func (s selector) MinSize() uint64 { return s.minSize }
+func (s selector) MaxSize() uint64 { return s.maxSize }
+func (s selector) Model() string   { return s.model }
+func (s selector) Serial() string  { return s.serial }
+func (s selector) Bus() string     { return s.bus }
+
+func TestFind(t *testing.T) {
+	disks := []*Disk{
+		{DeviceName: "/dev/nvme0n1", Size: 512e9, Model: "Samsung SSD 970", Serial: "S1", Bus: BusNVMe},
+		{DeviceName: "/dev/sda", Size: 16e9, Model: "Flash Drive", Serial: "U1", Bus: BusUSB},
+		{DeviceName: "/dev/sdb", Size: 4e12, Model: "WDC WD40EFRX", Serial: "W1", Bus: BusATA},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		selector selector
+		expected string
+	}{
+		{"size", selector{minSize: 100e9, maxSize: 1e12}, "/dev/nvme0n1"},
+		{"min size", selector{minSize: 1e12}, "/dev/sdb"},
+		{"model", selector{model: "WDC*"}, "/dev/sdb"},
+		{"serial", selector{serial: "U1"}, "/dev/sda"},
+		{"bus", selector{bus: BusUSB}, "/dev/sda"},
+		{"all", selector{minSize: 1e9, model: "Samsung*", bus: BusNVMe}, "/dev/nvme0n1"},
+		{"none", selector{model: "WDC*", bus: BusNVMe}, ""},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := Find(disks, tt.selector)
+
+			if tt.expected == "" {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, d.DeviceName)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package disk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+)
+
+// Filesystem types (as reported by blkid).
+const (
+	FilesystemXFS        = "xfs"
+	FilesystemExt2       = "ext2"
+	FilesystemExt3       = "ext3"
+	FilesystemExt4       = "ext4"
+	FilesystemVFAT       = "vfat"
+	FilesystemSwap       = "swap"
+	FilesystemISO9660    = "iso9660"
+	FilesystemLUKS       = "crypto_LUKS"
+	FilesystemLVM2       = "LVM2_member"
+	FilesystemRAIDMember = "linux_raid_member"
+)
+
+// superblockProbeSize covers all the superblocks probed below.
+const superblockProbeSize = 0x8800
+
+// probeFilesystem detects the filesystem type and label by the superblock.
+//
+// Empty type is returned if the filesystem is not recognized.
+func probeFilesystem(r io.ReaderAt) (string, string, error) {
+	buf := make([]byte, superblockProbeSize)
+
+	n, err := r.ReadAt(buf, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", "", err
+	}
+
+	buf = buf[:n]
+
+	at := func(offset int, magic string) bool {
+		return len(buf) >= offset+len(magic) && string(buf[offset:offset+len(magic)]) == magic
+	}
+
+	label := func(offset, size int) string {
+		if len(buf) < offset+size {
+			return ""
+		}
+
+		b := buf[offset : offset+size]
+
+		if i := bytes.IndexByte(b, 0); i >= 0 {
+			b = b[:i]
+		}
+
+		return strings.TrimSpace(string(b))
+	}
+
+	le32 := func(offset int) uint32 {
+		if len(buf) < offset+4 {
+			return 0
+		}
+
+		return binary.LittleEndian.Uint32(buf[offset : offset+4])
+	}
+
+	switch {
+	case at(0, "LUKS\xba\xbe"):
+		if at(6, "\x00\x02") {
+			return FilesystemLUKS, label(24, 48), nil
+		}
+
+		return FilesystemLUKS, "", nil
+	case at(0, "XFSB"):
+		return FilesystemXFS, label(108, 12), nil
+	case at(0x438, "\x53\xef"):
+		const (
+			compatHasJournal = 0x4
+			incompatExt4     = 0x40 | 0x80 | 0x200 // extents, 64bit, flex_bg
+		)
+
+		fsType := FilesystemExt2
+
+		switch {
+		case le32(0x460)&incompatExt4 != 0:
+			fsType = FilesystemExt4
+		case le32(0x45c)&compatHasJournal != 0:
+			fsType = FilesystemExt3
+		}
+
+		return fsType, label(0x478, 16), nil
+	case at(512+24, "LVM2 001") && at(512, "LABELONE"):
+		return FilesystemLVM2, "", nil
+	case le32(0) == 0xa92b4efc:
+		// md superblock version 1.1
+		return FilesystemRAIDMember, label(32, 32), nil
+	case le32(4096) == 0xa92b4efc:
+		// md superblock version 1.2
+		return FilesystemRAIDMember, label(4096+32, 32), nil
+	case at(4096-10, "SWAPSPACE2"):
+		return FilesystemSwap, label(1024+28, 16), nil
+	case at(0x8001, "CD001"):
+		return FilesystemISO9660, label(0x8028, 32), nil
+	case at(510, "\x55\xaa") && at(0x52, "FAT32   "):
+		return FilesystemVFAT, fatLabel(label(0x47, 11)), nil
+	case at(510, "\x55\xaa") && (at(0x36, "FAT12   ") || at(0x36, "FAT16   ")):
+		return FilesystemVFAT, fatLabel(label(0x2b, 11)), nil
+	}
+
+	return "", "", nil
+}
+
+func fatLabel(label string) string {
+	if label == "NO NAME" {
+		return ""
+	}
+
+	return label
+}
@@ -0,0 +1,171 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package disk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+)
+
+// Partition table types.
+const (
+	PartitionTableGPT = "gpt"
+	PartitionTableDOS = "dos"
+)
+
+const (
+	gptSignature       = "EFI PART"
+	gptMaxEntries      = 1024
+	mbrPartitionOffset = 446
+	mbrGPTProtective   = 0xee
+)
+
+type partitionEntry struct {
+	label string
+	typ   string
+}
+
+// readPartitionTable reads the GPT or MBR partition table.
+//
+// Partition table entries are returned by the partition number.
+func readPartitionTable(r io.ReaderAt, sectorSize int64) (string, map[uint32]partitionEntry, error) {
+	mbr := make([]byte, 512)
+
+	ok, err := readFull(r, mbr, 0)
+	if err != nil || !ok {
+		return "", nil, err
+	}
+
+	if mbr[510] != 0x55 || mbr[511] != 0xaa {
+		return "", nil, nil
+	}
+
+	entries, ok, err := readGPT(r, sectorSize)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if ok {
+		return PartitionTableGPT, entries, nil
+	}
+
+	if string(mbr[0x52:0x5a]) == "FAT32   " || string(mbr[0x36:0x3a]) == "FAT1" {
+		// boot sector of the FAT filesystem, not a partition table
+		return "", nil, nil
+	}
+
+	entries = map[uint32]partitionEntry{}
+
+	for i := 0; i < 4; i++ {
+		typ := mbr[mbrPartitionOffset+16*i+4]
+
+		if typ == 0 || typ == mbrGPTProtective {
+			continue
+		}
+
+		entries[uint32(i+1)] = partitionEntry{
+			typ: fmt.Sprintf("0x%02x", typ),
+		}
+	}
+
+	if len(entries) == 0 {
+		return "", nil, nil
+	}
+
+	return PartitionTableDOS, entries, nil
+}
+
+func readGPT(r io.ReaderAt, sectorSize int64) (map[uint32]partitionEntry, bool, error) {
+	header := make([]byte, 92)
+
+	ok, err := readFull(r, header, sectorSize)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	if string(header[:8]) != gptSignature {
+		return nil, false, nil
+	}
+
+	entriesLBA := binary.LittleEndian.Uint64(header[72:80])
+	numEntries := binary.LittleEndian.Uint32(header[80:84])
+	entrySize := binary.LittleEndian.Uint32(header[84:88])
+
+	if numEntries > gptMaxEntries || entrySize < 128 || entrySize > 4096 {
+		return nil, false, nil
+	}
+
+	buf := make([]byte, numEntries*entrySize)
+
+	ok, err = readFull(r, buf, int64(entriesLBA)*sectorSize)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	entries := map[uint32]partitionEntry{}
+
+	for i := uint32(0); i < numEntries; i++ {
+		entry := buf[i*entrySize : (i+1)*entrySize]
+
+		if bytes.Equal(entry[:16], make([]byte, 16)) {
+			// unused entry
+			continue
+		}
+
+		entries[i+1] = partitionEntry{
+			label: decodeUTF16(entry[56:128]),
+			typ:   formatGUID(entry[:16]),
+		}
+	}
+
+	return entries, true, nil
+}
+
+// readFull reads the whole buffer at the offset.
+//
+// It returns false if the device ends before the end of the buffer.
+func readFull(r io.ReaderAt, buf []byte, offset int64) (bool, error) {
+	n, err := r.ReadAt(buf, offset)
+	if n == len(buf) {
+		return true, nil
+	}
+
+	if errors.Is(err, io.EOF) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+// formatGUID formats the GUID in the mixed-endian on-disk format.
+func formatGUID(b []byte) string {
+	return strings.ToUpper(fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		binary.LittleEndian.Uint32(b[0:4]),
+		binary.LittleEndian.Uint16(b[4:6]),
+		binary.LittleEndian.Uint16(b[6:8]),
+		b[8:10],
+		b[10:16],
+	))
+}
+
+func decodeUTF16(b []byte) string {
+	u := make([]uint16, 0, len(b)/2)
+
+	for i := 0; i+1 < len(b); i += 2 {
+		c := binary.LittleEndian.Uint16(b[i : i+2])
+		if c == 0 {
+			break
+		}
+
+		u = append(u, c)
+	}
+
+	return string(utf16.Decode(u))
+}
@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package disk
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// Match checks whether the disk matches all the criteria of the selector.
+func Match(d *Disk, selector config.InstallDiskSelector) bool {
+	if selector.MinSize() != 0 && d.Size < selector.MinSize() {
+		return false
+	}
+
+	if selector.MaxSize() != 0 && d.Size > selector.MaxSize() {
+		return false
+	}
+
+	if selector.Model() != "" {
+		if matched, _ := filepath.Match(selector.Model(), d.Model); !matched {
+			return false
+		}
+	}
+
+	if selector.Serial() != "" && d.Serial != selector.Serial() {
+		return false
+	}
+
+	if selector.Bus() != "" && d.Bus != selector.Bus() {
+		return false
+	}
+
+	return true
+}
+
+// Find returns the first disk matching the selector.
+func Find(disks []*Disk, selector config.InstallDiskSelector) (*Disk, error) {
+	for _, d := range disks {
+		if Match(d, selector) {
+			return d, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no disk matches the install disk selector")
+}
+
+// InstallDisk returns the path to the install disk.
+//
+// If the install disk selector is set, the disk is looked up by the selector,
+// otherwise the install disk path is returned as is.
+func InstallDisk(install config.Install) (string, error) {
+	selector := install.DiskSelector()
+	if selector == nil {
+		return install.Disk(), nil
+	}
+
+	disks, err := List()
+	if err != nil {
+		return "", fmt.Errorf("error listing disks: %w", err)
+	}
+
+	d, err := Find(disks, selector)
+	if err != nil {
+		return "", err
+	}
+
+	return d.DeviceName, nil
+}
@@ -0,0 +1,213 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package disk
+
+import (
+	"encoding/binary"
+	"os"
+	"runtime"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// SMART is the basic SMART health of the disk.
+//
+// ATA disks report only the overall health, NVMe disks report the health log as well.
+type SMART struct {
+	Passed bool
+	// Temperature in degrees Celsius, zero if not known.
+	Temperature     uint32
+	PercentageUsed  uint32
+	PowerOnHours    uint64
+	CriticalWarning uint32
+}
+
+func readSMART(devPath, bus string) *SMART {
+	switch bus {
+	case BusNVMe:
+		return readNVMeSMART(devPath)
+	case BusATA, BusSCSI, BusUSB:
+		return readATASMART(devPath)
+	}
+
+	return nil
+}
+
+const (
+	nvmeIoctlAdminCmd  = 0xc0484e41 // _IOWR('N', 0x41, struct nvme_admin_cmd)
+	nvmeAdminGetLog    = 0x02
+	nvmeLogSMART       = 0x02
+	nvmeSMARTLogSize   = 512
+	nvmeNamespaceAll   = 0xffffffff
+	kelvinToCelsius    = 273
+	sgIO               = 0x2285
+	sgDxferNone        = -1
+	sgInterfaceID      = 'S'
+	ataPassThrough16   = 0x85
+	ataSMART           = 0xb0
+	ataSMARTStatus     = 0xda
+	senseDescriptorFmt = 0x72
+	senseATAReturn     = 0x09
+)
+
+// nvmeAdminCmd is struct nvme_admin_cmd from linux/nvme_ioctl.h.
+type nvmeAdminCmd struct {
+	opcode      uint8
+	flags       uint8
+	rsvd1       uint16
+	nsid        uint32
+	cdw2        uint32
+	cdw3        uint32
+	metadata    uint64
+	addr        uint64
+	metadataLen uint32
+	dataLen     uint32
+	cdw10       uint32
+	cdw11       uint32
+	cdw12       uint32
+	cdw13       uint32
+	cdw14       uint32
+	cdw15       uint32
+	timeoutMs   uint32
+	result      uint32
+}
+
+func readNVMeSMART(devPath string) *SMART {
+	f, err := os.Open(devPath)
+	if err != nil {
+		return nil
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	buf := make([]byte, nvmeSMARTLogSize)
+
+	cmd := nvmeAdminCmd{
+		opcode:  nvmeAdminGetLog,
+		nsid:    nvmeNamespaceAll,
+		addr:    uint64(uintptr(unsafe.Pointer(&buf[0]))),
+		dataLen: nvmeSMARTLogSize,
+		cdw10:   nvmeLogSMART | (nvmeSMARTLogSize/4-1)<<16,
+	}
+
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), nvmeIoctlAdminCmd, uintptr(unsafe.Pointer(&cmd)))
+
+	runtime.KeepAlive(buf)
+
+	if errno != 0 {
+		return nil
+	}
+
+	return parseNVMeSMARTLog(buf)
+}
+
+// parseNVMeSMARTLog parses the SMART / Health Information log page.
+func parseNVMeSMARTLog(buf []byte) *SMART {
+	smart := &SMART{
+		CriticalWarning: uint32(buf[0]),
+		PercentageUsed:  uint32(buf[5]),
+		PowerOnHours:    binary.LittleEndian.Uint64(buf[128:136]),
+	}
+
+	smart.Passed = smart.CriticalWarning == 0
+
+	if temperature := uint32(binary.LittleEndian.Uint16(buf[1:3])); temperature > kelvinToCelsius {
+		smart.Temperature = temperature - kelvinToCelsius
+	}
+
+	return smart
+}
+
+// sgIOHdr is struct sg_io_hdr from scsi/sg.h.
+type sgIOHdr struct {
+	interfaceID    int32
+	dxferDirection int32
+	cmdLen         uint8
+	mxSbLen        uint8
+	iovecCount     uint16
+	dxferLen       uint32
+	dxferp         unsafe.Pointer
+	cmdp           unsafe.Pointer
+	sbp            unsafe.Pointer
+	timeout        uint32
+	flags          uint32
+	packID         int32
+	usrPtr         unsafe.Pointer
+	status         uint8
+	maskedStatus   uint8
+	msgStatus      uint8
+	sbLenWr        uint8
+	hostStatus     uint16
+	driverStatus   uint16
+	resid          int32
+	duration       uint32
+	info           uint32
+}
+
+// readATASMART issues SMART RETURN STATUS via ATA PASS-THROUGH(16).
+func readATASMART(devPath string) *SMART {
+	f, err := os.Open(devPath)
+	if err != nil {
+		return nil
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	cdb := []byte{
+		ataPassThrough16,
+		3 << 1, // protocol: non-data
+		0x20,   // CK_COND: return the ATA registers in the sense data
+		0, ataSMARTStatus,
+		0, 0,
+		0, 0,
+		0, 0x4f, // LBA mid
+		0, 0xc2, // LBA high
+		0,
+		ataSMART,
+		0,
+	}
+
+	sense := make([]byte, 32)
+
+	hdr := sgIOHdr{
+		interfaceID:    sgInterfaceID,
+		dxferDirection: sgDxferNone,
+		cmdLen:         uint8(len(cdb)),
+		mxSbLen:        uint8(len(sense)),
+		cmdp:           unsafe.Pointer(&cdb[0]),
+		sbp:            unsafe.Pointer(&sense[0]),
+		timeout:        5000,
+	}
+
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), sgIO, uintptr(unsafe.Pointer(&hdr)))
+
+	runtime.KeepAlive(cdb)
+	runtime.KeepAlive(sense)
+
+	if errno != 0 {
+		return nil
+	}
+
+	return parseATASMARTStatus(sense)
+}
+
+// parseATASMARTStatus parses the ATA Status Return descriptor of the sense data.
+func parseATASMARTStatus(sense []byte) *SMART {
+	if sense[0]&0x7f != senseDescriptorFmt || sense[8] != senseATAReturn {
+		return nil
+	}
+
+	lbaMid, lbaHigh := sense[8+9], sense[8+11]
+
+	switch {
+	case lbaMid == 0x4f && lbaHigh == 0xc2:
+		return &SMART{Passed: true}
+	case lbaMid == 0xf4 && lbaHigh == 0x2c:
+		return &SMART{Passed: false}
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package encryption implements encryption of the system partitions.
+package encryption
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// ProviderLUKS2 is the name of the LUKS2 encryption provider.
+const ProviderLUKS2 = "luks2"
+
+// blankHeaderSize is the size of the partition header which should be zeroed
+// for the partition to be considered blank.
+//
+// It covers both LUKS2 binary headers and filesystem superblocks.
+const blankHeaderSize = 64 * 1024
+
+// Handler opens the encrypted volume of the system partition.
+type Handler struct {
+	partition string
+	label     string
+	cfg       config.Encryption
+	luks      *luks
+}
+
+// NewHandler creates the encryption handler for the partition.
+func NewHandler(partition, label string, cfg config.Encryption) (*Handler, error) {
+	if cfg.Provider() != ProviderLUKS2 {
+		return nil, fmt.Errorf("unsupported encryption provider %q", cfg.Provider())
+	}
+
+	if len(cfg.Keys()) == 0 {
+		return nil, fmt.Errorf("no encryption keys are configured for %q", label)
+	}
+
+	return &Handler{
+		partition: partition,
+		label:     label,
+		cfg:       cfg,
+		luks: &luks{
+			cipher:      cfg.Cipher(),
+			keySize:     cfg.KeySize(),
+			blockSize:   cfg.BlockSize(),
+			perfOptions: cfg.Options(),
+		},
+	}, nil
+}
+
+// Label returns the label of the partition.
+func (h *Handler) Label() string {
+	return h.label
+}
+
+// Open opens the encrypted volume and returns the path to the mapped device.
+//
+// Blank partition is formatted as an encrypted volume first, in that case the returned
+// flag is set, and the mapped device should be formatted with the filesystem.
+func (h *Handler) Open() (path string, formatted bool, err error) {
+	path = MapperPath(h.label)
+
+	if _, err = os.Stat(path); err == nil {
+		// already opened
+		return path, false, nil
+	}
+
+	encrypted, err := isLUKS(h.partition)
+	if err != nil {
+		return "", false, err
+	}
+
+	if !encrypted {
+		var blank bool
+
+		if blank, err = isBlank(h.partition); err != nil {
+			return "", false, err
+		}
+
+		if !blank {
+			return "", false, fmt.Errorf("partition %q is neither encrypted nor blank, refusing to format it", h.partition)
+		}
+
+		log.Printf("formatting partition %q as encrypted volume", h.partition)
+
+		if err = h.format(); err != nil {
+			return "", false, fmt.Errorf("error formatting encrypted volume %q: %w", h.partition, err)
+		}
+
+		formatted = true
+	}
+
+	if err = h.withKeys(func(key []byte) error {
+		return h.luks.open(h.partition, mapperName(h.label), key)
+	}); err != nil {
+		return "", formatted, fmt.Errorf("error opening encrypted volume %q: %w", h.partition, err)
+	}
+
+	return path, formatted, nil
+}
+
+// Resize grows the opened encrypted volume to the size of the partition.
+func (h *Handler) Resize() error {
+	return h.withKeys(func(key []byte) error {
+		return h.luks.resize(mapperName(h.label), key)
+	})
+}
+
+// format creates the encrypted volume with all the configured keys.
+func (h *Handler) format() error {
+	keys := h.cfg.Keys()
+
+	key, token, err := newKey(keys[0], h.label)
+	if err != nil {
+		return err
+	}
+
+	if err = h.luks.format(h.partition, keys[0].Slot(), key); err != nil {
+		return err
+	}
+
+	if err = h.storeToken(keys[0].Slot(), token); err != nil {
+		return err
+	}
+
+	for _, k := range keys[1:] {
+		newKey, newToken, err := newKey(k, h.label)
+		if err != nil {
+			return err
+		}
+
+		if err = h.luks.addKey(h.partition, key, k.Slot(), newKey); err != nil {
+			return fmt.Errorf("error adding key to slot %d: %w", k.Slot(), err)
+		}
+
+		if err = h.storeToken(k.Slot(), newToken); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// storeToken stores the node ID key token in the volume header.
+func (h *Handler) storeToken(slot int, token *nodeIDToken) error {
+	if token == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	if err = h.luks.importToken(h.partition, slot, data); err != nil {
+		return fmt.Errorf("error storing token of slot %d: %w", slot, err)
+	}
+
+	return nil
+}
+
+// readKey returns the key of the existing volume.
+//
+// The salt of the node ID key is read from the volume header.
+func (h *Handler) readKey(k config.EncryptionKey) ([]byte, error) {
+	var salt []byte
+
+	if k.NodeID() != nil {
+		data, err := h.luks.exportToken(h.partition, k.Slot())
+		if err != nil {
+			return nil, fmt.Errorf("error reading token: %w", err)
+		}
+
+		var token nodeIDToken
+
+		if err = json.Unmarshal(data, &token); err != nil {
+			return nil, fmt.Errorf("error decoding token: %w", err)
+		}
+
+		if token.Type != nodeIDTokenType {
+			return nil, fmt.Errorf("unexpected token type %q", token.Type)
+		}
+
+		salt = token.Salt
+	}
+
+	return getKey(k, h.label, salt)
+}
+
+// withKeys calls f with each configured key until it succeeds.
+func (h *Handler) withKeys(f func(key []byte) error) error {
+	var result *multierror.Error
+
+	for _, k := range h.cfg.Keys() {
+		key, err := h.readKey(k)
+		if err == nil {
+			err = f(key)
+		}
+
+		if err == nil {
+			return nil
+		}
+
+		result = multierror.Append(result, fmt.Errorf("key slot %d: %w", k.Slot(), err))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// MapperPath returns the path to the mapped device of the encrypted partition.
+func MapperPath(label string) string {
+	return filepath.Join("/dev/mapper", mapperName(label))
+}
+
+// Close closes the encrypted volume of the partition if it's open.
+func Close(label string) error {
+	if _, err := os.Stat(MapperPath(label)); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return (&luks{}).close(mapperName(label))
+}
+
+func mapperName(label string) string {
+	return ProviderLUKS2 + "-" + strings.ToLower(label)
+}
+
+// luksMagic is the signature of the LUKS header.
+var luksMagic = []byte{'L', 'U', 'K', 'S', 0xba, 0xbe}
+
+func isLUKS(partition string) (bool, error) {
+	header, err := readHeader(partition, len(luksMagic))
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(header, luksMagic), nil
+}
+
+func isBlank(partition string) (bool, error) {
+	header, err := readHeader(partition, blankHeaderSize)
+	if err != nil {
+		return false, err
+	}
+
+	for _, b := range header {
+		if b != 0 {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func readHeader(partition string, size int) ([]byte, error) {
+	f, err := os.Open(partition)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	header := make([]byte, size)
+
+	if _, err = io.ReadFull(f, header); err != nil {
+		return nil, fmt.Errorf("error reading %q header: %w", partition, err)
+	}
+
+	return header, nil
+}
@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package encryption
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionHeader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	blank := filepath.Join(dir, "blank")
+	require.NoError(t, ioutil.WriteFile(blank, make([]byte, blankHeaderSize*2), 0o600))
+
+	encrypted := filepath.Join(dir, "encrypted")
+	require.NoError(t, ioutil.WriteFile(encrypted, append(append([]byte{}, luksMagic...), make([]byte, blankHeaderSize)...), 0o600))
+
+	formatted := make([]byte, blankHeaderSize*2)
+	copy(formatted[blankHeaderSize-4:], "XFSB")
+
+	plain := filepath.Join(dir, "plain")
+	require.NoError(t, ioutil.WriteFile(plain, formatted, 0o600))
+
+	for _, test := range []struct {
+		path      string
+		encrypted bool
+		blank     bool
+	}{
+		{blank, false, true},
+		{encrypted, true, false},
+		{plain, false, false},
+	} {
+		isEncrypted, err := isLUKS(test.path)
+		require.NoError(t, err)
+		assert.Equal(t, test.encrypted, isEncrypted, test.path)
+
+		isBlankPartition, err := isBlank(test.path)
+		require.NoError(t, err)
+		assert.Equal(t, test.blank, isBlankPartition, test.path)
+	}
+}
+
+func TestWithKeyFiles(t *testing.T) {
+	require.NoError(t, withKeyFiles([][]byte{[]byte("first"), []byte("second")}, func(paths []string) error {
+		require.Len(t, paths, 2)
+
+		for i, expected := range []string{"first", "second"} {
+			contents, err := ioutil.ReadFile(paths[i])
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, string(contents))
+		}
+
+		return nil
+	}))
+}
+
+func TestMapperPath(t *testing.T) {
+	assert.Equal(t, "/dev/mapper/luks2-ephemeral", MapperPath("EPHEMERAL"))
+}
+
+func TestDeriveKey(t *testing.T) {
+	salt := []byte("0123456789abcdef0123456789abcdef")
+	uuid := "4c4c4544-0047-3510-8052-b4c04f4d4d32"
+
+	key, err := deriveKey(uuid, "STATE", salt)
+	require.NoError(t, err)
+	assert.Len(t, key, 32)
+
+	same, err := deriveKey(uuid, "STATE", salt)
+	require.NoError(t, err)
+	assert.Equal(t, key, same)
+
+	for _, other := range []struct {
+		uuid  string
+		label string
+		salt  []byte
+	}{
+		{"4c4c4544-0047-3510-8052-b4c04f4d4d33", "STATE", salt},
+		{uuid, "EPHEMERAL", salt},
+		{uuid, "STATE", []byte("fedcba9876543210fedcba9876543210")},
+	} {
+		otherKey, err := deriveKey(other.uuid, other.label, other.salt)
+		require.NoError(t, err)
+		assert.NotEqual(t, key, otherKey)
+	}
+
+	_, err = nodeIDKey("STATE", nil)
+	assert.Error(t, err)
+}
+
+func TestNodeIDToken(t *testing.T) {
+	token := nodeIDToken{
+		Type:     nodeIDTokenType,
+		KeySlots: []string{"0"},
+		Salt:     []byte{0xde, 0xad, 0xbe, 0xef},
+	}
+
+	data, err := json.Marshal(token)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"talos-nodeid","keyslots":["0"],"salt":"3q2+7w=="}`, string(data))
+
+	// tokens exported by cryptsetup have extra fields
+	var decoded nodeIDToken
+
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"talos-nodeid","keyslots":["0"],"salt":"3q2+7w==","extra":1}`), &decoded))
+	assert.Equal(t, token, decoded)
+}
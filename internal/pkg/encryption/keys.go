@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/talos-systems/go-smbios/smbios"
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// Values of the SMBIOS system UUID which are not unique to the node.
+var nonUniqueUUIDs = map[string]struct{}{
+	"00000000-0000-0000-0000-000000000000": {},
+	"ffffffff-ffff-ffff-ffff-ffffffffffff": {},
+}
+
+// nodeIDTokenType is the type of the LUKS2 token which stores the salt of the node ID key.
+const nodeIDTokenType = "talos-nodeid"
+
+// nodeIDSaltSize is the size of the random salt of the node ID key.
+const nodeIDSaltSize = 32
+
+// nodeIDToken is the LUKS2 token stored in the volume header for each node ID key slot.
+//
+// The token ID matches the key slot number.
+type nodeIDToken struct {
+	Type     string   `json:"type"`
+	KeySlots []string `json:"keyslots"`
+	Salt     []byte   `json:"salt"`
+}
+
+// newKey returns the key for the new volume.
+//
+// For the node ID keys the salt is generated, and the token returned should be stored in the volume header.
+func newKey(key config.EncryptionKey, label string) ([]byte, *nodeIDToken, error) {
+	if key.NodeID() == nil {
+		k, err := getKey(key, label, nil)
+
+		return k, nil, err
+	}
+
+	token := &nodeIDToken{
+		Type:     nodeIDTokenType,
+		KeySlots: []string{strconv.Itoa(key.Slot())},
+		Salt:     make([]byte, nodeIDSaltSize),
+	}
+
+	if _, err := io.ReadFull(rand.Reader, token.Salt); err != nil {
+		return nil, nil, fmt.Errorf("error generating salt: %w", err)
+	}
+
+	k, err := getKey(key, label, token.Salt)
+
+	return k, token, err
+}
+
+// getKey returns the key, salt is used only for the node ID keys.
+func getKey(key config.EncryptionKey, label string, salt []byte) ([]byte, error) {
+	switch {
+	case key.Static() != nil:
+		k := key.Static().Key()
+		if len(k) == 0 {
+			return nil, errors.New("static key is empty")
+		}
+
+		return k, nil
+	case key.NodeID() != nil:
+		return nodeIDKey(label, salt)
+	default:
+		return nil, errors.New("unsupported key type")
+	}
+}
+
+// nodeIDKey derives the key from the SMBIOS system UUID, the partition label and the volume salt.
+//
+// The key protects the data on the disk which is removed from the node: the system UUID is not stored on the disk,
+// so the disk can't be opened without access to the node (or knowledge of its UUID).
+// The UUID is not a secret, as it can be read by anyone with access to the node firmware
+// or to the management interfaces, so the key doesn't protect against an attacker who knows it.
+// The random salt stored in the volume header makes the key unique to the volume,
+// so the key of one volume can't be used to open the other volumes of the same node,
+// and the keys can't be precomputed for the known UUIDs.
+func nodeIDKey(label string, salt []byte) ([]byte, error) {
+	if len(salt) == 0 {
+		return nil, errors.New("node ID key salt is missing")
+	}
+
+	s, err := smbios.New()
+	if err != nil {
+		return nil, fmt.Errorf("error reading SMBIOS: %w", err)
+	}
+
+	uuid, err := s.SystemInformation().UUID()
+	if err != nil {
+		return nil, fmt.Errorf("error reading system UUID: %w", err)
+	}
+
+	id := uuid.String()
+
+	if _, ok := nonUniqueUUIDs[id]; ok {
+		return nil, fmt.Errorf("system UUID %q is not unique to the node", id)
+	}
+
+	return deriveKey(id, label, salt)
+}
+
+// deriveKey derives the key from the secret with HKDF-SHA256, label is used as the context.
+func deriveKey(secret, label string, salt []byte) ([]byte, error) {
+	key := make([]byte, 32)
+
+	if _, err := io.ReadFull(hkdf.New(sha256.New, []byte(secret), salt, []byte(label)), key); err != nil {
+		return nil, fmt.Errorf("error deriving key: %w", err)
+	}
+
+	return key, nil
+}
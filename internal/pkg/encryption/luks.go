@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/pkg/cmd"
+)
+
+// luks implements LUKS2 encryption using cryptsetup.
+type luks struct {
+	cipher      string
+	keySize     uint
+	blockSize   uint64
+	perfOptions []string
+}
+
+func (l *luks) format(partition string, slot int, key []byte) error {
+	return withKeyFiles([][]byte{key}, func(paths []string) error {
+		args := []string{
+			"luksFormat",
+			"--type", ProviderLUKS2,
+			"--batch-mode",
+			"--key-file", paths[0],
+			"--key-slot", strconv.Itoa(slot),
+		}
+
+		if l.cipher != "" {
+			args = append(args, "--cipher", l.cipher)
+		}
+
+		if l.keySize != 0 {
+			args = append(args, "--key-size", strconv.FormatUint(uint64(l.keySize), 10))
+		}
+
+		if l.blockSize != 0 {
+			args = append(args, "--sector-size", strconv.FormatUint(l.blockSize, 10))
+		}
+
+		_, err := cmd.Run("cryptsetup", append(args, partition)...)
+
+		return err
+	})
+}
+
+func (l *luks) addKey(partition string, key []byte, slot int, newKey []byte) error {
+	return withKeyFiles([][]byte{key, newKey}, func(paths []string) error {
+		_, err := cmd.Run("cryptsetup", "luksAddKey", "--batch-mode", "--key-file", paths[0], "--key-slot", strconv.Itoa(slot), partition, paths[1])
+
+		return err
+	})
+}
+
+func (l *luks) open(partition, name string, key []byte) error {
+	return withKeyFiles([][]byte{key}, func(paths []string) error {
+		args := []string{
+			"open",
+			"--type", ProviderLUKS2,
+			"--key-file", paths[0],
+		}
+
+		for _, option := range l.perfOptions {
+			args = append(args, "--perf-"+option)
+		}
+
+		_, err := cmd.Run("cryptsetup", append(args, partition, name)...)
+
+		return err
+	})
+}
+
+func (l *luks) resize(name string, key []byte) error {
+	return withKeyFiles([][]byte{key}, func(paths []string) error {
+		_, err := cmd.Run("cryptsetup", "resize", "--key-file", paths[0], name)
+
+		return err
+	})
+}
+
+func (l *luks) importToken(partition string, id int, token []byte) error {
+	return withKeyFiles([][]byte{token}, func(paths []string) error {
+		_, err := cmd.Run("cryptsetup", "token", "import", "--token-id", strconv.Itoa(id), "--json-file", paths[0], partition)
+
+		return err
+	})
+}
+
+func (l *luks) exportToken(partition string, id int) ([]byte, error) {
+	out, err := cmd.Run("cryptsetup", "token", "export", "--token-id", strconv.Itoa(id), partition)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(out), nil
+}
+
+func (l *luks) close(name string) error {
+	_, err := cmd.Run("cryptsetup", "close", name)
+
+	return err
+}
+
+// withKeyFiles passes the keys to cryptsetup via anonymous memory files,
+// so that the keys are never written to disk.
+func withKeyFiles(keys [][]byte, f func(paths []string) error) error {
+	paths := make([]string, len(keys))
+
+	for i, key := range keys {
+		fd, err := unix.MemfdCreate("key", unix.MFD_CLOEXEC)
+		if err != nil {
+			return fmt.Errorf("error creating key file: %w", err)
+		}
+
+		file := os.NewFile(uintptr(fd), "key")
+
+		defer file.Close() //nolint: errcheck
+
+		if _, err = file.Write(key); err != nil {
+			return fmt.Errorf("error writing key file: %w", err)
+		}
+
+		// the key file is opened by cryptsetup via procfs of the current process
+		paths[i] = fmt.Sprintf("/proc/%d/fd/%d", os.Getpid(), fd)
+	}
+
+	return f(paths)
+}
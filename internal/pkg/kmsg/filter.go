@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kmsg
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// ParseFacility parses the facility name as returned by Facility.String.
+func ParseFacility(s string) (Facility, error) {
+	for f := Kern; f <= Local7; f++ {
+		if f.String() == s {
+			return f, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown facility %q", s)
+}
+
+// ParsePriority parses the priority name as returned by Priority.String.
+func ParsePriority(s string) (Priority, error) {
+	for p := Emerg; p <= Debug; p++ {
+		if p.String() == s {
+			return p, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown priority %q", s)
+}
+
+// Filter selects the kernel log messages.
+//
+// Zero value of Filter matches all the messages.
+type Filter struct {
+	// Since and Until limit the message timestamps, both bounds are inclusive.
+	Since time.Time
+	Until time.Time
+
+	// Match is matched against the message text.
+	Match *regexp.Regexp
+
+	// Facilities lists the accepted facilities, all facilities are accepted if empty.
+	Facilities []Facility
+
+	// Priority is the lowest accepted priority (e.g. Warning accepts Emerg through Warning).
+	Priority *Priority
+}
+
+// Matches returns true if the message is selected by the filter.
+func (f *Filter) Matches(msg Message) bool {
+	if !f.Since.IsZero() && msg.Timestamp.Before(f.Since) {
+		return false
+	}
+
+	if !f.Until.IsZero() && msg.Timestamp.After(f.Until) {
+		return false
+	}
+
+	if f.Priority != nil && msg.Priority > *f.Priority {
+		return false
+	}
+
+	if len(f.Facilities) > 0 {
+		found := false
+
+		for _, facility := range f.Facilities {
+			if facility == msg.Facility {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if f.Match != nil && !f.Match.MatchString(msg.Message) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kmsg_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/kmsg"
+)
+
+func TestParseFacilityPriority(t *testing.T) {
+	facility, err := kmsg.ParseFacility("daemon")
+	require.NoError(t, err)
+	assert.Equal(t, kmsg.Daemon, facility)
+
+	_, err = kmsg.ParseFacility("foo")
+	assert.Error(t, err)
+
+	priority, err := kmsg.ParsePriority("warning")
+	require.NoError(t, err)
+	assert.Equal(t, kmsg.Warning, priority)
+
+	_, err = kmsg.ParsePriority("warn")
+	assert.Error(t, err)
+}
+
+func TestFilter(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	warning := kmsg.Warning
+
+	msg := kmsg.Message{
+		Facility:  kmsg.Kern,
+		Priority:  kmsg.Err,
+		Timestamp: now,
+		Message:   "eth0: link is down",
+	}
+
+	for _, tt := range []struct {
+		name     string
+		filter   kmsg.Filter
+		expected bool
+	}{
+		{
+			name:     "empty",
+			expected: true,
+		},
+		{
+			name:     "in time range",
+			filter:   kmsg.Filter{Since: now.Add(-time.Minute), Until: now},
+			expected: true,
+		},
+		{
+			name:   "before since",
+			filter: kmsg.Filter{Since: now.Add(time.Second)},
+		},
+		{
+			name:   "after until",
+			filter: kmsg.Filter{Until: now.Add(-time.Second)},
+		},
+		{
+			name:     "match",
+			filter:   kmsg.Filter{Match: regexp.MustCompile(`eth\d+`)},
+			expected: true,
+		},
+		{
+			name:   "no match",
+			filter: kmsg.Filter{Match: regexp.MustCompile(`^usb`)},
+		},
+		{
+			name:     "facility",
+			filter:   kmsg.Filter{Facilities: []kmsg.Facility{kmsg.Daemon, kmsg.Kern}},
+			expected: true,
+		},
+		{
+			name:   "other facility",
+			filter: kmsg.Filter{Facilities: []kmsg.Facility{kmsg.Daemon}},
+		},
+		{
+			name:     "priority",
+			filter:   kmsg.Filter{Priority: &warning},
+			expected: true,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.filter.Matches(msg))
+		})
+	}
+
+	crit := kmsg.Crit
+
+	assert.False(t, (&kmsg.Filter{Priority: &crit}).Matches(msg))
+}
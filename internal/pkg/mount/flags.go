@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount
+
+import (
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+var mountFlags = map[string]uintptr{
+	"ro":          unix.MS_RDONLY,
+	"nosuid":      unix.MS_NOSUID,
+	"nodev":       unix.MS_NODEV,
+	"noexec":      unix.MS_NOEXEC,
+	"sync":        unix.MS_SYNCHRONOUS,
+	"dirsync":     unix.MS_DIRSYNC,
+	"noatime":     unix.MS_NOATIME,
+	"nodiratime":  unix.MS_NODIRATIME,
+	"relatime":    unix.MS_RELATIME,
+	"strictatime": unix.MS_STRICTATIME,
+	"lazytime":    unix.MS_LAZYTIME,
+}
+
+// ParseOptions converts the mount options in the fstab format into the mount flags
+// and the filesystem-specific data.
+//
+// Options which are not mount flags (e.g. `discard` or `prjquota`) are passed to the filesystem as is.
+func ParseOptions(options []string) (flags uintptr, data string) {
+	var extra []string
+
+	for _, option := range options {
+		switch option {
+		case "", "defaults", "rw":
+			continue
+		}
+
+		if flag, ok := mountFlags[option]; ok {
+			flags |= flag
+
+			continue
+		}
+
+		extra = append(extra, option)
+	}
+
+	return flags, strings.Join(extra, ",")
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/talos-systems/go-retry/retry"
 	"golang.org/x/sys/unix"
 
+	"github.com/talos-systems/talos/internal/pkg/encryption"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/makefs"
 )
@@ -62,6 +63,12 @@ func mountMountpoint(mountpoint *Point) (err error) {
 	}
 
 	if !skipMount {
+		if mountpoint.encryption != nil {
+			if err = mountpoint.openEncryptedVolume(); err != nil {
+				return fmt.Errorf("error opening encrypted volume: %w", err)
+			}
+		}
+
 		if err = mountpoint.Mount(); err != nil {
 			return fmt.Errorf("error mounting: %w", err)
 		}
@@ -72,6 +79,12 @@ func mountMountpoint(mountpoint *Point) (err error) {
 	// Growfs is called always, even if ResizePartition returns false to workaround failure scenario
 	// when partition was resized, but growfs never got called.
 	if mountpoint.Resize {
+		if mountpoint.encryption != nil {
+			if err = mountpoint.encryption.Resize(); err != nil {
+				return fmt.Errorf("error resizing encrypted volume: %w", err)
+			}
+		}
+
 		if err = mountpoint.GrowFilesystem(); err != nil {
 			return fmt.Errorf("error resizing filesystem: %w", err)
 		}
@@ -164,6 +177,8 @@ type Point struct {
 	flags  uintptr
 	data   string
 	*Options
+
+	encryption *encryption.Handler
 }
 
 // PointMap represents a unique set of mount points.
@@ -304,6 +319,25 @@ func (p *Point) Move(prefix string) (err error) {
 	return nil
 }
 
+// openEncryptedVolume opens the encrypted volume of the partition, so that
+// the mapped device is mounted instead of the partition.
+func (p *Point) openEncryptedVolume() error {
+	path, formatted, err := p.encryption.Open()
+	if err != nil {
+		return err
+	}
+
+	if formatted {
+		if err = makefs.XFS(path, makefs.WithLabel(p.encryption.Label())); err != nil {
+			return fmt.Errorf("error formatting encrypted volume: %w", err)
+		}
+	}
+
+	p.source = path
+
+	return nil
+}
+
 // ResizePartition resizes a partition to the maximum size allowed.
 func (p *Point) ResizePartition() (resized bool, err error) {
 	var devname string
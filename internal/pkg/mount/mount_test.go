@@ -4,11 +4,44 @@
 
 package mount_test
 
-import "testing"
+import (
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/pkg/mount"
+)
+
+func TestParseOptions(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		options       []string
+		expectedFlags uintptr
+		expectedData  string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:          "flags",
+			options:       []string{"defaults", "noatime", "nodev", "nosuid"},
+			expectedFlags: unix.MS_NOATIME | unix.MS_NODEV | unix.MS_NOSUID,
+		},
+		{
+			name:          "data",
+			options:       []string{"ro", "discard", "prjquota"},
+			expectedFlags: unix.MS_RDONLY,
+			expectedData:  "discard,prjquota",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			flags, data := mount.ParseOptions(tt.options)
+
+			assert.Equal(t, tt.expectedFlags, flags)
+			assert.Equal(t, tt.expectedData, data)
+		})
+	}
 }
@@ -4,6 +4,8 @@
 
 package mount
 
+import "github.com/talos-systems/talos/pkg/machinery/config"
+
 // Options is the functional options struct.
 type Options struct {
 	Loopback      string
@@ -13,6 +15,7 @@ type Options struct {
 	Resize        bool
 	Overlay       bool
 	SkipIfMounted bool
+	Encryption    config.Encryption
 }
 
 // Option is the functional option func.
@@ -62,6 +65,15 @@ func WithOverlay(o bool) Option {
 	}
 }
 
+// WithEncryptionConfig sets the encryption config of the system partition.
+//
+// Encryption config is used only if the partition filesystem is not found by the label.
+func WithEncryptionConfig(cfg config.Encryption) Option {
+	return func(args *Options) {
+		args.Encryption = cfg
+	}
+}
+
 // NewDefaultOptions initializes a Options struct with default values.
 func NewDefaultOptions(setters ...Option) *Options {
 	opts := &Options{
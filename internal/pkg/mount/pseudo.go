@@ -6,6 +6,8 @@ package mount
 
 import (
 	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
 // PseudoMountPoints returns the mountpoints required to boot the system.
@@ -30,3 +32,14 @@ func PseudoSubMountPoints() (mountpoints *Points, err error) {
 
 	return pseudo, nil
 }
+
+// PstoreMountPoints returns the mountpoint of the pstore filesystem.
+//
+// Unlike the other pseudo filesystems, pstore is not required to boot the system,
+// as the kernel might be built without pstore support.
+func PstoreMountPoints() (mountpoints *Points, err error) {
+	pstore := NewMountPoints()
+	pstore.Set("pstore", NewMountPoint("pstore", constants.PstoreMountPoint, "pstore", unix.MS_NOSUID|unix.MS_NOEXEC|unix.MS_NODEV|unix.MS_RELATIME, ""))
+
+	return pstore, nil
+}
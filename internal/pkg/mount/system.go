@@ -8,9 +8,13 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/talos-systems/go-blockdevice/blockdevice"
 	"github.com/talos-systems/go-blockdevice/blockdevice/probe"
+	"github.com/talos-systems/go-blockdevice/blockdevice/util"
 	"golang.org/x/sys/unix"
 
+	"github.com/talos-systems/talos/internal/pkg/encryption"
+	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
@@ -19,10 +23,23 @@ import (
 // creation and bare metall installs ). This is why we want to look up
 // device by specified disk as well as why we don't want to grow any
 // filesystems.
-func SystemMountPointsForDevice(devpath string) (mountpoints *Points, err error) {
+//
+// Partitions with labels listed in skip are not mounted (e.g. encrypted partitions
+// which are not formatted yet).
+func SystemMountPointsForDevice(devpath string, skip ...string) (mountpoints *Points, err error) {
 	mountpoints = NewMountPoints()
 
+	skipped := map[string]struct{}{}
+
+	for _, label := range skip {
+		skipped[label] = struct{}{}
+	}
+
 	for _, name := range []string{constants.EphemeralPartitionLabel, constants.BootPartitionLabel, constants.EFIPartitionLabel, constants.StatePartitionLabel} {
+		if _, ok := skipped[name]; ok {
+			continue
+		}
+
 		var target string
 
 		switch name {
@@ -86,6 +103,11 @@ func SystemMountPointForLabel(label string, opts ...Option) (mountpoint *Point,
 			return nil, nil
 		}
 
+		// Encrypted partitions don't have the filesystem label until the volume is opened.
+		if cfg := NewDefaultOptions(opts...).Encryption; cfg != nil {
+			return encryptedSystemMountPoint(label, target, cfg, opts...)
+		}
+
 		return nil, fmt.Errorf("failed to find device with label %s: %w", label, err)
 	}
 
@@ -97,6 +119,60 @@ func SystemMountPointForLabel(label string, opts ...Option) (mountpoint *Point,
 	return mountpoint, nil
 }
 
+func encryptedSystemMountPoint(label, target string, cfg config.Encryption, opts ...Option) (mountpoint *Point, err error) {
+	partition, err := probe.GetPartitionWithName(label)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find partition with name %s: %w", label, err)
+	}
+
+	// nolint: errcheck
+	defer partition.Close()
+
+	handler, err := encryption.NewHandler(partition.Name(), label, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	mountpoint = NewMountPoint(partition.Name(), target, "xfs", unix.MS_NOATIME, "", opts...)
+	mountpoint.encryption = handler
+
+	return mountpoint, nil
+}
+
+// SystemPartitionDevice returns the device of the system partition with the label.
+//
+// Encrypted partitions don't have the filesystem label, so the partition is
+// looked up by the partition name if the filesystem is not found.
+func SystemPartitionDevice(label string) (*probe.ProbedBlockDevice, error) {
+	dev, err := probe.GetDevWithFileSystemLabel(label)
+	if err == nil {
+		return dev, nil
+	}
+
+	partition, e := probe.GetPartitionWithName(label)
+	if e != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer partition.Close()
+
+	devname, e := util.DevnameFromPartname(partition.Name())
+	if e != nil {
+		return nil, err
+	}
+
+	bd, e := blockdevice.Open("/dev/" + devname)
+	if e != nil {
+		return nil, err
+	}
+
+	return &probe.ProbedBlockDevice{
+		BlockDevice: bd,
+		Path:        partition.Name(),
+	}, nil
+}
+
 // SystemPartitionMount mounts a system partition by the label.
 func SystemPartitionMount(label string, opts ...Option) (err error) {
 	mountpoints := NewMountPoints()
@@ -138,5 +214,5 @@ func SystemPartitionUnmount(label string) (err error) {
 		return err
 	}
 
-	return nil
+	return encryption.Close(label)
 }
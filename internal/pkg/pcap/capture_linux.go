@@ -0,0 +1,197 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// +build linux
+
+package pcap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+)
+
+// readTimeout is the socket receive timeout, capture checks for context cancelation on each timeout.
+const readTimeout = 200 * time.Millisecond
+
+// Option configures Capture.
+type Option func(*options)
+
+type options struct {
+	promiscuous bool
+	snapLen     uint32
+	filter      []bpf.RawInstruction
+}
+
+// WithPromiscuousMode puts the interface into promiscuous mode for the duration of the capture.
+func WithPromiscuousMode(promiscuous bool) Option {
+	return func(o *options) {
+		o.promiscuous = promiscuous
+	}
+}
+
+// WithSnapLen sets the maximum number of bytes captured for each packet.
+//
+// Values above MaxSnapLen are clamped to it.
+func WithSnapLen(snapLen uint32) Option {
+	return func(o *options) {
+		switch {
+		case snapLen > MaxSnapLen:
+			o.snapLen = MaxSnapLen
+		case snapLen > 0:
+			o.snapLen = snapLen
+		}
+	}
+}
+
+// WithFilter attaches BPF filter to the capture socket.
+func WithFilter(filter []bpf.RawInstruction) Option {
+	return func(o *options) {
+		o.filter = filter
+	}
+}
+
+// Capture is a packet capture on a network interface.
+type Capture struct {
+	fd      int
+	options options
+}
+
+// htons converts a short (uint16) from host to network byte order.
+func htons(i uint16) uint16 {
+	return (i<<8)&0xff00 | i>>8
+}
+
+// NewCapture opens AF_PACKET socket bound to the specified interface.
+//
+// nolint: gocyclo
+func NewCapture(ifaceName string, setters ...Option) (*Capture, error) {
+	c := &Capture{
+		options: options{
+			snapLen: DefaultSnapLen,
+		},
+	}
+
+	for _, setter := range setters {
+		setter(&c.options)
+	}
+
+	iface, err := net.InterfaceByName(ifaceName)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up interface %q: %w", ifaceName, err)
+	}
+
+	// socket is opened with protocol 0, so that it doesn't receive any packets until it is bound
+	// to the interface, otherwise packets from all the interfaces are queued before the bind
+	c.fd, err = unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error opening packet socket: %w", err)
+	}
+
+	if err = c.setup(iface.Index); err != nil {
+		c.Close() //nolint: errcheck
+
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Capture) setup(ifindex int) error {
+	if len(c.options.filter) > 0 {
+		filter := make([]unix.SockFilter, len(c.options.filter))
+
+		for i, ins := range c.options.filter {
+			filter[i] = unix.SockFilter{
+				Code: ins.Op,
+				Jt:   ins.Jt,
+				Jf:   ins.Jf,
+				K:    ins.K,
+			}
+		}
+
+		// filter is attached before the socket is bound, so that no packets bypass the filter
+		if err := unix.SetsockoptSockFprog(c.fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER, &unix.SockFprog{
+			Len:    uint16(len(filter)),
+			Filter: &filter[0],
+		}); err != nil {
+			return fmt.Errorf("error attaching BPF filter: %w", err)
+		}
+	}
+
+	if err := unix.Bind(c.fd, &unix.SockaddrLinklayer{
+		Protocol: htons(unix.ETH_P_ALL),
+		Ifindex:  ifindex,
+	}); err != nil {
+		return fmt.Errorf("error binding packet socket: %w", err)
+	}
+
+	if c.options.promiscuous {
+		// membership is dropped by the kernel when the socket is closed
+		if err := unix.SetsockoptPacketMreq(c.fd, unix.SOL_PACKET, unix.PACKET_ADD_MEMBERSHIP, &unix.PacketMreq{
+			Ifindex: int32(ifindex),
+			Type:    unix.PACKET_MR_PROMISC,
+		}); err != nil {
+			return fmt.Errorf("error enabling promiscuous mode: %w", err)
+		}
+	}
+
+	tv := unix.NsecToTimeval(readTimeout.Nanoseconds())
+
+	if err := unix.SetsockoptTimeval(c.fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &tv); err != nil {
+		return fmt.Errorf("error setting socket timeout: %w", err)
+	}
+
+	return nil
+}
+
+// Run captures packets and writes them to out in pcap format until the context is canceled.
+func (c *Capture) Run(ctx context.Context, out io.Writer) error {
+	w, err := NewWriter(out, c.options.snapLen, LinkTypeEthernet)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, c.options.snapLen)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		var n int
+
+		// with MSG_TRUNC packet socket returns real length of the packet
+		n, _, err = unix.Recvfrom(c.fd, buf, unix.MSG_TRUNC)
+		if err != nil {
+			if errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR) {
+				continue
+			}
+
+			return fmt.Errorf("error reading packet: %w", err)
+		}
+
+		captured := n
+		if captured > len(buf) {
+			captured = len(buf)
+		}
+
+		if err = w.WritePacket(time.Now(), buf[:captured], n); err != nil {
+			return err
+		}
+	}
+}
+
+// Close the capture socket.
+func (c *Capture) Close() error {
+	return unix.Close(c.fd)
+}
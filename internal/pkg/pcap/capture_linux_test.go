@@ -0,0 +1,30 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package pcap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithSnapLen(t *testing.T) {
+	for _, tt := range []struct {
+		snapLen  uint32
+		expected uint32
+	}{
+		{0, DefaultSnapLen},
+		{1500, 1500},
+		{MaxSnapLen, MaxSnapLen},
+		{1 << 31, MaxSnapLen},
+	} {
+		o := options{snapLen: DefaultSnapLen}
+
+		WithSnapLen(tt.snapLen)(&o)
+
+		assert.Equal(t, tt.expected, o.snapLen)
+	}
+}
@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pcap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/bpf"
+)
+
+// ParseRawFilter parses BPF program in the format produced by `tcpdump -ddd`.
+//
+// The first value is the number of instructions, followed by the instructions
+// as `op jt jf k` tuples. Values might be separated with newlines or commas,
+// e.g. `4,40 0 0 12,21 0 1 2048,6 0 0 65535,6 0 0 0`.
+func ParseRawFilter(s string) ([]bpf.RawInstruction, error) {
+	lines := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == '\n'
+	})
+
+	if len(lines) == 0 {
+		return nil, nil
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(lines[0]))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing instruction count: %w", err)
+	}
+
+	lines = lines[1:]
+
+	if count != len(lines) {
+		return nil, fmt.Errorf("expected %d instructions, got %d", count, len(lines))
+	}
+
+	filter := make([]bpf.RawInstruction, 0, count)
+
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("instruction %d: expected 4 values, got %d", i, len(fields))
+		}
+
+		var values [4]uint64
+
+		for j, field := range fields {
+			bitSize := 8
+
+			switch j {
+			case 0:
+				bitSize = 16
+			case 3:
+				bitSize = 32
+			}
+
+			values[j], err = strconv.ParseUint(field, 10, bitSize)
+			if err != nil {
+				return nil, fmt.Errorf("instruction %d: %w", i, err)
+			}
+		}
+
+		filter = append(filter, bpf.RawInstruction{
+			Op: uint16(values[0]),
+			Jt: uint8(values[1]),
+			Jf: uint8(values[2]),
+			K:  uint32(values[3]),
+		})
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,90 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package pcap implements packet capture via AF_PACKET sockets and pcap file format writer.
+package pcap
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Snapshot length limits.
+const (
+	// DefaultSnapLen is the default maximum number of bytes captured for each packet.
+	DefaultSnapLen = 65536
+	// MaxSnapLen is the upper limit for the snapshot length, larger values are clamped.
+	MaxSnapLen = 262144
+)
+
+// pcap file format constants.
+const (
+	magicMicroseconds = 0xa1b2c3d4
+	versionMajor      = 2
+	versionMinor      = 4
+
+	// LinkTypeEthernet is the link type for Ethernet frames.
+	LinkTypeEthernet = 1
+
+	fileHeaderLen   = 24
+	packetHeaderLen = 16
+)
+
+// Writer writes packets in pcap file format.
+type Writer struct {
+	w       io.Writer
+	snapLen uint32
+	buf     []byte
+}
+
+// NewWriter writes pcap file header to w and returns Writer.
+func NewWriter(w io.Writer, snapLen uint32, linkType uint32) (*Writer, error) {
+	var hdr [fileHeaderLen]byte
+
+	binary.LittleEndian.PutUint32(hdr[0:4], magicMicroseconds)
+	binary.LittleEndian.PutUint16(hdr[4:6], versionMajor)
+	binary.LittleEndian.PutUint16(hdr[6:8], versionMinor)
+	// bytes 8:16 are timezone offset and timestamp accuracy, always zero
+	binary.LittleEndian.PutUint32(hdr[16:20], snapLen)
+	binary.LittleEndian.PutUint32(hdr[20:24], linkType)
+
+	if _, err := w.Write(hdr[:]); err != nil {
+		return nil, fmt.Errorf("error writing pcap file header: %w", err)
+	}
+
+	return &Writer{
+		w:       w,
+		snapLen: snapLen,
+		buf:     make([]byte, packetHeaderLen, packetHeaderLen+int(snapLen)),
+	}, nil
+}
+
+// WritePacket writes a single packet record.
+//
+// Data is truncated to the snapshot length, origLen is the length of the packet on the wire.
+func (w *Writer) WritePacket(ts time.Time, data []byte, origLen int) error {
+	if uint32(len(data)) > w.snapLen {
+		data = data[:w.snapLen]
+	}
+
+	if origLen < len(data) {
+		origLen = len(data)
+	}
+
+	// record is written with a single call, so that header and data are not split between writes
+	w.buf = w.buf[:packetHeaderLen]
+
+	binary.LittleEndian.PutUint32(w.buf[0:4], uint32(ts.Unix()))
+	binary.LittleEndian.PutUint32(w.buf[4:8], uint32(ts.Nanosecond()/int(time.Microsecond)))
+	binary.LittleEndian.PutUint32(w.buf[8:12], uint32(len(data)))
+	binary.LittleEndian.PutUint32(w.buf[12:16], uint32(origLen))
+
+	w.buf = append(w.buf, data...)
+
+	_, err := w.w.Write(w.buf)
+
+	return err
+}
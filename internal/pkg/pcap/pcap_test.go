@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pcap_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/bpf"
+
+	"github.com/talos-systems/talos/internal/pkg/pcap"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	w, err := pcap.NewWriter(&buf, 4, pcap.LinkTypeEthernet)
+	require.NoError(t, err)
+
+	ts := time.Unix(1600000000, 123456789)
+
+	require.NoError(t, w.WritePacket(ts, []byte{1, 2, 3, 4, 5, 6}, 100))
+	require.NoError(t, w.WritePacket(ts, []byte{7, 8}, 2))
+
+	b := buf.Bytes()
+	require.Len(t, b, 24+16+4+16+2)
+
+	assert.Equal(t, uint32(0xa1b2c3d4), binary.LittleEndian.Uint32(b[0:4]))
+	assert.Equal(t, uint16(2), binary.LittleEndian.Uint16(b[4:6]))
+	assert.Equal(t, uint16(4), binary.LittleEndian.Uint16(b[6:8]))
+	assert.Equal(t, uint32(4), binary.LittleEndian.Uint32(b[16:20]))
+	assert.Equal(t, uint32(pcap.LinkTypeEthernet), binary.LittleEndian.Uint32(b[20:24]))
+
+	b = b[24:]
+	assert.Equal(t, uint32(1600000000), binary.LittleEndian.Uint32(b[0:4]))
+	assert.Equal(t, uint32(123456), binary.LittleEndian.Uint32(b[4:8]))
+	assert.Equal(t, uint32(4), binary.LittleEndian.Uint32(b[8:12]))
+	assert.Equal(t, uint32(100), binary.LittleEndian.Uint32(b[12:16]))
+	assert.Equal(t, []byte{1, 2, 3, 4}, b[16:20])
+
+	b = b[20:]
+	assert.Equal(t, uint32(2), binary.LittleEndian.Uint32(b[8:12]))
+	assert.Equal(t, uint32(2), binary.LittleEndian.Uint32(b[12:16]))
+	assert.Equal(t, []byte{7, 8}, b[16:18])
+}
+
+func TestParseRawFilter(t *testing.T) {
+	filter, err := pcap.ParseRawFilter("4,40 0 0 12,21 0 1 2048,6 0 0 65535,6 0 0 0")
+	require.NoError(t, err)
+
+	assert.Equal(t, []bpf.RawInstruction{
+		{Op: 40, K: 12},
+		{Op: 21, Jf: 1, K: 2048},
+		{Op: 6, K: 65535},
+		{Op: 6},
+	}, filter)
+
+	filter, err = pcap.ParseRawFilter("2\n6 0 0 65535\n6 0 0 0\n")
+	require.NoError(t, err)
+	assert.Len(t, filter, 2)
+
+	filter, err = pcap.ParseRawFilter("")
+	require.NoError(t, err)
+	assert.Empty(t, filter)
+
+	for _, s := range []string{
+		"x,6 0 0 0",
+		"2,6 0 0 0",
+		"1,6 0 0",
+		"1,6 0 256 0",
+	} {
+		_, err = pcap.ParseRawFilter(s)
+		assert.Error(t, err, s)
+	}
+}
@@ -0,0 +1,200 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package pstore provides access to the kernel log records of the previous boot kept by pstore.
+//
+// Kernel writes the tail of the kernel log to the pstore backend (ramoops, efi-pstore)
+// on panic or oops (and console output with ramoops on every boot), records are exposed
+// as files in the pstore filesystem on the next boot.
+package pstore
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Record types.
+const (
+	TypeDmesg   = "dmesg"
+	TypeConsole = "console"
+)
+
+// Record is a single pstore record.
+type Record struct {
+	// Name is the file name of the record in the pstore filesystem.
+	Name string
+	// Type is the type of the record (e.g. dmesg, console).
+	Type string
+	// Backend is the pstore backend the record was read from (e.g. ramoops, efi).
+	Backend string
+
+	// Reason is the reason the kernel log was dumped (e.g. Panic, Oops), set only for dmesg records.
+	Reason string
+	// Count is the number of the dump since the boot, set only for dmesg records.
+	Count int
+	// Part is the number of the part of the dump, part 1 is the most recent part of the log.
+	Part int
+
+	Data []byte
+}
+
+// Crash returns true if the record was written because of the kernel crash.
+func (r *Record) Crash() bool {
+	return r.Type == TypeDmesg && (r.Reason == "Panic" || r.Reason == "Oops")
+}
+
+// ReadRecords reads the records from the pstore filesystem mounted at the path.
+//
+// Compressed records which kernel failed to decompress are skipped.
+func ReadRecords(path string) ([]Record, error) {
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	records := make([]Record, 0, len(files))
+
+	for _, file := range files {
+		if file.IsDir() || strings.HasSuffix(file.Name(), ".enc.z") {
+			continue
+		}
+
+		parts := strings.SplitN(file.Name(), "-", 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(path, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		record := Record{
+			Name:    file.Name(),
+			Type:    parts[0],
+			Backend: parts[1],
+			Data:    data,
+		}
+
+		if record.Type == TypeDmesg {
+			parseHeader(&record)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// parseHeader parses the header of the dmesg record, e.g. `Panic#1 Part2`.
+func parseHeader(record *Record) {
+	header := record.Data
+
+	if i := bytes.IndexByte(header, '\n'); i >= 0 {
+		header = header[:i]
+	}
+
+	fields := strings.Fields(string(header))
+	if len(fields) != 2 || !strings.HasPrefix(fields[1], "Part") {
+		return
+	}
+
+	reason := strings.SplitN(fields[0], "#", 2)
+	if len(reason) != 2 {
+		return
+	}
+
+	count, err := strconv.Atoi(reason[1])
+	if err != nil {
+		return
+	}
+
+	part, err := strconv.Atoi(strings.TrimPrefix(fields[1], "Part"))
+	if err != nil {
+		return
+	}
+
+	record.Reason = reason[0]
+	record.Count = count
+	record.Part = part
+	record.Data = bytes.TrimPrefix(record.Data[len(header):], []byte("\n"))
+}
+
+// Log assembles the kernel log of the previous boot from the records.
+//
+// Console records go first, followed by the dmesg records ordered so that
+// the log is chronological.
+func Log(records []Record) []byte {
+	records = append([]Record(nil), records...)
+
+	typeOrder := func(r *Record) int {
+		if r.Type == TypeConsole {
+			return 0
+		}
+
+		return 1
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		a, b := &records[i], &records[j]
+
+		if typeOrder(a) != typeOrder(b) {
+			return typeOrder(a) < typeOrder(b)
+		}
+
+		if a.Count != b.Count {
+			return a.Count < b.Count
+		}
+
+		// part 1 is the most recent one
+		return a.Part > b.Part
+	})
+
+	var buf bytes.Buffer
+
+	dump := -1
+
+	for _, record := range records {
+		if record.Type != TypeConsole && record.Type != TypeDmesg {
+			continue
+		}
+
+		if record.Reason != "" && record.Count != dump {
+			dump = record.Count
+
+			fmt.Fprintf(&buf, "--- kernel log dumped on %s#%d ---\n", record.Reason, record.Count)
+		}
+
+		buf.Write(record.Data)
+
+		if len(record.Data) > 0 && record.Data[len(record.Data)-1] != '\n' {
+			buf.WriteByte('\n')
+		}
+	}
+
+	return buf.Bytes()
+}
+
+// RemoveRecords removes the records from the pstore filesystem mounted at the path.
+//
+// Removing the records frees the space in the pstore backend for the next records.
+func RemoveRecords(path string, records []Record) error {
+	for _, record := range records {
+		if err := os.Remove(filepath.Join(path, record.Name)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
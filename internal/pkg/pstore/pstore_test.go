@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pstore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/pstore"
+)
+
+func TestRecords(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	for name, contents := range map[string]string{
+		"dmesg-ramoops-0":       "Panic#1 Part1\n<0>[    5.000000] Kernel panic - not syncing: sysrq triggered crash\n",
+		"dmesg-ramoops-1":       "Panic#1 Part2\n<6>[    4.000000] sysrq: Trigger a crash\n",
+		"console-ramoops-0":     "[    0.000000] Linux version 5.9.1-talos",
+		"dmesg-efi-1.enc.z":     "garbage",
+		"pmsg-ramoops-0":        "",
+		"unknown":               "",
+		"dmesg-ramoops-invalid": "no header",
+	} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(contents), 0o600))
+	}
+
+	records, err := pstore.ReadRecords(dir)
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+
+	crashes := 0
+
+	for _, record := range records {
+		if record.Crash() {
+			crashes++
+
+			assert.Equal(t, "ramoops", record.Backend)
+			assert.Equal(t, "Panic", record.Reason)
+			assert.Equal(t, 1, record.Count)
+		}
+	}
+
+	assert.Equal(t, 2, crashes)
+
+	assert.Equal(t, `[    0.000000] Linux version 5.9.1-talos
+no header
+--- kernel log dumped on Panic#1 ---
+<6>[    4.000000] sysrq: Trigger a crash
+<0>[    5.000000] Kernel panic - not syncing: sysrq triggered crash
+`, string(pstore.Log(records)))
+
+	require.NoError(t, pstore.RemoveRecords(dir, records))
+
+	records, err = pstore.ReadRecords(dir)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	records, err = pstore.ReadRecords(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
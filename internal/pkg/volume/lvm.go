@@ -0,0 +1,144 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package volume
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/talos-systems/talos/internal/pkg/disk"
+	"github.com/talos-systems/talos/pkg/cmd"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+const lvm = "/sbin/lvm"
+
+// assembleLVM creates or activates the volume group and the logical volume spanning the whole volume group,
+// both named after the volume.
+func assembleLVM(v config.Volume) (*Result, error) {
+	result := &Result{
+		DeviceName: filepath.Join("/dev", v.Name(), v.Name()),
+	}
+
+	existing, blank, err := classifyMembers(v.Devices(), disk.FilesystemLVM2, v.Wipe())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blank) > 0 {
+		if _, err = cmd.Run(lvm, append([]string{"pvcreate"}, blank...)...); err != nil {
+			return nil, fmt.Errorf("error creating physical volumes: %w", err)
+		}
+	}
+
+	if len(existing) == 0 {
+		if _, err = cmd.Run(lvm, append([]string{"vgcreate", v.Name()}, blank...)...); err != nil {
+			return nil, fmt.Errorf("error creating volume group: %w", err)
+		}
+
+		if _, err = cmd.Run(lvm, "lvcreate", "--yes", "--extents", "100%FREE", "--name", v.Name(), v.Name()); err != nil {
+			return nil, fmt.Errorf("error creating logical volume: %w", err)
+		}
+
+		result.Created = true
+
+		return result, nil
+	}
+
+	if _, err = cmd.Run(lvm, "vgchange", "--activate", "y", v.Name()); err != nil {
+		return nil, fmt.Errorf("error activating volume group: %w", err)
+	}
+
+	if len(blank) > 0 {
+		if _, err = cmd.Run(lvm, append([]string{"vgextend", v.Name()}, blank...)...); err != nil {
+			return nil, fmt.Errorf("error extending volume group: %w", err)
+		}
+
+		if _, err = cmd.Run(lvm, "lvextend", "--extents", "+100%FREE", v.Name()+"/"+v.Name()); err != nil {
+			return nil, fmt.Errorf("error extending logical volume: %w", err)
+		}
+
+		result.Extended = true
+	}
+
+	return result, nil
+}
+
+func deactivateLVM(v config.Volume) error {
+	if _, err := os.Stat(filepath.Join("/dev", v.Name())); os.IsNotExist(err) {
+		return nil
+	}
+
+	if _, err := cmd.Run(lvm, "vgchange", "--activate", "n", v.Name()); err != nil {
+		return fmt.Errorf("error deactivating volume group: %w", err)
+	}
+
+	return nil
+}
+
+// readLVM reads the LVM logical volume from sysfs, nil is returned if the device is not an LVM logical volume.
+func readLVM(sysPath, name string) *Volume {
+	if !strings.HasPrefix(readString(filepath.Join(sysPath, "dm", "uuid")), "LVM-") {
+		return nil
+	}
+
+	vg, lv := splitDMName(readString(filepath.Join(sysPath, "dm", "name")))
+
+	v := &Volume{
+		Name:       vg,
+		Type:       TypeLVM,
+		DeviceName: "/dev/" + name,
+		Size:       readUint(filepath.Join(sysPath, "size")) * 512,
+		State:      "active",
+	}
+
+	if lv != vg {
+		v.Name = vg + "/" + lv
+	}
+
+	if readString(filepath.Join(sysPath, "dm", "suspended")) == "1" {
+		v.State = "suspended"
+	}
+
+	slaves, err := ioutil.ReadDir(filepath.Join(sysPath, "slaves"))
+	if err != nil {
+		return v
+	}
+
+	for _, slave := range slaves {
+		v.Members = append(v.Members, &Member{
+			DeviceName: "/dev/" + slave.Name(),
+		})
+	}
+
+	sort.Slice(v.Members, func(i, j int) bool { return v.Members[i].DeviceName < v.Members[j].DeviceName })
+
+	return v
+}
+
+// splitDMName splits the device mapper name of the logical volume into the volume group and the logical volume names.
+//
+// Device mapper name is `<vg>-<lv>` with the dashes in the names doubled.
+func splitDMName(dmName string) (vg, lv string) {
+	for i := 0; i < len(dmName); i++ {
+		if dmName[i] != '-' {
+			continue
+		}
+
+		if i+1 < len(dmName) && dmName[i+1] == '-' {
+			i++
+
+			continue
+		}
+
+		return strings.ReplaceAll(dmName[:i], "--", "-"), strings.ReplaceAll(dmName[i+1:], "--", "-")
+	}
+
+	return strings.ReplaceAll(dmName, "--", "-"), ""
+}
@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package volume
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/talos-systems/talos/internal/pkg/disk"
+	"github.com/talos-systems/talos/pkg/cmd"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+const mdadm = "/sbin/mdadm"
+
+func assembleRAID(v config.Volume) (*Result, error) {
+	result := &Result{
+		DeviceName: filepath.Join(mdDevPath, v.Name()),
+	}
+
+	existing, blank, err := classifyMembers(v.Devices(), disk.FilesystemRAIDMember, v.Wipe())
+	if err != nil {
+		return nil, err
+	}
+
+	switch _, statErr := os.Stat(result.DeviceName); {
+	case statErr == nil:
+		// the array was already assembled (e.g. by the udev incremental assembly)
+	case len(existing) == 0:
+		args := []string{
+			"--create", result.DeviceName,
+			"--run",
+			"--metadata=1.2",
+			fmt.Sprintf("--level=%d", v.Level()),
+			fmt.Sprintf("--raid-devices=%d", len(blank)),
+			"--name=" + v.Name(),
+			"--homehost=any",
+		}
+
+		if _, err = cmd.Run(mdadm, append(args, blank...)...); err != nil {
+			return nil, fmt.Errorf("error creating RAID array: %w", err)
+		}
+
+		result.Created = true
+
+		return result, nil
+	default:
+		// --run starts the array even if some of the members are missing
+		args := []string{"--assemble", result.DeviceName, "--run"}
+
+		if _, err = cmd.Run(mdadm, append(args, existing...)...); err != nil {
+			return nil, fmt.Errorf("error assembling RAID array: %w", err)
+		}
+	}
+
+	for _, device := range blank {
+		if _, err = cmd.Run(mdadm, "--manage", result.DeviceName, "--add", device); err != nil {
+			return nil, fmt.Errorf("error adding %q to RAID array: %w", device, err)
+		}
+	}
+
+	return result, nil
+}
+
+func stopRAID(v config.Volume) error {
+	device := filepath.Join(mdDevPath, v.Name())
+
+	if _, err := os.Stat(device); os.IsNotExist(err) {
+		return nil
+	}
+
+	if _, err := cmd.Run(mdadm, "--stop", device); err != nil {
+		return fmt.Errorf("error stopping RAID array: %w", err)
+	}
+
+	return nil
+}
+
+// readRAID reads the md array state from sysfs, nil is returned if the device is not an md array.
+func readRAID(sysPath, name string) *Volume {
+	mdPath := filepath.Join(sysPath, "md")
+
+	if _, err := os.Stat(mdPath); err != nil {
+		return nil
+	}
+
+	v := &Volume{
+		Type:       TypeRAID,
+		DeviceName: "/dev/" + name,
+		Level:      readString(filepath.Join(mdPath, "level")),
+		Size:       readUint(filepath.Join(sysPath, "size")) * 512,
+		State:      readString(filepath.Join(mdPath, "array_state")),
+		SyncAction: readString(filepath.Join(mdPath, "sync_action")),
+		Degraded:   readUint(filepath.Join(mdPath, "degraded")) > 0,
+	}
+
+	entries, err := ioutil.ReadDir(mdPath)
+	if err != nil {
+		return v
+	}
+
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "dev-") {
+			continue
+		}
+
+		v.Members = append(v.Members, &Member{
+			DeviceName: "/dev/" + strings.TrimPrefix(entry.Name(), "dev-"),
+			State:      readString(filepath.Join(mdPath, entry.Name(), "state")),
+		})
+	}
+
+	sort.Slice(v.Members, func(i, j int) bool { return v.Members[i].DeviceName < v.Members[j].DeviceName })
+
+	return v
+}
+
+// readRAIDNames returns the map of the md devices to the array names from the `/dev/md/<name>` symlinks.
+func readRAIDNames(path string) map[string]string {
+	names := map[string]string{}
+
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		return names
+	}
+
+	for _, entry := range entries {
+		target, err := os.Readlink(filepath.Join(path, entry.Name()))
+		if err != nil {
+			continue
+		}
+
+		names[filepath.Base(target)] = entry.Name()
+	}
+
+	return names
+}
@@ -0,0 +1,390 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package volume assembles and inspects the user volumes: md RAID arrays and LVM logical volumes.
+package volume
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/pkg/disk"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// Volume types.
+const (
+	TypeRAID = "raid"
+	TypeLVM  = "lvm"
+)
+
+// Volume states reported by Status.
+const (
+	StatusHealthy    = "healthy"
+	StatusDegraded   = "degraded"
+	StatusRecovering = "recovering"
+	StatusResyncing  = "resyncing"
+	StatusInactive   = "inactive"
+)
+
+const (
+	sysBlockPath = "/sys/block"
+	mdDevPath    = "/dev/md"
+	mountsPath   = "/proc/self/mounts"
+)
+
+// Volume describes an assembled volume.
+type Volume struct {
+	// Name is the md array name or the LVM volume name (`<vg>/<lv>`, or `<vg>` if the logical volume is named after the volume group).
+	Name string
+	// Type is one of the Type* constants.
+	Type string
+	// Level is the RAID level (e.g. `raid1`), empty for the LVM volumes.
+	Level string
+	// DeviceName is the path to the device (e.g. `/dev/md127`).
+	DeviceName string
+	Size       uint64
+	// State is the md array state (e.g. `clean`, `active`) or `active` for the LVM volumes.
+	State string
+	// SyncAction is the md array sync action (e.g. `idle`, `resync`, `recover`).
+	SyncAction string
+	// Degraded is set if the md array is missing some of the members.
+	Degraded   bool
+	Members    []*Member
+	MountPoint string
+}
+
+// Member describes a member device of the volume.
+type Member struct {
+	DeviceName string
+	// State is the md member state (e.g. `in_sync`, `faulty`, `spare`), empty for the LVM volumes.
+	State string
+}
+
+// Status returns the summary of the volume state as one of the Status* constants.
+func (v *Volume) Status() string {
+	switch {
+	case v.State == "inactive":
+		return StatusInactive
+	case v.SyncAction == "recover":
+		return StatusRecovering
+	case v.Degraded:
+		return StatusDegraded
+	case v.SyncAction == "resync":
+		return StatusResyncing
+	}
+
+	return StatusHealthy
+}
+
+// FailedMembers returns the member devices marked as faulty.
+func (v *Volume) FailedMembers() []string {
+	var failed []string
+
+	for _, m := range v.Members {
+		for _, state := range strings.Split(m.State, ",") {
+			if state == "faulty" {
+				failed = append(failed, m.DeviceName)
+
+				break
+			}
+		}
+	}
+
+	return failed
+}
+
+// List returns the md RAID arrays and the LVM logical volumes of the node sorted by the name.
+func List() ([]*Volume, error) {
+	entries, err := ioutil.ReadDir(sysBlockPath)
+	if err != nil {
+		return nil, err
+	}
+
+	mounts, err := readMounts(mountsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	names := readRAIDNames(mdDevPath)
+
+	volumes := []*Volume{}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		sysPath := filepath.Join(sysBlockPath, name)
+
+		var v *Volume
+
+		switch {
+		case strings.HasPrefix(name, "md"):
+			v = readRAID(sysPath, name)
+
+			if v != nil {
+				v.Name = name
+
+				if mdName, ok := names[name]; ok {
+					v.Name = mdName
+				}
+			}
+		case strings.HasPrefix(name, "dm-"):
+			v = readLVM(sysPath, name)
+		}
+
+		if v == nil {
+			continue
+		}
+
+		v.MountPoint = mounts[v.DeviceName]
+
+		volumes = append(volumes, v)
+	}
+
+	sort.Slice(volumes, func(i, j int) bool { return volumes[i].Name < volumes[j].Name })
+
+	return volumes, nil
+}
+
+// Result describes the outcome of the volume assembly.
+type Result struct {
+	// DeviceName is the path to the volume device.
+	DeviceName string
+	// Created is set if the volume was created, so it should be formatted.
+	Created bool
+	// Extended is set if the volume was extended with the new members, so the filesystem should be grown.
+	Extended bool
+}
+
+// Assemble assembles the volume.
+//
+// The volume is created if all the members are blank, otherwise the existing volume is assembled
+// and the blank members are added to it.
+// Members which are not blank and don't belong to the volume are wiped only if the volume
+// has wipe enabled, otherwise the volume is not assembled.
+func Assemble(v config.Volume) (*Result, error) {
+	switch v.Type() {
+	case TypeRAID:
+		return assembleRAID(v)
+	case TypeLVM:
+		return assembleLVM(v)
+	default:
+		return nil, fmt.Errorf("unsupported volume type %q", v.Type())
+	}
+}
+
+// Deactivate stops the RAID array or deactivates the LVM volume group of the volume.
+//
+// The volume should be unmounted before it is deactivated.
+func Deactivate(v config.Volume) error {
+	switch v.Type() {
+	case TypeRAID:
+		return stopRAID(v)
+	case TypeLVM:
+		return deactivateLVM(v)
+	default:
+		return fmt.Errorf("unsupported volume type %q", v.Type())
+	}
+}
+
+// blankCheckSize is the size of the head and the tail of the device which should be zeroed
+// for the device without known signatures to be considered blank.
+const blankCheckSize = 1024 * 1024
+
+// classifyMembers splits the devices into the members of the existing volume (having the signature)
+// and the blank devices.
+//
+// Devices with any other data are wiped if wipe is set, and the error is returned otherwise.
+func classifyMembers(devices []string, signature string, wipe bool) (existing, blank []string, err error) {
+	for _, device := range devices {
+		var partitionTable, filesystem, contents string
+
+		partitionTable, filesystem, err = disk.Probe(device)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error probing %q: %w", device, err)
+		}
+
+		switch {
+		case partitionTable != "":
+			contents = fmt.Sprintf("a %s partition table", partitionTable)
+		case filesystem == signature:
+			existing = append(existing, device)
+
+			continue
+		case filesystem != "":
+			contents = filesystem
+		default:
+			var zeroed bool
+
+			if zeroed, err = isZeroed(device); err != nil {
+				return nil, nil, fmt.Errorf("error reading %q: %w", device, err)
+			}
+
+			if zeroed {
+				blank = append(blank, device)
+
+				continue
+			}
+
+			contents = "unrecognized data"
+		}
+
+		if !wipe {
+			return nil, nil, fmt.Errorf("device %q contains %s, refusing to use it as a volume member unless wipe is enabled", device, contents)
+		}
+
+		log.Printf("wiping %q which contains %s", device, contents)
+
+		if err = wipeDevice(device); err != nil {
+			return nil, nil, fmt.Errorf("error wiping %q: %w", device, err)
+		}
+
+		blank = append(blank, device)
+	}
+
+	return existing, blank, nil
+}
+
+// isZeroed checks whether the head and the tail of the device are zeroed.
+func isZeroed(device string) (bool, error) {
+	f, err := os.Open(device)
+	if err != nil {
+		return false, err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return false, err
+	}
+
+	buf := make([]byte, blankCheckSize)
+
+	for _, offset := range blankCheckOffsets(size) {
+		n, err := f.ReadAt(buf, offset)
+		if err != nil && err != io.EOF {
+			return false, err
+		}
+
+		for _, b := range buf[:n] {
+			if b != 0 {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// wipeDevice zeroes the head and the tail of the device, removing the partition tables
+// and the signatures of the filesystems, md arrays and LVM physical volumes.
+func wipeDevice(device string) error {
+	f, err := os.OpenFile(device, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	for _, offset := range blankCheckOffsets(size) {
+		n := int64(blankCheckSize)
+		if offset+n > size {
+			n = size - offset
+		}
+
+		if _, err = f.WriteAt(make([]byte, n), offset); err != nil {
+			return err
+		}
+	}
+
+	if err = f.Sync(); err != nil {
+		return err
+	}
+
+	// make the kernel drop the partitions of the wiped partition table,
+	// the error is ignored as the device might be not partitionable
+	unix.IoctlSetInt(int(f.Fd()), unix.BLKRRPART, 0) //nolint: errcheck
+
+	return nil
+}
+
+// blankCheckOffsets returns the offsets of the head and the tail of the device of the given size.
+func blankCheckOffsets(size int64) []int64 {
+	if size <= blankCheckSize {
+		return []int64{0}
+	}
+
+	tail := size - blankCheckSize
+	if tail < blankCheckSize {
+		tail = blankCheckSize
+	}
+
+	return []int64{0, tail}
+}
+
+// readMounts returns the map of the mounted devices (with the symlinks resolved) to the mount points.
+func readMounts(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	mounts := map[string]string{}
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || !strings.HasPrefix(fields[0], "/dev/") {
+			continue
+		}
+
+		source := fields[0]
+
+		if resolved, err := filepath.EvalSymlinks(source); err == nil {
+			source = resolved
+		}
+
+		if _, ok := mounts[source]; !ok {
+			mounts[source] = fields[1]
+		}
+	}
+
+	return mounts, scanner.Err()
+}
+
+func readString(path string) string {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(b))
+}
+
+func readUint(path string) uint64 {
+	v, err := strconv.ParseUint(readString(path), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
@@ -0,0 +1,205 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// nolint: testpackage
+package volume
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/disk"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for name, contents := range files {
+		path := filepath.Join(root, name)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents+"\n"), 0o644))
+	}
+}
+
+func TestReadRAID(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	writeFiles(t, dir, map[string]string{
+		"md127/size":             "2048",
+		"md127/md/level":         "raid1",
+		"md127/md/array_state":   "clean",
+		"md127/md/sync_action":   "idle",
+		"md127/md/degraded":      "1",
+		"md127/md/dev-sdc/state": "faulty",
+		"md127/md/dev-sdb/state": "in_sync",
+		"md127/md/raid_disks":    "2",
+		"sda/device/model":       "QEMU HARDDISK",
+	})
+
+	assert.Nil(t, readRAID(filepath.Join(dir, "sda"), "sda"))
+
+	v := readRAID(filepath.Join(dir, "md127"), "md127")
+	require.NotNil(t, v)
+
+	assert.Equal(t, &Volume{
+		Type:       TypeRAID,
+		Level:      "raid1",
+		DeviceName: "/dev/md127",
+		Size:       2048 * 512,
+		State:      "clean",
+		SyncAction: "idle",
+		Degraded:   true,
+		Members: []*Member{
+			{DeviceName: "/dev/sdb", State: "in_sync"},
+			{DeviceName: "/dev/sdc", State: "faulty"},
+		},
+	}, v)
+
+	assert.Equal(t, StatusDegraded, v.Status())
+	assert.Equal(t, []string{"/dev/sdc"}, v.FailedMembers())
+
+	v.SyncAction = "recover"
+	assert.Equal(t, StatusRecovering, v.Status())
+}
+
+func TestReadRAIDNames(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	require.NoError(t, os.Symlink("../md127", filepath.Join(dir, "data")))
+
+	assert.Equal(t, map[string]string{"md127": "data"}, readRAIDNames(dir))
+	assert.Empty(t, readRAIDNames(filepath.Join(dir, "missing")))
+}
+
+func TestReadLVM(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	writeFiles(t, dir, map[string]string{
+		"dm-0/size":         "4096",
+		"dm-0/dm/name":      "data-data",
+		"dm-0/dm/uuid":      "LVM-abcdef",
+		"dm-0/dm/suspended": "0",
+		"dm-0/slaves/sdc":   "",
+		"dm-0/slaves/sdb":   "",
+		"dm-1/dm/name":      "luks-ephemeral",
+		"dm-1/dm/uuid":      "CRYPT-LUKS2-abcdef",
+	})
+
+	assert.Nil(t, readLVM(filepath.Join(dir, "dm-1"), "dm-1"))
+
+	assert.Equal(t, &Volume{
+		Name:       "data",
+		Type:       TypeLVM,
+		DeviceName: "/dev/dm-0",
+		Size:       4096 * 512,
+		State:      "active",
+		Members: []*Member{
+			{DeviceName: "/dev/sdb"},
+			{DeviceName: "/dev/sdc"},
+		},
+	}, readLVM(filepath.Join(dir, "dm-0"), "dm-0"))
+}
+
+func TestSplitDMName(t *testing.T) {
+	for _, tt := range []struct {
+		dmName     string
+		expectedVG string
+		expectedLV string
+	}{
+		{"data-data", "data", "data"},
+		{"my--vg-my--lv", "my-vg", "my-lv"},
+		{"vg-lv--1", "vg", "lv-1"},
+		{"vg", "vg", ""},
+	} {
+		vg, lv := splitDMName(tt.dmName)
+
+		assert.Equal(t, tt.expectedVG, vg, tt.dmName)
+		assert.Equal(t, tt.expectedLV, lv, tt.dmName)
+	}
+}
+
+func TestClassifyMembers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	const size = 4 * blankCheckSize
+
+	device := func(name string, offset int64, data string) string {
+		path := filepath.Join(dir, name)
+
+		buf := make([]byte, size)
+		copy(buf[offset:], data)
+
+		require.NoError(t, ioutil.WriteFile(path, buf, 0o644))
+
+		return path
+	}
+
+	blank := device("blank", 0, "")
+	member := device("member", 4096, "\xfc\x4e\x2b\xa9")
+
+	existing, blankMembers, err := classifyMembers([]string{blank, member}, disk.FilesystemRAIDMember, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{member}, existing)
+	assert.Equal(t, []string{blank}, blankMembers)
+
+	for _, tt := range []struct {
+		name          string
+		offset        int64
+		data          string
+		expectedError string
+	}{
+		{
+			name:          "xfs",
+			data:          "XFSB",
+			expectedError: "contains xfs",
+		},
+		{
+			name:          "head",
+			offset:        blankCheckSize - 1,
+			data:          "x",
+			expectedError: "contains unrecognized data",
+		},
+		{
+			name:          "tail",
+			offset:        size - 1,
+			data:          "x",
+			expectedError: "contains unrecognized data",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			path := device(tt.name, tt.offset, tt.data)
+
+			_, _, err := classifyMembers([]string{blank, path}, disk.FilesystemRAIDMember, false)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+
+			existing, blankMembers, err := classifyMembers([]string{path}, disk.FilesystemRAIDMember, true)
+			require.NoError(t, err)
+			assert.Empty(t, existing)
+			assert.Equal(t, []string{path}, blankMembers)
+
+			zeroed, err := isZeroed(path)
+			require.NoError(t, err)
+			assert.True(t, zeroed)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package filter
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/talos-systems/talos/pkg/chunker"
+)
+
+// MatchFunc decides whether the line (without the trailing newline) should be passed through.
+type MatchFunc func(line []byte) bool
+
+// Filter is a concrete type that implements the chunker.Chunker interface.
+//
+// Filter splits the chunks of the source into lines and passes through only
+// the lines accepted by the MatchFunc.
+type Filter struct {
+	source chunker.Chunker
+	match  MatchFunc
+
+	ctx context.Context
+}
+
+// NewChunker initializes a Chunker which filters the lines of the source Chunker.
+func NewChunker(ctx context.Context, source chunker.Chunker, match MatchFunc) chunker.Chunker {
+	return &Filter{
+		source,
+		match,
+		ctx,
+	}
+}
+
+// Read implements ChunkReader.
+func (c *Filter) Read() <-chan []byte {
+	// Create a buffered channel of length 1.
+	ch := make(chan []byte, 1)
+
+	go func(ch chan []byte) {
+		defer close(ch)
+
+		// incomplete line left from the previous chunks
+		var pending []byte
+
+		send := func(b []byte) bool {
+			if len(b) == 0 {
+				return true
+			}
+
+			select {
+			case <-c.ctx.Done():
+				return false
+			case ch <- b:
+				return true
+			}
+		}
+
+		for chunk := range c.source.Read() {
+			pending = append(pending, chunk...)
+
+			var out []byte
+
+			for {
+				i := bytes.IndexByte(pending, '\n')
+				if i == -1 {
+					break
+				}
+
+				if c.match(pending[:i]) {
+					out = append(out, pending[:i+1]...)
+				}
+
+				pending = pending[i+1:]
+			}
+
+			// copy the incomplete line, so that the consumed data is not retained
+			pending = append([]byte(nil), pending...)
+
+			if !send(out) {
+				return
+			}
+		}
+
+		// last line might not have '\n'
+		if len(pending) > 0 && c.match(pending) {
+			send(pending)
+		}
+	}(ch)
+
+	return ch
+}
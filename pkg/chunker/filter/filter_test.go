@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package filter_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/pkg/chunker/filter"
+)
+
+type sliceChunker [][]byte
+
+func (s sliceChunker) Read() <-chan []byte {
+	ch := make(chan []byte, len(s))
+
+	for _, chunk := range s {
+		ch <- chunk
+	}
+
+	close(ch)
+
+	return ch
+}
+
+func TestFilter(t *testing.T) {
+	source := sliceChunker{
+		[]byte("error: fo"),
+		[]byte("o\ninfo: bar\nerr"),
+		[]byte("or: baz\ninfo: qux\nerror: last"),
+	}
+
+	chunker := filter.NewChunker(context.Background(), source, func(line []byte) bool {
+		return bytes.HasPrefix(line, []byte("error:"))
+	})
+
+	var result []byte
+
+	for chunk := range chunker.Read() {
+		result = append(result, chunk...)
+	}
+
+	assert.Equal(t, "error: foo\nerror: baz\nerror: last", string(result))
+}
@@ -5,13 +5,18 @@
 package cluster
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"strings"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
@@ -78,6 +83,55 @@ func (s *APICrashDumper) CrashDump(ctx context.Context, out io.Writer) {
 					r.Close() //nolint: errcheck
 				}
 			}
+
+			dumpPreviousBootKernelLog(nodeCtx, cli, out)
 		}(node)
 	}
 }
+
+// dumpPreviousBootKernelLog dumps the kernel log of the previous boot if the node saved it (e.g. after the kernel crash).
+func dumpPreviousBootKernelLog(ctx context.Context, cli *client.Client, out io.Writer) {
+	stream, err := cli.MachineClient.Dmesg(ctx, &machine.DmesgRequest{
+		PreviousBoot: true,
+	})
+	if err != nil {
+		fmt.Fprintf(out, "error getting previous boot kernel log: %s\n", err)
+
+		return
+	}
+
+	r, errCh, err := client.ReadStream(stream)
+	if err != nil {
+		fmt.Fprintf(out, "error getting previous boot kernel log: %s\n", err)
+
+		return
+	}
+
+	defer r.Close() //nolint: errcheck
+
+	var buf bytes.Buffer
+
+	_, err = io.Copy(&buf, r)
+	if err == nil {
+		err = <-errCh
+	}
+
+	if err != nil {
+		// nodes without pstore records don't have the log, errors proxied by apid carry only the message
+		if status.Code(err) != codes.NotFound && !strings.Contains(err.Error(), "code = "+codes.NotFound.String()) {
+			fmt.Fprintf(out, "error streaming previous boot kernel log: %s\n", err)
+		}
+
+		return
+	}
+
+	if buf.Len() == 0 {
+		return
+	}
+
+	title := "previous boot kernel log"
+
+	fmt.Fprintf(out, "\n> %s\n%s\n\n", title, strings.Repeat("-", len(title)+2))
+
+	buf.WriteTo(out) //nolint: errcheck
+}
@@ -0,0 +1,353 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package rollout implements rolling Talos upgrades of the cluster nodes.
+package rollout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/talos-systems/go-retry/retry"
+
+	"github.com/talos-systems/talos/pkg/cluster/check"
+	"github.com/talos-systems/talos/pkg/conditions"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// ErrPaused is returned when the rollout is paused.
+var ErrPaused = errors.New("rollout is paused")
+
+// Options describes the rolling upgrade settings.
+type Options struct {
+	Image    string
+	Preserve bool
+
+	// WorkerBatchSize is the number of the worker nodes upgraded at the same time.
+	WorkerBatchSize int
+	// NodeTimeout limits the time for the node (or the batch of nodes) to reboot and pass the health checks.
+	NodeTimeout time.Duration
+
+	// Checks are run before the rollout and after each node (batch) is upgraded.
+	Checks   []check.ClusterCheck
+	Reporter check.Reporter
+
+	Log func(format string, args ...interface{})
+}
+
+// DefaultOptions returns the default rollout options.
+func DefaultOptions() Options {
+	return Options{
+		WorkerBatchSize: 1,
+		NodeTimeout:     20 * time.Minute,
+		Checks:          check.DefaultClusterChecks(),
+		Reporter:        check.StderrReporter(),
+		Log:             func(string, ...interface{}) {},
+	}
+}
+
+// upgrader performs the operations on the nodes.
+type upgrader interface {
+	// BootID returns the boot ID of the node.
+	BootID(ctx context.Context, node string) (string, error)
+	// Upgrade starts the upgrade of the nodes to the image.
+	Upgrade(ctx context.Context, nodes []string, image string, preserve bool) error
+}
+
+type rollout struct {
+	cluster     check.ClusterInfo
+	store       Store
+	options     Options
+	state       *State
+	upgrader    upgrader
+	quorumCheck check.ClusterCheck
+}
+
+// Run upgrades the cluster nodes to the image one node (or one batch of nodes) at a time.
+//
+// Control plane nodes are upgraded first one by one, each node is upgraded only if etcd has quorum.
+// Worker nodes are upgraded next in the batches of WorkerBatchSize nodes.
+// Each node (batch) should reboot and the cluster should pass the health checks before
+// the rollout proceeds to the next one.
+//
+// Progress is kept in the store, so Run continues the rollout of the same image
+// skipping the nodes which were already upgraded. Run returns ErrPaused if the rollout is
+// paused in the store.
+func Run(ctx context.Context, cluster check.ClusterInfo, store Store, options Options) error {
+	c, err := cluster.Client()
+	if err != nil {
+		return err
+	}
+
+	r := &rollout{
+		cluster:     cluster,
+		store:       store,
+		options:     options,
+		upgrader:    &apiUpgrader{client: c},
+		quorumCheck: etcdQuorumCheck,
+	}
+
+	return r.start(ctx)
+}
+
+// Pause marks the rollout in the store as paused.
+//
+// Running rollout stops before upgrading the next node (batch).
+func Pause(store Store) error {
+	return setPaused(store, true)
+}
+
+// Resume clears the pause mark of the rollout in the store.
+func Resume(store Store) (*State, error) {
+	if err := setPaused(store, false); err != nil {
+		return nil, err
+	}
+
+	return store.Load()
+}
+
+func setPaused(store Store, paused bool) error {
+	state, err := store.Load()
+	if err != nil {
+		return err
+	}
+
+	if state == nil {
+		return errors.New("no rollout in progress")
+	}
+
+	state.Paused = paused
+
+	return store.Save(state)
+}
+
+// start loads the rollout state or starts the new rollout, and runs it.
+func (r *rollout) start(ctx context.Context) error {
+	if r.options.Image == "" {
+		return errors.New("installer image is required")
+	}
+
+	if r.options.WorkerBatchSize < 1 {
+		return fmt.Errorf("worker batch size should be positive, got %d", r.options.WorkerBatchSize)
+	}
+
+	state, err := r.store.Load()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case state == nil || state.Image != r.options.Image:
+		// new rollout
+		state = &State{
+			Image:    r.options.Image,
+			Preserve: r.options.Preserve,
+		}
+
+		if err = r.store.Save(state); err != nil {
+			return err
+		}
+	case state.Paused:
+		return ErrPaused
+	}
+
+	r.state = state
+
+	return r.run(ctx)
+}
+
+func (r *rollout) run(ctx context.Context) error {
+	r.options.Log("waiting for the cluster to be healthy before the upgrade")
+
+	if err := r.waitHealthy(ctx); err != nil {
+		return fmt.Errorf("cluster is not healthy: %w", err)
+	}
+
+	controlPlaneNodes := r.pending(append(r.cluster.NodesByType(machine.TypeInit), r.cluster.NodesByType(machine.TypeControlPlane)...))
+
+	for _, node := range controlPlaneNodes {
+		if err := r.upgrade(ctx, []string{node}, true); err != nil {
+			return err
+		}
+	}
+
+	workerNodes := r.pending(r.cluster.NodesByType(machine.TypeJoin))
+
+	for len(workerNodes) > 0 {
+		batch := workerNodes
+		if len(batch) > r.options.WorkerBatchSize {
+			batch = batch[:r.options.WorkerBatchSize]
+		}
+
+		workerNodes = workerNodes[len(batch):]
+
+		if err := r.upgrade(ctx, batch, false); err != nil {
+			return err
+		}
+	}
+
+	r.options.Log("all nodes are upgraded to %s", r.state.Image)
+
+	return nil
+}
+
+// pending filters out the nodes which were already upgraded.
+func (r *rollout) pending(nodes []string) []string {
+	result := make([]string, 0, len(nodes))
+
+	for _, node := range nodes {
+		if !r.state.IsUpgraded(node) {
+			result = append(result, node)
+		}
+	}
+
+	return result
+}
+
+//nolint: gocyclo
+func (r *rollout) upgrade(ctx context.Context, nodes []string, controlPlane bool) error {
+	// rollout might have been paused from another process
+	state, err := r.store.Load()
+	if err != nil {
+		return err
+	}
+
+	if state != nil && state.Paused {
+		return ErrPaused
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.options.NodeTimeout)
+	defer cancel()
+
+	if controlPlane {
+		// etcd health check on the node validates the quorum, so upgrade proceeds only if
+		// all control plane nodes are the healthy members of the etcd cluster with quorum
+		if err = check.Wait(ctx, r.cluster, []check.ClusterCheck{r.quorumCheck}, r.options.Reporter); err != nil {
+			return fmt.Errorf("etcd quorum check failed: %w", err)
+		}
+	}
+
+	bootIDs := map[string]string{}
+
+	for _, node := range nodes {
+		if bootIDs[node], err = r.upgrader.BootID(ctx, node); err != nil {
+			return fmt.Errorf("error reading boot ID of %s: %w", node, err)
+		}
+	}
+
+	r.options.Log("upgrading %s to %s", strings.Join(nodes, ", "), r.state.Image)
+
+	if err = r.upgrader.Upgrade(ctx, nodes, r.state.Image, r.state.Preserve); err != nil {
+		return fmt.Errorf("error upgrading %s: %w", strings.Join(nodes, ", "), err)
+	}
+
+	for _, node := range nodes {
+		r.options.Log("waiting for %s to reboot", node)
+
+		if err = waitRebooted(ctx, r.upgrader, node, bootIDs[node], r.options.NodeTimeout); err != nil {
+			return err
+		}
+	}
+
+	r.options.Log("waiting for the cluster to be healthy after the upgrade of %s", strings.Join(nodes, ", "))
+
+	if err = r.waitHealthy(ctx); err != nil {
+		return fmt.Errorf("cluster is not healthy after the upgrade of %s: %w", strings.Join(nodes, ", "), err)
+	}
+
+	return r.markUpgraded(nodes)
+}
+
+func (r *rollout) waitHealthy(ctx context.Context) error {
+	return check.Wait(ctx, r.cluster, r.options.Checks, r.options.Reporter)
+}
+
+func (r *rollout) markUpgraded(nodes []string) error {
+	r.state.Upgraded = append(r.state.Upgraded, nodes...)
+
+	// keep the pause mark which might have been set while the nodes were upgraded
+	state, err := r.store.Load()
+	if err != nil {
+		return err
+	}
+
+	if state != nil {
+		r.state.Paused = state.Paused
+	}
+
+	return r.store.Save(r.state)
+}
+
+func etcdQuorumCheck(cluster check.ClusterInfo) conditions.Condition {
+	return conditions.PollingCondition("etcd to have quorum", func(ctx context.Context) error {
+		return check.ServiceHealthAssertion(ctx, cluster, "etcd", check.WithNodeTypes(machine.TypeInit, machine.TypeControlPlane))
+	}, 5*time.Minute, 5*time.Second)
+}
+
+// waitRebooted waits for the boot ID of the node to change.
+func waitRebooted(ctx context.Context, u upgrader, node, bootIDBefore string, timeout time.Duration) error {
+	return retry.Constant(timeout, retry.WithUnits(5*time.Second)).Retry(func() error {
+		select {
+		case <-ctx.Done():
+			return retry.UnexpectedError(ctx.Err())
+		default:
+		}
+
+		bootID, err := u.BootID(ctx, node)
+		if err != nil {
+			// API is unavailable while the node is rebooting
+			return retry.ExpectedError(err)
+		}
+
+		if bootID == bootIDBefore {
+			return retry.ExpectedError(fmt.Errorf("node %s hasn't rebooted yet", node))
+		}
+
+		return nil
+	})
+}
+
+// apiUpgrader implements upgrader via the Talos API.
+type apiUpgrader struct {
+	client *client.Client
+}
+
+// BootID implements upgrader interface.
+func (u *apiUpgrader) BootID(ctx context.Context, node string) (string, error) {
+	// rebooting node might not answer for a long time
+	ctx, cancel := context.WithTimeout(client.WithNodes(ctx, node), 10*time.Second)
+	defer cancel()
+
+	reader, errCh, err := u.client.Read(ctx, "/proc/sys/kernel/random/boot_id")
+	if err != nil {
+		return "", err
+	}
+
+	defer reader.Close() //nolint: errcheck
+
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+
+	for err = range errCh {
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return strings.TrimSpace(string(body)), nil
+}
+
+// Upgrade implements upgrader interface.
+func (u *apiUpgrader) Upgrade(ctx context.Context, nodes []string, image string, preserve bool) error {
+	_, err := u.client.Upgrade(client.WithNodes(ctx, nodes...), image, preserve)
+
+	return err
+}
@@ -0,0 +1,274 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package rollout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/cluster/check"
+	"github.com/talos-systems/talos/pkg/conditions"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+const testImage = "ghcr.io/talos-systems/installer:latest"
+
+// memStore keeps the copy of the state as the persistent store does.
+type memStore struct {
+	state *State
+}
+
+func (s *memStore) Load() (*State, error) {
+	if s.state == nil {
+		return nil, nil
+	}
+
+	state := *s.state
+	state.Upgraded = append([]string(nil), s.state.Upgraded...)
+
+	return &state, nil
+}
+
+func (s *memStore) Save(state *State) error {
+	saved := *state
+	saved.Upgraded = append([]string(nil), state.Upgraded...)
+
+	s.state = &saved
+
+	return nil
+}
+
+// fakeCluster implements check.ClusterInfo, only the node listing is used by the rollout.
+type fakeCluster struct {
+	check.ClusterInfo
+
+	nodes map[machine.Type][]string
+}
+
+func (c *fakeCluster) Nodes() []string {
+	var nodes []string
+
+	for _, typ := range []machine.Type{machine.TypeInit, machine.TypeControlPlane, machine.TypeJoin} {
+		nodes = append(nodes, c.nodes[typ]...)
+	}
+
+	return nodes
+}
+
+func (c *fakeCluster) NodesByType(typ machine.Type) []string {
+	return c.nodes[typ]
+}
+
+// fakeUpgrader reboots the nodes immediately on upgrade.
+type fakeUpgrader struct {
+	boots   map[string]int
+	batches [][]string
+
+	onUpgrade func(nodes []string)
+}
+
+func (u *fakeUpgrader) BootID(ctx context.Context, node string) (string, error) {
+	return fmt.Sprintf("%s-%d", node, u.boots[node]), nil
+}
+
+func (u *fakeUpgrader) Upgrade(ctx context.Context, nodes []string, image string, preserve bool) error {
+	if image != testImage {
+		return fmt.Errorf("unexpected image %q", image)
+	}
+
+	u.batches = append(u.batches, nodes)
+
+	for _, node := range nodes {
+		u.boots[node]++
+	}
+
+	if u.onUpgrade != nil {
+		u.onUpgrade(nodes)
+	}
+
+	return nil
+}
+
+type fakeCondition struct {
+	err   error
+	calls *int
+}
+
+func (c fakeCondition) String() string {
+	return "fake condition"
+}
+
+func (c fakeCondition) Wait(ctx context.Context) error {
+	*c.calls++
+
+	return c.err
+}
+
+func fakeCheck(calls *int, err error) check.ClusterCheck {
+	return func(check.ClusterInfo) conditions.Condition {
+		return fakeCondition{err: err, calls: calls}
+	}
+}
+
+type nopReporter struct{}
+
+func (nopReporter) Update(conditions.Condition) {}
+
+type rolloutSuite struct {
+	store    *memStore
+	upgrader *fakeUpgrader
+
+	healthChecks int
+	quorumChecks int
+	quorumErr    error
+}
+
+func newRolloutSuite() *rolloutSuite {
+	return &rolloutSuite{
+		store:    &memStore{},
+		upgrader: &fakeUpgrader{boots: map[string]int{}},
+	}
+}
+
+func (s *rolloutSuite) start(batchSize int) error {
+	r := &rollout{
+		cluster: &fakeCluster{
+			nodes: map[machine.Type][]string{
+				machine.TypeInit:         {"cp1"},
+				machine.TypeControlPlane: {"cp2", "cp3"},
+				machine.TypeJoin:         {"w1", "w2", "w3", "w4", "w5"},
+			},
+		},
+		store: s.store,
+		options: Options{
+			Image:           testImage,
+			WorkerBatchSize: batchSize,
+			NodeTimeout:     time.Minute,
+			Checks:          []check.ClusterCheck{fakeCheck(&s.healthChecks, nil)},
+			Reporter:        nopReporter{},
+			Log:             func(string, ...interface{}) {},
+		},
+		upgrader:    s.upgrader,
+		quorumCheck: fakeCheck(&s.quorumChecks, s.quorumErr),
+	}
+
+	return r.start(context.Background())
+}
+
+func TestRun(t *testing.T) {
+	s := newRolloutSuite()
+
+	require.NoError(t, s.start(2))
+
+	assert.Equal(t, [][]string{{"cp1"}, {"cp2"}, {"cp3"}, {"w1", "w2"}, {"w3", "w4"}, {"w5"}}, s.upgrader.batches)
+	assert.Equal(t, 3, s.quorumChecks)
+	assert.Equal(t, 1+6, s.healthChecks)
+
+	assert.Equal(t, testImage, s.store.state.Image)
+	assert.Equal(t, []string{"cp1", "cp2", "cp3", "w1", "w2", "w3", "w4", "w5"}, s.store.state.Upgraded)
+}
+
+func TestRunValidation(t *testing.T) {
+	s := newRolloutSuite()
+
+	assert.Error(t, s.start(0))
+
+	r := &rollout{store: s.store, options: Options{WorkerBatchSize: 1}}
+	assert.Error(t, r.start(context.Background()))
+
+	assert.Nil(t, s.store.state)
+	assert.Empty(t, s.upgrader.batches)
+}
+
+func TestRunSkipsUpgraded(t *testing.T) {
+	s := newRolloutSuite()
+	s.store.state = &State{
+		Image:    testImage,
+		Upgraded: []string{"cp2", "w1", "w4"},
+	}
+
+	require.NoError(t, s.start(2))
+
+	assert.Equal(t, [][]string{{"cp1"}, {"cp3"}, {"w2", "w3"}, {"w5"}}, s.upgrader.batches)
+	assert.ElementsMatch(t, []string{"cp1", "cp2", "cp3", "w1", "w2", "w3", "w4", "w5"}, s.store.state.Upgraded)
+}
+
+func TestRunNewImage(t *testing.T) {
+	s := newRolloutSuite()
+	s.store.state = &State{
+		Image:    "ghcr.io/talos-systems/installer:old",
+		Paused:   true,
+		Upgraded: []string{"cp1", "cp2", "cp3"},
+	}
+
+	require.NoError(t, s.start(5))
+
+	assert.Equal(t, [][]string{{"cp1"}, {"cp2"}, {"cp3"}, {"w1", "w2", "w3", "w4", "w5"}}, s.upgrader.batches)
+	assert.False(t, s.store.state.Paused)
+}
+
+func TestPauseResume(t *testing.T) {
+	s := newRolloutSuite()
+
+	// pause the rollout while the second node is being upgraded
+	s.upgrader.onUpgrade = func(nodes []string) {
+		if nodes[0] == "cp2" {
+			require.NoError(t, Pause(s.store))
+		}
+	}
+
+	err := s.start(3)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrPaused))
+
+	// node which was being upgraded is finished, but the rollout doesn't proceed
+	assert.Equal(t, [][]string{{"cp1"}, {"cp2"}}, s.upgrader.batches)
+	assert.Equal(t, []string{"cp1", "cp2"}, s.store.state.Upgraded)
+	assert.True(t, s.store.state.Paused)
+
+	// paused rollout is not continued
+	assert.True(t, errors.Is(s.start(3), ErrPaused))
+	assert.Len(t, s.upgrader.batches, 2)
+
+	state, err := Resume(s.store)
+	require.NoError(t, err)
+	assert.False(t, state.Paused)
+
+	s.upgrader.onUpgrade = nil
+
+	require.NoError(t, s.start(3))
+
+	assert.Equal(t, [][]string{{"cp1"}, {"cp2"}, {"cp3"}, {"w1", "w2", "w3"}, {"w4", "w5"}}, s.upgrader.batches)
+	assert.Equal(t, []string{"cp1", "cp2", "cp3", "w1", "w2", "w3", "w4", "w5"}, s.store.state.Upgraded)
+}
+
+func TestPauseNoRollout(t *testing.T) {
+	store := &memStore{}
+
+	assert.Error(t, Pause(store))
+
+	_, err := Resume(store)
+	assert.Error(t, err)
+}
+
+func TestQuorumGate(t *testing.T) {
+	s := newRolloutSuite()
+	s.quorumErr = errors.New("etcd has no quorum")
+
+	err := s.start(1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "etcd quorum check failed")
+
+	assert.Equal(t, 1, s.quorumChecks)
+	assert.Empty(t, s.upgrader.batches)
+	assert.Empty(t, s.store.state.Upgraded)
+}
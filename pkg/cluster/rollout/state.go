@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rollout
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// State is the progress of the rollout persisted between the runs.
+type State struct {
+	Image    string `yaml:"image"`
+	Preserve bool   `yaml:"preserve,omitempty"`
+	Paused   bool   `yaml:"paused,omitempty"`
+
+	// Upgraded is the list of the nodes which were upgraded to the Image and passed the health checks.
+	Upgraded []string `yaml:"upgraded,omitempty"`
+}
+
+// IsUpgraded returns true if the node was already upgraded.
+func (s *State) IsUpgraded(node string) bool {
+	for _, n := range s.Upgraded {
+		if n == node {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Store persists the rollout state.
+type Store interface {
+	// Load returns nil State if there is no rollout in progress.
+	Load() (*State, error)
+	Save(state *State) error
+}
+
+// FileStore keeps the rollout state in the local file.
+type FileStore struct {
+	Path string
+}
+
+// Load implements Store interface.
+func (s *FileStore) Load() (*State, error) {
+	data, err := ioutil.ReadFile(s.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var state State
+
+	if err = yaml.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("error parsing rollout state %q: %w", s.Path, err)
+	}
+
+	return &state, nil
+}
+
+// Save implements Store interface.
+func (s *FileStore) Save(state *State) error {
+	data, err := yaml.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(s.Path), 0o755); err != nil {
+		return err
+	}
+
+	// write the new state to the temporary file first, so that the state is never left half-written
+	tmp := s.Path + ".tmp"
+
+	if err = ioutil.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.Path)
+}
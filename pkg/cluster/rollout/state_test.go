@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rollout_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/cluster/rollout"
+)
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	store := &rollout.FileStore{Path: filepath.Join(dir, "cluster", "rollout.yaml")}
+
+	state, err := store.Load()
+	require.NoError(t, err)
+	assert.Nil(t, state)
+
+	require.NoError(t, store.Save(&rollout.State{
+		Image:    "ghcr.io/talos-systems/installer:latest",
+		Preserve: true,
+		Upgraded: []string{"10.5.0.2"},
+	}))
+
+	state, err = store.Load()
+	require.NoError(t, err)
+	require.NotNil(t, state)
+
+	assert.Equal(t, "ghcr.io/talos-systems/installer:latest", state.Image)
+	assert.True(t, state.Preserve)
+	assert.False(t, state.Paused)
+	assert.True(t, state.IsUpgraded("10.5.0.2"))
+	assert.False(t, state.IsUpgraded("10.5.0.3"))
+}
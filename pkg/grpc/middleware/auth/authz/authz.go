@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package authz provides role-based authorization middleware for gRPC.
+//
+// Injector extracts client roles and stores them in the context, Authorizer
+// checks them against the per-method allowlist.
+package authz
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+// Authorizer checks that the client has one of the roles allowed for the method.
+type Authorizer struct {
+	// Rules maps full gRPC method name to the set of allowed roles.
+	Rules map[string]role.Set
+
+	// FallbackRoles are allowed to call methods which are not listed in Rules.
+	FallbackRoles role.Set
+
+	// Logger is used to log denied requests.
+	Logger func(format string, v ...interface{})
+}
+
+func (a *Authorizer) authorize(ctx context.Context, method string) error {
+	allowed, ok := a.Rules[method]
+	if !ok {
+		allowed = a.FallbackRoles
+	}
+
+	clientRoles := GetRoles(ctx)
+
+	if clientRoles.IncludesAny(allowed) {
+		return nil
+	}
+
+	if a.Logger != nil {
+		a.Logger("access to %s denied for roles %q", method, clientRoles.Strings())
+	}
+
+	return status.Errorf(codes.PermissionDenied, "not authorized: %s requires one of the roles %q", method, allowed.Strings())
+}
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor.
+func (a *Authorizer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := a.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor.
+func (a *Authorizer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authorize(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
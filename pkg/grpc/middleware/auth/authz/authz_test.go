@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package authz_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/pkg/grpc/middleware/auth/authz"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+func peerContext(organizations ...string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{
+					{
+						Subject: pkix.Name{
+							Organization: organizations,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func injectedRoles(ctx context.Context, t *testing.T, injector *authz.Injector) (role.Set, []string) {
+	var (
+		roles   role.Set
+		mdRoles []string
+	)
+
+	_, err := injector.UnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		roles = authz.GetRoles(ctx)
+		mdRoles = metadataRoles(ctx)
+
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	return roles, mdRoles
+}
+
+func metadataRoles(ctx context.Context) []string {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	return md.Get(authz.MetadataKey)
+}
+
+func TestInjectorCertificate(t *testing.T) {
+	injector := &authz.Injector{
+		Mode:          authz.ReadFromCertificate,
+		FallbackRoles: role.MakeSet(role.Admin),
+	}
+
+	// roles from the certificate take precedence over the metadata set by the client
+	ctx := metadata.NewIncomingContext(peerContext("os:reader"), metadata.Pairs(authz.MetadataKey, "os:admin"))
+
+	roles, md := injectedRoles(ctx, t, injector)
+	assert.Equal(t, role.MakeSet(role.Reader), roles)
+	assert.Equal(t, []string{"os:reader"}, md)
+
+	// certificate with unknown roles only doesn't get any access
+	roles, _ = injectedRoles(peerContext("os:unknown"), t, injector)
+	assert.Empty(t, roles)
+
+	// certificate without roles: roles are propagated via metadata
+	ctx = metadata.NewIncomingContext(peerContext("talos"), metadata.Pairs(authz.MetadataKey, "os:operator"))
+
+	roles, _ = injectedRoles(ctx, t, injector)
+	assert.Equal(t, role.MakeSet(role.Operator), roles)
+
+	// certificate without roles and no metadata
+	roles, md = injectedRoles(peerContext(), t, injector)
+	assert.Equal(t, role.MakeSet(role.Admin), roles)
+	assert.Equal(t, []string{"os:admin"}, md)
+}
+
+func TestInjectorMetadata(t *testing.T) {
+	injector := &authz.Injector{
+		Mode:          authz.ReadFromMetadata,
+		FallbackRoles: role.MakeSet(role.Admin),
+	}
+
+	ctx := metadata.NewIncomingContext(peerContext("os:admin"), metadata.Pairs(authz.MetadataKey, "os:reader"))
+
+	roles, _ := injectedRoles(ctx, t, injector)
+	assert.Equal(t, role.MakeSet(role.Reader), roles)
+
+	roles, _ = injectedRoles(context.Background(), t, injector)
+	assert.Equal(t, role.MakeSet(role.Admin), roles)
+}
+
+func TestAuthorizer(t *testing.T) {
+	authorizer := &authz.Authorizer{
+		Rules: map[string]role.Set{
+			"/machine.MachineService/Version": role.MakeSet(role.Admin, role.Operator, role.Reader),
+			"/machine.MachineService/Reboot":  role.MakeSet(role.Admin, role.Operator),
+		},
+		FallbackRoles: role.MakeSet(role.Admin),
+	}
+
+	for _, test := range []struct {
+		method  string
+		roles   role.Set
+		allowed bool
+	}{
+		{"/machine.MachineService/Version", role.MakeSet(role.Reader), true},
+		{"/machine.MachineService/Reboot", role.MakeSet(role.Reader), false},
+		{"/machine.MachineService/Reboot", role.MakeSet(role.Reader, role.Operator), true},
+		{"/machine.MachineService/Reset", role.MakeSet(role.Operator), false},
+		{"/machine.MachineService/Reset", role.MakeSet(role.Admin), true},
+		{"/machine.MachineService/Version", role.MakeSet(), false},
+	} {
+		ctx := authz.ContextWithRoles(context.Background(), test.roles)
+
+		_, err := authorizer.UnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: test.method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+
+		if test.allowed {
+			assert.NoError(t, err, "%s %v", test.method, test.roles.Strings())
+		} else {
+			assert.Equal(t, codes.PermissionDenied, status.Code(err), "%s %v", test.method, test.roles.Strings())
+		}
+	}
+}
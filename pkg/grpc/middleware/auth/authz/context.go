@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package authz
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+// MetadataKey is the gRPC metadata key used to propagate roles to the backends.
+const MetadataKey = "talos-role"
+
+type rolesKey struct{}
+
+// ContextWithRoles returns derived context with roles set.
+func ContextWithRoles(ctx context.Context, roles role.Set) context.Context {
+	return context.WithValue(ctx, rolesKey{}, roles)
+}
+
+// GetRoles returns roles stored in the context by the Injector.
+//
+// Function returns empty set if roles were not set.
+func GetRoles(ctx context.Context) role.Set {
+	roles, ok := ctx.Value(rolesKey{}).(role.Set)
+	if !ok {
+		return role.MakeSet()
+	}
+
+	return roles
+}
+
+// SetMetadata sets roles in the incoming gRPC metadata, so that they're propagated to the proxied backends.
+//
+// Roles which were set by the client are replaced.
+func SetMetadata(ctx context.Context, roles role.Set) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	md = md.Copy()
+	delete(md, MetadataKey)
+
+	if len(roles) > 0 {
+		md.Set(MetadataKey, roles.Strings()...)
+	}
+
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// rolesFromMetadata returns roles set in the incoming gRPC metadata.
+func rolesFromMetadata(ctx context.Context) (roles role.Set, unknown []string, ok bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return nil, nil, false
+	}
+
+	roles, unknown = role.Parse(values)
+
+	return roles, unknown, true
+}
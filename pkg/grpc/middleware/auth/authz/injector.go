@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package authz
+
+import (
+	"context"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+// InjectorMode specifies how roles are extracted.
+type InjectorMode int
+
+const (
+	// ReadFromCertificate reads roles from the Organization field of the client certificate.
+	//
+	// If the certificate doesn't carry any roles (certificates issued before roles were introduced,
+	// certificates of other apid instances), roles are read from the gRPC metadata.
+	ReadFromCertificate InjectorMode = iota
+	// ReadFromMetadata reads roles from the gRPC metadata, should be used only for trusted connections
+	// (e.g. local unix sockets).
+	ReadFromMetadata
+)
+
+// Injector sets roles to the context and to the gRPC metadata for propagation to the backends.
+type Injector struct {
+	// Mode specifies how roles are extracted.
+	Mode InjectorMode
+
+	// FallbackRoles are used if no roles were found in the certificate or metadata.
+	FallbackRoles role.Set
+
+	// Logger is used to log unknown roles.
+	Logger func(format string, v ...interface{})
+}
+
+func (i *Injector) logf(format string, v ...interface{}) {
+	if i.Logger != nil {
+		i.Logger(format, v...)
+	}
+}
+
+func certificateRoles(ctx context.Context) (roles role.Set, unknown []string, ok bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, nil, false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil, nil, false
+	}
+
+	// client certificate is verified by the TLS layer, so the leaf certificate is trusted
+	if len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil, nil, false
+	}
+
+	organizations := tlsInfo.State.PeerCertificates[0].Subject.Organization
+
+	roles, unknown = role.Parse(organizations)
+
+	// certificate carries roles if it has at least a single organization with the role prefix
+	return roles, unknown, len(roles) > 0 || len(unknown) > 0
+}
+
+// extractRoles returns roles of the client.
+func (i *Injector) extractRoles(ctx context.Context) role.Set {
+	if i.Mode == ReadFromCertificate {
+		if roles, unknown, ok := certificateRoles(ctx); ok {
+			if len(unknown) > 0 {
+				i.logf("ignoring unknown roles in the client certificate: %q", unknown)
+			}
+
+			return roles
+		}
+	}
+
+	if roles, unknown, ok := rolesFromMetadata(ctx); ok {
+		if len(unknown) > 0 {
+			i.logf("ignoring unknown roles in the metadata: %q", unknown)
+		}
+
+		return roles
+	}
+
+	return i.FallbackRoles
+}
+
+// inject sets roles to the context value and to the metadata.
+func (i *Injector) inject(ctx context.Context) context.Context {
+	roles := i.extractRoles(ctx)
+
+	return SetMetadata(ContextWithRoles(ctx, roles), roles)
+}
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor.
+func (i *Injector) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(i.inject(ctx), req)
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor.
+func (i *Injector) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = i.inject(stream.Context())
+
+		return handler(srv, wrapped)
+	}
+}
@@ -371,11 +371,35 @@ func (c *Client) GenerateConfiguration(ctx context.Context, req *machineapi.Gene
 	return c.MachineClient.GenerateConfiguration(ctx, req, callOptions...)
 }
 
+// GetConfiguration implements proto.MachineServiceClient interface.
+func (c *Client) GetConfiguration(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.GetConfigurationResponse, err error) {
+	resp, err = c.MachineClient.GetConfiguration(ctx, &machineapi.GetConfigurationRequest{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.GetConfigurationResponse) //nolint: errcheck
+
+	return
+}
+
 // Disks returns the list of block devices.
+//
+// Disks only works with a single node, use ListDisks to query several nodes.
 func (c *Client) Disks(ctx context.Context, callOptions ...grpc.CallOption) (resp *storageapi.DisksResponse, err error) {
 	return c.StorageClient.Disks(ctx, &empty.Empty{}, callOptions...)
 }
 
+// ListDisks returns the list of block devices and volumes.
+func (c *Client) ListDisks(ctx context.Context, callOptions ...grpc.CallOption) (resp *storageapi.ListDisksResponse, err error) {
+	resp, err = c.StorageClient.ListDisks(ctx, &empty.Empty{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*storageapi.ListDisksResponse) //nolint: errcheck
+
+	return
+}
+
 // Stats implements the proto.MachineServiceClient interface.
 func (c *Client) Stats(ctx context.Context, namespace string, driver common.ContainerDriver, callOptions ...grpc.CallOption) (resp *machineapi.StatsResponse, err error) {
 	resp, err = c.MachineClient.Stats(
@@ -471,8 +495,8 @@ func (c *Client) Rollback(ctx context.Context) (err error) {
 }
 
 // Bootstrap implements the proto.MachineServiceClient interface.
-func (c *Client) Bootstrap(ctx context.Context) (err error) {
-	resp, err := c.MachineClient.Bootstrap(ctx, &machineapi.BootstrapRequest{})
+func (c *Client) Bootstrap(ctx context.Context, req *machineapi.BootstrapRequest, callOptions ...grpc.CallOption) (err error) {
+	resp, err := c.MachineClient.Bootstrap(ctx, req, callOptions...)
 
 	if err == nil {
 		_, err = FilterMessages(resp, err)
@@ -513,6 +537,16 @@ func (c *Client) Logs(ctx context.Context, namespace string, driver common.Conta
 	return
 }
 
+// PacketCapture captures packets on the node interface and returns them in pcap format.
+func (c *Client) PacketCapture(ctx context.Context, req *machineapi.PacketCaptureRequest, callOptions ...grpc.CallOption) (io.ReadCloser, <-chan error, error) {
+	stream, err := c.MachineClient.PacketCapture(ctx, req, callOptions...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ReadStream(stream)
+}
+
 // Version implements the proto.MachineServiceClient interface.
 func (c *Client) Version(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.VersionResponse, err error) {
 	resp, err = c.MachineClient.Version(
@@ -644,6 +678,63 @@ func (c *Client) Upgrade(ctx context.Context, image string, preserve bool, callO
 	return
 }
 
+// upgradeArchiveChunkSize is the size of the archive data sent in a single message.
+const upgradeArchiveChunkSize = 1 << 20
+
+// UpgradeFromArchive streams the installer image archive to the node and upgrades the node using the image from the archive.
+//
+// Digest is the expected digest of the archive in the form "sha256:<hex>", it's verified on the node before the archive is imported.
+// Signature is the detached signature of the image, it's used instead of the signatures in the registry
+// if the image signature verification is enabled on the node (nil to use the registry).
+func (c *Client) UpgradeFromArchive(ctx context.Context, image, digest string, signature *machineapi.ImageSignature, archive io.Reader, preserve bool, callOptions ...grpc.CallOption) (resp *machineapi.UpgradeResponse, err error) {
+	stream, err := c.MachineClient.UpgradeFromArchive(ctx, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &machineapi.UpgradeFromArchiveRequest{
+		Image:     image,
+		Digest:    digest,
+		Preserve:  preserve,
+		Signature: signature,
+	}
+
+	buf := make([]byte, upgradeArchiveChunkSize)
+
+	for {
+		n, readErr := archive.Read(buf)
+
+		if n > 0 {
+			req.Data = buf[:n]
+
+			// error is returned from CloseAndRecv
+			if err = stream.Send(req); err != nil {
+				break
+			}
+
+			req = &machineapi.UpgradeFromArchiveRequest{}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+
+		if readErr != nil {
+			stream.CloseSend() //nolint: errcheck
+
+			return nil, fmt.Errorf("error reading archive: %w", readErr)
+		}
+	}
+
+	resp, err = stream.CloseAndRecv()
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.UpgradeResponse) //nolint: errcheck
+
+	return
+}
+
 // ServiceList returns list of services with their state.
 func (c *Client) ServiceList(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.ServiceListResponse, err error) {
 	resp, err = c.MachineClient.ServiceList(
@@ -799,6 +890,47 @@ func (c *Client) ClusterHealthCheck(ctx context.Context, waitTimeout time.Durati
 	})
 }
 
+// EtcdSnapshot receives a snapshot of the etcd from the node.
+func (c *Client) EtcdSnapshot(ctx context.Context, req *machineapi.EtcdSnapshotRequest, callOptions ...grpc.CallOption) (io.ReadCloser, <-chan error, error) {
+	stream, err := c.MachineClient.EtcdSnapshot(ctx, req, callOptions...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ReadStream(stream)
+}
+
+// EtcdRecover uploads etcd snapshot created with EtcdSnapshot to the node.
+func (c *Client) EtcdRecover(ctx context.Context, snapshot io.Reader, callOptions ...grpc.CallOption) (*machineapi.EtcdRecoverResponse, error) {
+	cli, err := c.MachineClient.EtcdRecover(ctx, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 4096)
+
+	for {
+		var n int
+
+		n, err = snapshot.Read(buf)
+		if n > 0 {
+			if err = cli.Send(&common.Data{Bytes: buf[:n]}); err != nil {
+				return nil, err
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cli.CloseAndRecv()
+}
+
 // MachineStream is a common interface for streams returned by streaming APIs.
 type MachineStream interface {
 	Recv() (*common.Data, error)
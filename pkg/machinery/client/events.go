@@ -45,6 +45,15 @@ func WithTailDuration(dur time.Duration) EventsOptionFunc {
 	}
 }
 
+// WithBootID sets up Events API to return only events of the boot with the ID.
+//
+// Boot ID prefix can be used.
+func WithBootID(id string) EventsOptionFunc {
+	return func(opts *machineapi.EventsRequest) {
+		opts.BootId = id
+	}
+}
+
 // Events implements the proto.OSClient interface.
 func (c *Client) Events(ctx context.Context, opts ...EventsOptionFunc) (stream machineapi.MachineService_EventsClient, err error) {
 	var req machineapi.EventsRequest
@@ -62,6 +71,8 @@ type Event struct {
 	TypeURL string
 	ID      string
 	Payload proto.Message
+	// BootID is the ID of the boot the event was published in.
+	BootID string
 }
 
 // EventsWatch wraps Events by providing more simple interface.
@@ -113,6 +124,10 @@ func (c *Client) EventsWatch(ctx context.Context, watchFunc func(<-chan Event),
 			&machineapi.PhaseEvent{},
 			&machineapi.TaskEvent{},
 			&machineapi.ServiceStateEvent{},
+			&machineapi.ImageVerificationEvent{},
+			&machineapi.RollbackEvent{},
+			&machineapi.KernelCrashEvent{},
+			&machineapi.VolumeStateEvent{},
 		} {
 			if typeURL == "talos/runtime/"+string(eventType.ProtoReflect().Descriptor().FullName()) {
 				msg = eventType
@@ -140,7 +155,11 @@ func (c *Client) EventsWatch(ctx context.Context, watchFunc func(<-chan Event),
 		}
 
 		if event.Metadata != nil {
-			ev.Node = event.Metadata.Hostname
+			if event.Metadata.Hostname != "" {
+				ev.Node = event.Metadata.Hostname
+			}
+
+			ev.BootID = event.Metadata.BootId
 		}
 
 		select {
@@ -0,0 +1,203 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package configpatcher applies the patches to the Talos machine configuration.
+//
+// RFC6902 JSON patch is a list of operations (in JSON or YAML format), e.g.
+// `[{"op": "add", "path": "/machine/install/disk", "value": "/dev/sdb"}]`.
+//
+// Strategic merge patch is a partial machine configuration (in JSON or YAML format) which is merged
+// into the configuration: maps are merged recursively, all the other values (including lists) replace
+// the original ones, `null` value removes the key.
+package configpatcher
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"gopkg.in/yaml.v3"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+)
+
+// Patch is a machine configuration patch.
+type Patch interface {
+	apply(doc []byte) ([]byte, error)
+}
+
+// JSON6902 is a RFC6902 JSON patch.
+type JSON6902 struct {
+	jsonpatch.Patch
+}
+
+func (p JSON6902) apply(doc []byte) ([]byte, error) {
+	return p.Patch.Apply(doc)
+}
+
+// StrategicMerge is a partial machine configuration merged into the configuration.
+type StrategicMerge map[string]interface{}
+
+func (p StrategicMerge) apply(doc []byte) ([]byte, error) {
+	var target, patch interface{}
+
+	if err := unmarshalJSON(doc, &target); err != nil {
+		return nil, err
+	}
+
+	// round-trip the patch through JSON, so that the values have the same types as the values of the document
+	patchJSON, err := json.Marshal(map[string]interface{}(p))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = unmarshalJSON(patchJSON, &patch); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(merge(target, patch))
+}
+
+// merge merges the patch into the target, maps are merged recursively, other values are replaced.
+func merge(target, patch interface{}) interface{} {
+	targetMap, ok := target.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	patchMap, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	for key, value := range patchMap {
+		if value == nil {
+			// null removes the key
+			delete(targetMap, key)
+
+			continue
+		}
+
+		targetMap[key] = merge(targetMap[key], value)
+	}
+
+	return targetMap
+}
+
+// LoadPatch parses the patch in JSON or YAML format.
+//
+// List of operations is parsed as JSON patch, a mapping is parsed as strategic merge patch.
+func LoadPatch(data []byte) (Patch, error) {
+	var parsed interface{}
+
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("error parsing patch: %w", err)
+	}
+
+	switch p := parsed.(type) {
+	case []interface{}:
+		// JSON patch might be in YAML format, so convert it to JSON first
+		patchJSON, err := json.Marshal(p)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing JSON patch: %w", err)
+		}
+
+		jsonPatch, err := jsonpatch.DecodePatch(patchJSON)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing JSON patch: %w", err)
+		}
+
+		return JSON6902{jsonPatch}, nil
+	case map[string]interface{}:
+		return StrategicMerge(p), nil
+	default:
+		return nil, fmt.Errorf("patch should be either a list of JSON patch operations or a partial machine configuration")
+	}
+}
+
+// LoadPatches parses the patches.
+//
+// Patch starting with `@` is read from the file, e.g. `@patch.yaml`.
+func LoadPatches(in []string) ([]Patch, error) {
+	patches := make([]Patch, 0, len(in))
+
+	for _, spec := range in {
+		data := []byte(spec)
+
+		if strings.HasPrefix(spec, "@") {
+			var err error
+
+			if data, err = ioutil.ReadFile(spec[1:]); err != nil {
+				return nil, err
+			}
+		}
+
+		patch, err := LoadPatch(data)
+		if err != nil {
+			return nil, err
+		}
+
+		patches = append(patches, patch)
+	}
+
+	return patches, nil
+}
+
+// Apply the patches to the machine configuration in YAML format.
+//
+// Patched configuration is returned in JSON format (which is valid YAML as well),
+// it can be loaded with configloader.
+func Apply(in []byte, patches []Patch) ([]byte, error) {
+	var parsed interface{}
+
+	if err := yaml.Unmarshal(in, &parsed); err != nil {
+		return nil, fmt.Errorf("error parsing config: %w", err)
+	}
+
+	doc, err := json.Marshal(parsed)
+	if err != nil {
+		return nil, fmt.Errorf("error converting config to JSON: %w", err)
+	}
+
+	for _, patch := range patches {
+		if doc, err = patch.apply(doc); err != nil {
+			return nil, fmt.Errorf("error applying patch: %w", err)
+		}
+	}
+
+	return doc, nil
+}
+
+// ApplyConfig applies the patches to the machine configuration.
+//
+// Patched configuration should be validated with config.Provider.Validate.
+func ApplyConfig(cfg config.Provider, patches []Patch) (config.Provider, error) {
+	in, err := cfg.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := Apply(in, patches)
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := configloader.NewFromBytes(out)
+	if err != nil {
+		return nil, fmt.Errorf("error loading patched config: %w", err)
+	}
+
+	return patched, nil
+}
+
+func unmarshalJSON(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	return dec.Decode(v)
+}
@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configpatcher_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
+)
+
+const config = `version: v1alpha1
+machine:
+  type: join
+  install:
+    disk: /dev/sda
+    extraKernelArgs:
+      - console=ttyS0
+  kubelet:
+    extraArgs:
+      node-labels: a=b
+cluster:
+  controlPlane:
+    endpoint: https://10.5.0.1:6443
+  network:
+    podSubnets:
+      - 10.244.0.0/16
+`
+
+func TestLoadPatch(t *testing.T) {
+	patch, err := configpatcher.LoadPatch([]byte(`[{"op": "add", "path": "/machine/install/disk", "value": "/dev/sdb"}]`))
+	require.NoError(t, err)
+	assert.IsType(t, configpatcher.JSON6902{}, patch)
+
+	patch, err = configpatcher.LoadPatch([]byte(`- op: remove
+  path: /machine/kubelet
+`))
+	require.NoError(t, err)
+	assert.IsType(t, configpatcher.JSON6902{}, patch)
+
+	patch, err = configpatcher.LoadPatch([]byte(`machine:
+  install:
+    disk: /dev/sdb
+`))
+	require.NoError(t, err)
+	assert.IsType(t, configpatcher.StrategicMerge{}, patch)
+
+	_, err = configpatcher.LoadPatch([]byte(`[1, 2]`))
+	assert.Error(t, err)
+
+	_, err = configpatcher.LoadPatch([]byte(`foo`))
+	assert.Error(t, err)
+}
+
+func TestApply(t *testing.T) {
+	patches, err := configpatcher.LoadPatches([]string{
+		`[{"op": "replace", "path": "/machine/install/disk", "value": "/dev/sdb"}, {"op": "add", "path": "/cluster/network/podSubnets/-", "value": "10.245.0.0/16"}]`,
+		`machine:
+  install:
+    extraKernelArgs:
+      - console=tty0
+  kubelet:
+    extraArgs:
+      rotate-certificates: "true"
+  network:
+    hostname: worker-1
+cluster:
+  controlPlane: null
+`,
+	})
+	require.NoError(t, err)
+
+	out, err := configpatcher.Apply([]byte(config), patches)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+  "version": "v1alpha1",
+  "machine": {
+    "type": "join",
+    "install": {
+      "disk": "/dev/sdb",
+      "extraKernelArgs": ["console=tty0"]
+    },
+    "kubelet": {
+      "extraArgs": {
+        "node-labels": "a=b",
+        "rotate-certificates": "true"
+      }
+    },
+    "network": {
+      "hostname": "worker-1"
+    }
+  },
+  "cluster": {
+    "network": {
+      "podSubnets": ["10.244.0.0/16", "10.245.0.0/16"]
+    }
+  }
+}`, string(out))
+}
+
+func TestApplyError(t *testing.T) {
+	patches, err := configpatcher.LoadPatches([]string{
+		`[{"op": "replace", "path": "/machine/missing/disk", "value": "/dev/sdb"}]`,
+	})
+	require.NoError(t, err)
+
+	_, err = configpatcher.Apply([]byte(config), patches)
+	assert.Error(t, err)
+}
@@ -24,7 +24,7 @@ type Provider interface {
 	Persist() bool
 	Machine() MachineConfig
 	Cluster() ClusterConfig
-	Validate(RuntimeMode) error
+	Validate(RuntimeMode, ...ValidationOption) error
 	ApplyDynamicConfig(context.Context, DynamicConfigProvider) error
 	String() (string, error)
 	Bytes() ([]byte, error)
@@ -37,6 +37,7 @@ type MachineConfig interface {
 	Security() Security
 	Network() MachineNetwork
 	Disks() []Disk
+	Volumes() []Volume
 	Time() Time
 	Env() Env
 	Files() ([]File, error)
@@ -44,6 +45,9 @@ type MachineConfig interface {
 	Kubelet() Kubelet
 	Sysctls() map[string]string
 	Registries() Registries
+	Logging() Logging
+	Metrics() Metrics
+	Services() []Service
 }
 
 // Disk represents the options available for partitioning, formatting, and
@@ -51,12 +55,30 @@ type MachineConfig interface {
 type Disk interface {
 	Device() string
 	Partitions() []Partition
+	Wipe() bool
+}
+
+// Volume represents the options for the md RAID array or the LVM volume group
+// assembled from the disks.
+type Volume interface {
+	Name() string
+	Type() string
+	Level() int
+	Devices() []string
+	Filesystem() string
+	Label() string
+	MountPoint() string
+	MountOptions() []string
+	Wipe() bool
 }
 
 // Partition represents the options for a device partition.
 type Partition interface {
 	Size() uint64
 	MountPoint() string
+	Filesystem() string
+	Label() string
+	MountOptions() []string
 }
 
 // Env represents a set of environment variables.
@@ -75,11 +97,67 @@ type File interface {
 type Install interface {
 	Image() string
 	Disk() string
+	DiskSelector() InstallDiskSelector
 	ExtraKernelArgs() []string
 	Zero() bool
 	WithBootloader() bool
+	SystemDiskEncryption() SystemDiskEncryption
+	ImageVerification() ImageVerification
+	BootWatchdog() BootWatchdog
+}
+
+// InstallDiskSelector defines the criteria to look up the install disk.
+//
+// Zero values are not used in the lookup.
+type InstallDiskSelector interface {
+	MinSize() uint64
+	MaxSize() uint64
+	Model() string
+	Serial() string
+	Bus() string
+}
+
+// ImageVerification defines the image signature verification policy.
+type ImageVerification interface {
+	PublicKeys() []string
+}
+
+// BootWatchdog defines the boot watchdog settings.
+type BootWatchdog interface {
+	Timeout() time.Duration
+	Services() []string
+}
+
+// SystemDiskEncryption contains the encryption settings of the system partitions.
+type SystemDiskEncryption interface {
+	Get(label string) Encryption
+}
+
+// Encryption defines settings for the partition encryption.
+type Encryption interface {
+	Provider() string
+	Cipher() string
+	KeySize() uint
+	BlockSize() uint64
+	Options() []string
+	Keys() []EncryptionKey
+}
+
+// EncryptionKey defines settings for the partition encryption key handling.
+type EncryptionKey interface {
+	Static() EncryptionKeyStatic
+	NodeID() EncryptionKeyNodeID
+	Slot() int
+}
+
+// EncryptionKeyStatic ephemeral encryption key.
+type EncryptionKeyStatic interface {
+	Key() []byte
 }
 
+// EncryptionKeyNodeID deterministically generated encryption key.
+type EncryptionKeyNodeID interface{}
+
 // Security defines the requirements for a config that pertains to security
 // related options.
 type Security interface {
@@ -115,11 +193,31 @@ type Device interface {
 	Ignore() bool
 	Dummy() bool
 	DHCPOptions() DHCPOptions
+	AcceptRA() *bool
+	WireguardConfig() WireguardConfig
+}
+
+// WireguardConfig contains settings for configuring WireGuard network interface.
+type WireguardConfig interface {
+	PrivateKey() string
+	ListenPort() int
+	FirewallMark() int
+	Peers() []WireguardPeer
+}
+
+// WireguardPeer represents a WireGuard device peer configuration.
+type WireguardPeer interface {
+	PublicKey() string
+	Endpoint() string
+	PersistentKeepaliveInterval() time.Duration
+	AllowedIPs() []string
 }
 
 // DHCPOptions represents a set of DHCP options.
 type DHCPOptions interface {
 	RouteMetric() uint32
+	IPv4() bool
+	IPv6() bool
 }
 
 // Bond contains the various options for configuring a
@@ -176,6 +274,46 @@ type Time interface {
 	Servers() []string
 }
 
+// Logging defines the requirements for a config that pertains to logging
+// related options.
+type Logging interface {
+	Destinations() []LoggingDestination
+}
+
+// LoggingDestination describes remote destination for the logs.
+type LoggingDestination interface {
+	Endpoint() *url.URL
+	Format() string
+}
+
+// Metrics defines the requirements for a config that pertains to the metrics
+// endpoint.
+type Metrics interface {
+	Enabled() bool
+	Port() int
+}
+
+// Service defines the requirements for a config that pertains to the
+// user-defined system service.
+type Service interface {
+	Name() string
+	Image() string
+	Args() []string
+	Env() map[string]string
+	Mounts() []specs.Mount
+	Restart() string
+	DependsOn() []string
+	HealthCheck() ServiceHealthCheck
+}
+
+// ServiceHealthCheck describes the health check of the user-defined service.
+type ServiceHealthCheck interface {
+	TCP() string
+	HTTP() string
+	Interval() time.Duration
+	Timeout() time.Duration
+}
+
 // Kubelet defines the requirements for a config that pertains to kubelet
 // related options.
 type Kubelet interface {
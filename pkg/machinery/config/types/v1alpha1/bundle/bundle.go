@@ -14,6 +14,7 @@ import (
 	yaml "gopkg.in/yaml.v3"
 
 	"github.com/talos-systems/talos/pkg/machinery/client/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
@@ -72,7 +73,7 @@ func NewConfigBundle(opts ...Option) (*v1alpha1.ConfigBundle, error) {
 			return bundle, err
 		}
 
-		return bundle, nil
+		return bundle, applyPatches(bundle, &options)
 	}
 
 	// Handle generating net-new configs
@@ -121,5 +122,34 @@ func NewConfigBundle(opts ...Option) (*v1alpha1.ConfigBundle, error) {
 		return bundle, err
 	}
 
-	return bundle, nil
+	return bundle, applyPatches(bundle, &options)
+}
+
+// applyPatches applies the patches to the machine configs of the bundle.
+func applyPatches(bundle *v1alpha1.ConfigBundle, options *Options) error {
+	for _, cfg := range []struct {
+		cfg     **v1alpha1.Config
+		patches []configpatcher.Patch
+	}{
+		{&bundle.InitCfg, append(append([]configpatcher.Patch(nil), options.Patches...), options.PatchesControlPlane...)},
+		{&bundle.ControlPlaneCfg, append(append([]configpatcher.Patch(nil), options.Patches...), options.PatchesControlPlane...)},
+		{&bundle.JoinCfg, append(append([]configpatcher.Patch(nil), options.Patches...), options.PatchesJoin...)},
+	} {
+		if len(cfg.patches) == 0 {
+			continue
+		}
+
+		patched, err := configpatcher.ApplyConfig(*cfg.cfg, cfg.patches)
+		if err != nil {
+			return err
+		}
+
+		var ok bool
+
+		if *cfg.cfg, ok = patched.(*v1alpha1.Config); !ok {
+			return fmt.Errorf("unexpected config type %T after patching", patched)
+		}
+	}
+
+	return nil
 }
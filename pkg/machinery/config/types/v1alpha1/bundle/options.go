@@ -4,7 +4,10 @@
 
 package bundle
 
-import "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
+import (
+	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
+)
 
 // Option controls config options specific to config bundle generation.
 type Option func(o *Options) error
@@ -22,6 +25,12 @@ type Options struct {
 	ExistingConfigs string // path to existing config files
 	Verbose         bool   // wheither to write any logs during generate
 	InputOptions    *InputOptions
+
+	// Patches are applied to all the machine configs,
+	// PatchesControlPlane to init and controlplane configs, PatchesJoin to join config.
+	Patches             []configpatcher.Patch
+	PatchesControlPlane []configpatcher.Patch
+	PatchesJoin         []configpatcher.Patch
 }
 
 // DefaultOptions returns default options.
@@ -57,3 +66,30 @@ func WithVerbose(verbose bool) Option {
 		return nil
 	}
 }
+
+// WithPatch allows patching all the generated machine configs.
+func WithPatch(patches []configpatcher.Patch) Option {
+	return func(o *Options) error {
+		o.Patches = append(o.Patches, patches...)
+
+		return nil
+	}
+}
+
+// WithPatchControlPlane allows patching init and controlplane machine configs.
+func WithPatchControlPlane(patches []configpatcher.Patch) Option {
+	return func(o *Options) error {
+		o.PatchesControlPlane = append(o.PatchesControlPlane, patches...)
+
+		return nil
+	}
+}
+
+// WithPatchJoin allows patching join machine config.
+func WithPatchJoin(patches []configpatcher.Patch) Option {
+	return func(o *Options) error {
+		o.PatchesJoin = append(o.PatchesJoin, patches...)
+
+		return nil
+	}
+}
@@ -23,6 +23,7 @@ import (
 	v1alpha1 "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/role"
 )
 
 // Config returns the talos config for a given node type.
@@ -288,10 +289,16 @@ func NewTalosCA() (ca *x509.CertificateAuthority, err error) {
 
 // NewAdminCertificateAndKey generates the admin Talos certifiate and key.
 func NewAdminCertificateAndKey(crt, key []byte, loopback string) (p *x509.PEMEncodedCertificateAndKey, err error) {
+	return NewClientCertificateAndKey(crt, key, loopback, role.Admin)
+}
+
+// NewClientCertificateAndKey generates the Talos client certificate and key limited to the specified role.
+func NewClientCertificateAndKey(crt, key []byte, loopback string, r role.Role) (p *x509.PEMEncodedCertificateAndKey, err error) {
 	ips := []net.IP{net.ParseIP(loopback)}
 
 	opts := []x509.Option{
 		x509.IPAddresses(ips),
+		x509.Organization(string(r)),
 		x509.NotAfter(time.Now().Add(87600 * time.Hour)),
 	}
 
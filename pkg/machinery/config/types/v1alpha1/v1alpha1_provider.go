@@ -30,6 +30,19 @@ const (
 	Version = "v1alpha1"
 )
 
+// Filesystem types supported for the user disk partitions and volumes.
+const (
+	FilesystemXFS  = "xfs"
+	FilesystemExt4 = "ext4"
+	FilesystemNone = "none"
+)
+
+// Volume types.
+const (
+	VolumeTypeRAID = "raid"
+	VolumeTypeLVM  = "lvm"
+)
+
 // Version implements the config.Provider interface.
 func (c *Config) Version() string {
 	return Version
@@ -144,6 +157,17 @@ func (m *MachineConfig) Disks() []config.Disk {
 	return disks
 }
 
+// Volumes implements the config.Provider interface.
+func (m *MachineConfig) Volumes() []config.Volume {
+	volumes := make([]config.Volume, len(m.MachineVolumes))
+
+	for i := 0; i < len(m.MachineVolumes); i++ {
+		volumes[i] = m.MachineVolumes[i]
+	}
+
+	return volumes
+}
+
 // Network implements the config.Provider interface.
 func (m *MachineConfig) Network() config.MachineNetwork {
 	if m.MachineNetwork == nil {
@@ -162,6 +186,35 @@ func (m *MachineConfig) Time() config.Time {
 	return m.MachineTime
 }
 
+// Logging implements the config.Provider interface.
+func (m *MachineConfig) Logging() config.Logging {
+	if m.MachineLogging == nil {
+		return &LoggingConfig{}
+	}
+
+	return m.MachineLogging
+}
+
+// Metrics implements the config.Provider interface.
+func (m *MachineConfig) Metrics() config.Metrics {
+	if m.MachineMetrics == nil {
+		return &MetricsConfig{}
+	}
+
+	return m.MachineMetrics
+}
+
+// Services implements the config.Provider interface.
+func (m *MachineConfig) Services() []config.Service {
+	services := make([]config.Service, len(m.MachineServices))
+
+	for i := range m.MachineServices {
+		services[i] = m.MachineServices[i]
+	}
+
+	return services
+}
+
 // Kubelet implements the config.Provider interface.
 func (m *MachineConfig) Kubelet() config.Kubelet {
 	if m.MachineKubelet == nil {
@@ -786,11 +839,85 @@ func (d *Device) DHCPOptions() config.DHCPOptions {
 	return d.DeviceDHCPOptions
 }
 
+// AcceptRA implements the MachineNetwork interface.
+func (d *Device) AcceptRA() *bool {
+	return d.DeviceAcceptRA
+}
+
+// WireguardConfig implements the MachineNetwork interface.
+func (d *Device) WireguardConfig() config.WireguardConfig {
+	if d.DeviceWireguardConfig == nil {
+		return nil
+	}
+
+	return d.DeviceWireguardConfig
+}
+
+// PrivateKey implements the MachineNetwork interface.
+func (wc *DeviceWireguardConfig) PrivateKey() string {
+	return wc.WireguardPrivateKey
+}
+
+// ListenPort implements the MachineNetwork interface.
+func (wc *DeviceWireguardConfig) ListenPort() int {
+	return wc.WireguardListenPort
+}
+
+// FirewallMark implements the MachineNetwork interface.
+func (wc *DeviceWireguardConfig) FirewallMark() int {
+	return wc.WireguardFirewallMark
+}
+
+// Peers implements the MachineNetwork interface.
+func (wc *DeviceWireguardConfig) Peers() []config.WireguardPeer {
+	peers := make([]config.WireguardPeer, len(wc.WireguardPeers))
+
+	for i := 0; i < len(wc.WireguardPeers); i++ {
+		peers[i] = wc.WireguardPeers[i]
+	}
+
+	return peers
+}
+
+// PublicKey implements the MachineNetwork interface.
+func (wd *DeviceWireguardPeer) PublicKey() string {
+	return wd.WireguardPublicKey
+}
+
+// Endpoint implements the MachineNetwork interface.
+func (wd *DeviceWireguardPeer) Endpoint() string {
+	return wd.WireguardEndpoint
+}
+
+// PersistentKeepaliveInterval implements the MachineNetwork interface.
+func (wd *DeviceWireguardPeer) PersistentKeepaliveInterval() time.Duration {
+	return wd.WireguardPersistentKeepaliveInterval
+}
+
+// AllowedIPs implements the MachineNetwork interface.
+func (wd *DeviceWireguardPeer) AllowedIPs() []string {
+	return wd.WireguardAllowedIPs
+}
+
 // RouteMetric implements the MachineNetwork interface.
 func (d *DHCPOptions) RouteMetric() uint32 {
 	return d.DHCPRouteMetric
 }
 
+// IPv4 implements the MachineNetwork interface.
+func (d *DHCPOptions) IPv4() bool {
+	if d.DHCPIPv4 == nil {
+		return true
+	}
+
+	return *d.DHCPIPv4
+}
+
+// IPv6 implements the MachineNetwork interface.
+func (d *DHCPOptions) IPv6() bool {
+	return d.DHCPIPv6
+}
+
 // Network implements the MachineNetwork interface.
 func (r *Route) Network() string {
 	return r.RouteNetwork
@@ -985,6 +1112,121 @@ func (t *TimeConfig) Servers() []string {
 	return t.TimeServers
 }
 
+// Destinations implements the config.Provider interface.
+func (l *LoggingConfig) Destinations() []config.LoggingDestination {
+	destinations := make([]config.LoggingDestination, len(l.LoggingDestinations))
+
+	for i := range l.LoggingDestinations {
+		destinations[i] = l.LoggingDestinations[i]
+	}
+
+	return destinations
+}
+
+// Endpoint implements the config.Provider interface.
+func (d *LoggingDestination) Endpoint() *url.URL {
+	if d.LoggingEndpoint == nil {
+		return nil
+	}
+
+	return d.LoggingEndpoint.URL
+}
+
+// Format implements the config.Provider interface.
+func (d *LoggingDestination) Format() string {
+	return d.LoggingFormat
+}
+
+// Enabled implements the config.Provider interface.
+func (m *MetricsConfig) Enabled() bool {
+	return m.MetricsEnabled
+}
+
+// Port implements the config.Provider interface.
+func (m *MetricsConfig) Port() int {
+	if m.MetricsPort == 0 {
+		return constants.DefaultMetricsPort
+	}
+
+	return m.MetricsPort
+}
+
+// Name implements the config.Provider interface.
+func (s *ServiceConfig) Name() string {
+	return s.ServiceName
+}
+
+// Image implements the config.Provider interface.
+func (s *ServiceConfig) Image() string {
+	return s.ServiceImage
+}
+
+// Args implements the config.Provider interface.
+func (s *ServiceConfig) Args() []string {
+	return s.ServiceArgs
+}
+
+// Env implements the config.Provider interface.
+func (s *ServiceConfig) Env() map[string]string {
+	return s.ServiceEnv
+}
+
+// Mounts implements the config.Provider interface.
+func (s *ServiceConfig) Mounts() []specs.Mount {
+	return s.ServiceMounts
+}
+
+// Restart implements the config.Provider interface.
+func (s *ServiceConfig) Restart() string {
+	if s.ServiceRestart == "" {
+		return constants.DefaultServiceRestart
+	}
+
+	return s.ServiceRestart
+}
+
+// DependsOn implements the config.Provider interface.
+func (s *ServiceConfig) DependsOn() []string {
+	return s.ServiceDependsOn
+}
+
+// HealthCheck implements the config.Provider interface.
+func (s *ServiceConfig) HealthCheck() config.ServiceHealthCheck {
+	if s.ServiceHealthCheck == nil {
+		return nil
+	}
+
+	return s.ServiceHealthCheck
+}
+
+// TCP implements the config.Provider interface.
+func (h *ServiceHealthCheckConfig) TCP() string {
+	return h.HealthCheckTCP
+}
+
+// HTTP implements the config.Provider interface.
+func (h *ServiceHealthCheckConfig) HTTP() string {
+	return h.HealthCheckHTTP
+}
+
+// Interval implements the config.Provider interface.
+func (h *ServiceHealthCheckConfig) Interval() time.Duration {
+	if h.HealthCheckInterval == 0 {
+		return constants.DefaultServiceHealthCheckInterval
+	}
+
+	return h.HealthCheckInterval
+}
+
+// Timeout implements the config.Provider interface.
+func (h *ServiceHealthCheckConfig) Timeout() time.Duration {
+	if h.HealthCheckTimeout == 0 {
+		return constants.DefaultServiceHealthCheckTimeout
+	}
+
+	return h.HealthCheckTimeout
+}
+
 // Image implements the config.Provider interface.
 func (i *InstallConfig) Image() string {
 	return i.InstallImage
@@ -995,6 +1237,40 @@ func (i *InstallConfig) Disk() string {
 	return i.InstallDisk
 }
 
+// DiskSelector implements the config.Provider interface.
+func (i *InstallConfig) DiskSelector() config.InstallDiskSelector {
+	if i.InstallDiskSelector == nil {
+		return nil
+	}
+
+	return i.InstallDiskSelector
+}
+
+// MinSize implements the config.Provider interface.
+func (s *InstallDiskSelector) MinSize() uint64 {
+	return uint64(s.SelectorMinSize)
+}
+
+// MaxSize implements the config.Provider interface.
+func (s *InstallDiskSelector) MaxSize() uint64 {
+	return uint64(s.SelectorMaxSize)
+}
+
+// Model implements the config.Provider interface.
+func (s *InstallDiskSelector) Model() string {
+	return s.SelectorModel
+}
+
+// Serial implements the config.Provider interface.
+func (s *InstallDiskSelector) Serial() string {
+	return s.SelectorSerial
+}
+
+// Bus implements the config.Provider interface.
+func (s *InstallDiskSelector) Bus() string {
+	return s.SelectorBus
+}
+
 // ExtraKernelArgs implements the config.Provider interface.
 func (i *InstallConfig) ExtraKernelArgs() []string {
 	return i.InstallExtraKernelArgs
@@ -1010,6 +1286,132 @@ func (i *InstallConfig) WithBootloader() bool {
 	return i.InstallBootloader
 }
 
+// SystemDiskEncryption implements the config.Provider interface.
+func (i *InstallConfig) SystemDiskEncryption() config.SystemDiskEncryption {
+	if i.InstallSystemDiskEncryption == nil {
+		return &SystemDiskEncryptionConfig{}
+	}
+
+	return i.InstallSystemDiskEncryption
+}
+
+// ImageVerification implements the config.Provider interface.
+func (i *InstallConfig) ImageVerification() config.ImageVerification {
+	if i.InstallImageVerification == nil {
+		return nil
+	}
+
+	return i.InstallImageVerification
+}
+
+// PublicKeys implements the config.Provider interface.
+func (v *ImageVerificationConfig) PublicKeys() []string {
+	return v.VerificationPublicKeys
+}
+
+// BootWatchdog implements the config.Provider interface.
+func (i *InstallConfig) BootWatchdog() config.BootWatchdog {
+	if i.InstallBootWatchdog == nil {
+		return nil
+	}
+
+	return i.InstallBootWatchdog
+}
+
+// Timeout implements the config.Provider interface.
+func (w *BootWatchdogConfig) Timeout() time.Duration {
+	if w.WatchdogTimeout == 0 {
+		return constants.DefaultBootWatchdogTimeout
+	}
+
+	return w.WatchdogTimeout
+}
+
+// Services implements the config.Provider interface.
+func (w *BootWatchdogConfig) Services() []string {
+	return w.WatchdogServices
+}
+
+// Get implements the config.Provider interface.
+func (e *SystemDiskEncryptionConfig) Get(label string) config.Encryption {
+	switch label {
+	case constants.StatePartitionLabel:
+		if e.StatePartition != nil {
+			return e.StatePartition
+		}
+	case constants.EphemeralPartitionLabel:
+		if e.EphemeralPartition != nil {
+			return e.EphemeralPartition
+		}
+	}
+
+	return nil
+}
+
+// Provider implements the config.Provider interface.
+func (e *EncryptionConfig) Provider() string {
+	return e.EncryptionProvider
+}
+
+// Cipher implements the config.Provider interface.
+func (e *EncryptionConfig) Cipher() string {
+	return e.EncryptionCipher
+}
+
+// KeySize implements the config.Provider interface.
+func (e *EncryptionConfig) KeySize() uint {
+	return e.EncryptionKeySize
+}
+
+// BlockSize implements the config.Provider interface.
+func (e *EncryptionConfig) BlockSize() uint64 {
+	return e.EncryptionBlockSize
+}
+
+// Options implements the config.Provider interface.
+func (e *EncryptionConfig) Options() []string {
+	return e.EncryptionPerfOptions
+}
+
+// Keys implements the config.Provider interface.
+func (e *EncryptionConfig) Keys() []config.EncryptionKey {
+	keys := make([]config.EncryptionKey, len(e.EncryptionKeys))
+
+	for i, key := range e.EncryptionKeys {
+		keys[i] = key
+	}
+
+	return keys
+}
+
+// Static implements the config.Provider interface.
+func (k *EncryptionKey) Static() config.EncryptionKeyStatic {
+	if k.KeyStatic == nil {
+		return nil
+	}
+
+	return k.KeyStatic
+}
+
+// NodeID implements the config.Provider interface.
+func (k *EncryptionKey) NodeID() config.EncryptionKeyNodeID {
+	if k.KeyNodeID == nil {
+		return nil
+	}
+
+	return k.KeyNodeID
+}
+
+// Slot implements the config.Provider interface.
+func (k *EncryptionKey) Slot() int {
+	return k.KeySlot
+}
+
+// Key implements the config.Provider interface.
+func (k *EncryptionKeyStatic) Key() []byte {
+	return []byte(k.KeyData)
+}
+
 // Image implements the config.Provider interface.
 func (c *CoreDNS) Image() string {
 	coreDNSImage := fmt.Sprintf("%s:%s", constants.CoreDNSImage, constants.DefaultCoreDNSVersion)
@@ -1065,6 +1467,11 @@ func (d *MachineDisk) Device() string {
 	return d.DeviceName
 }
 
+// Wipe implements the config.Provider interface.
+func (d *MachineDisk) Wipe() bool {
+	return d.DiskWipe
+}
+
 // Partitions implements the config.Provider interface.
 func (d *MachineDisk) Partitions() []config.Partition {
 	partitions := make([]config.Partition, len(d.DiskPartitions))
@@ -1085,3 +1492,71 @@ func (p *DiskPartition) Size() uint64 {
 func (p *DiskPartition) MountPoint() string {
 	return p.DiskMountPoint
 }
+
+// Filesystem implements the config.Provider interface.
+func (p *DiskPartition) Filesystem() string {
+	if p.DiskFilesystem == "" {
+		return FilesystemXFS
+	}
+
+	return p.DiskFilesystem
+}
+
+// Label implements the config.Provider interface.
+func (p *DiskPartition) Label() string {
+	return p.DiskLabel
+}
+
+// MountOptions implements the config.Provider interface.
+func (p *DiskPartition) MountOptions() []string {
+	return p.DiskMountOptions
+}
+
+// Name implements the config.Provider interface.
+func (v *MachineVolume) Name() string {
+	return v.VolumeName
+}
+
+// Type implements the config.Provider interface.
+func (v *MachineVolume) Type() string {
+	return v.VolumeType
+}
+
+// Level implements the config.Provider interface.
+func (v *MachineVolume) Level() int {
+	return v.VolumeLevel
+}
+
+// Devices implements the config.Provider interface.
+func (v *MachineVolume) Devices() []string {
+	return v.VolumeDevices
+}
+
+// Filesystem implements the config.Provider interface.
+func (v *MachineVolume) Filesystem() string {
+	if v.VolumeFilesystem == "" {
+		return FilesystemXFS
+	}
+
+	return v.VolumeFilesystem
+}
+
+// Label implements the config.Provider interface.
+func (v *MachineVolume) Label() string {
+	return v.VolumeLabel
+}
+
+// MountPoint implements the config.Provider interface.
+func (v *MachineVolume) MountPoint() string {
+	return v.VolumeMountPoint
+}
+
+// MountOptions implements the config.Provider interface.
+func (v *MachineVolume) MountOptions() []string {
+	return v.VolumeMountOptions
+}
+
+// Wipe implements the config.Provider interface.
+func (v *MachineVolume) Wipe() bool {
+	return v.VolumeWipe
+}
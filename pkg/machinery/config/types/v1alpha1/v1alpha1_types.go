@@ -147,12 +147,29 @@ var (
 			DeviceName: "/dev/sdb",
 			DiskPartitions: []*DiskPartition{
 				{
+					DiskSize:       DiskSize(100 * 1024 * 1024 * 1024),
 					DiskMountPoint: "/var/mnt/extra",
+					DiskFilesystem: "ext4",
+					DiskLabel:      "extra",
+				},
+				{
+					DiskFilesystem: "none",
+					DiskLabel:      "ceph",
 				},
 			},
 		},
 	}
 
+	machineVolumesExample []*MachineVolume = []*MachineVolume{
+		{
+			VolumeName:       "data",
+			VolumeType:       "raid",
+			VolumeLevel:      1,
+			VolumeDevices:    []string{"/dev/sdb", "/dev/sdc"},
+			VolumeMountPoint: "/var/mnt/data",
+		},
+	}
+
 	machineInstallExample = &InstallConfig{
 		InstallDisk:            "/dev/sda",
 		InstallExtraKernelArgs: []string{"console=ttyS1", "panic=10"},
@@ -161,6 +178,48 @@ var (
 		InstallWipe:            false,
 	}
 
+	installSystemDiskEncryptionExample = &SystemDiskEncryptionConfig{
+		EphemeralPartition: &EncryptionConfig{
+			EncryptionProvider: "luks2",
+			EncryptionKeys: []*EncryptionKey{
+				{
+					KeyNodeID: &EncryptionKeyNodeID{},
+					KeySlot:   0,
+				},
+			},
+		},
+	}
+
+	installImageVerificationExample = &ImageVerificationConfig{
+		VerificationPublicKeys: []string{
+			"-----BEGIN PUBLIC KEY-----\nMFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE...\n-----END PUBLIC KEY-----\n",
+		},
+	}
+
+	installDiskSelectorExample = &InstallDiskSelector{
+		SelectorMinSize: DiskSize(100 * 1000 * 1000 * 1000),
+		SelectorModel:   "WDC*",
+		SelectorBus:     "nvme",
+	}
+
+	installBootWatchdogExample = &BootWatchdogConfig{
+		WatchdogTimeout:  15 * time.Minute,
+		WatchdogServices: []string{"apid", "etcd", "kubelet"},
+	}
+
+	installEncryptionKeysExample = []*EncryptionKey{
+		{
+			KeyStatic: &EncryptionKeyStatic{
+				KeyData: "exampleKey",
+			},
+			KeySlot: 0,
+		},
+		{
+			KeyNodeID: &EncryptionKeyNodeID{},
+			KeySlot:   1,
+		},
+	}
+
 	machineFilesExample = []*MachineFile{
 		{
 			FileContent:     "...",
@@ -189,6 +248,52 @@ var (
 		TimeServers: []string{"time.cloudflare.com"},
 	}
 
+	machineLoggingExample = &LoggingConfig{
+		LoggingDestinations: []*LoggingDestination{
+			{
+				LoggingEndpoint: &Endpoint{
+					URL: &url.URL{
+						Scheme: "udp",
+						Host:   "10.5.0.1:514",
+					},
+				},
+				LoggingFormat: "rfc5424",
+			},
+		},
+	}
+
+	machineMetricsExample = &MetricsConfig{
+		MetricsEnabled: true,
+	}
+
+	machineServicesExample = []*ServiceConfig{
+		{
+			ServiceName:  "log-shipper",
+			ServiceImage: "docker.io/example/log-shipper:v1.0.0",
+			ServiceArgs:  []string{"--listen=127.0.0.1:9880"},
+			ServiceEnv: Env{
+				"LOG_LEVEL": "info",
+			},
+			ServiceMounts: []specs.Mount{
+				{
+					Source:      "/var/log",
+					Destination: "/var/log",
+					Type:        "bind",
+					Options: []string{
+						"rbind",
+						"ro",
+					},
+				},
+			},
+			ServiceRestart:   "always",
+			ServiceDependsOn: []string{"networkd"},
+			ServiceHealthCheck: &ServiceHealthCheckConfig{
+				HealthCheckHTTP:     "http://127.0.0.1:9880/healthz",
+				HealthCheckInterval: 10 * time.Second,
+			},
+		},
+	}
+
 	machineSysctlsExample map[string]string = map[string]string{
 		"kernel.domainname":   "talos.dev",
 		"net.ipv4.ip_forward": "0",
@@ -316,8 +421,33 @@ var (
 		BondInterfaces: []string{"eth0", "eth1"},
 	}
 
+	networkConfigWireguardHostExample = &DeviceWireguardConfig{
+		WireguardPrivateKey: "ABCDEF...",
+		WireguardListenPort: 51111,
+		WireguardPeers: []*DeviceWireguardPeer{
+			{
+				WireguardPublicKey:  "ABCDEF...",
+				WireguardEndpoint:   "192.168.1.3:51820",
+				WireguardAllowedIPs: []string{"192.168.1.0/24"},
+			},
+		},
+	}
+
+	networkConfigWireguardPeerExample = &DeviceWireguardConfig{
+		WireguardPrivateKey: "ABCDEF...",
+		WireguardPeers: []*DeviceWireguardPeer{
+			{
+				WireguardPublicKey:                   "ABCDEF...",
+				WireguardEndpoint:                    "192.168.1.2:51822",
+				WireguardPersistentKeepaliveInterval: 10 * time.Second,
+				WireguardAllowedIPs:                  []string{"192.168.1.0/24"},
+			},
+		},
+	}
+
 	networkConfigDHCPOptionsExample = &DHCPOptions{
 		DHCPRouteMetric: 1024,
+		DHCPIPv6:        true,
 	}
 
 	clusterCustomCNIExample = &CNIConfig{
@@ -428,11 +558,21 @@ type MachineConfig struct {
 	//     Since the rootfs is read only with the exception of `/var`, mounts are only valid if they are under `/var`.
 	//     Note that the partitioning and formating is done only once, if and only if no existing partitions are found.
 	//     If `size:` is omitted, the partition is sized to occupy the full disk.
+	//     Disks without partitions are left as is, so that they can be used as the members of the `volumes`.
 	//   examples:
 	//     - name: MachineDisks list example.
 	//       value: machineDisksExample
 	MachineDisks []*MachineDisk `yaml:"disks,omitempty"` // Note: `size` is in units of bytes.
 	//   description: |
+	//     Used to assemble md RAID arrays or LVM volume groups from the `disks` and to format and mount them.
+	//     The members of the volume should be listed in `disks` without partitions.
+	//     The volume is created only if all the members are blank, otherwise the existing volume is assembled
+	//     (blank members are added to the existing volume, e.g. to replace the failed disk of the RAID array).
+	//   examples:
+	//     - name: MachineVolumes usage example.
+	//       value: machineVolumesExample
+	MachineVolumes []*MachineVolume `yaml:"volumes,omitempty"`
+	//   description: |
 	//     Used to provide instructions for installations.
 	//   examples:
 	//     - name: MachineInstall config usage example.
@@ -492,6 +632,24 @@ type MachineConfig struct {
 	//   examples:
 	//     - value: machineConfigRegistriesExample
 	MachineRegistries RegistriesConfig `yaml:"registries,omitempty"`
+	//   description: |
+	//     Used to configure forwarding of the service and kernel logs to the remote destinations.
+	//   examples:
+	//     - value: machineLoggingExample
+	MachineLogging *LoggingConfig `yaml:"logging,omitempty"`
+	//   description: |
+	//     Used to configure the Prometheus metrics endpoint of the node.
+	//   examples:
+	//     - value: machineMetricsExample
+	MachineMetrics *MetricsConfig `yaml:"metrics,omitempty"`
+	//   description: |
+	//     Used to run additional containerized system services on the node.
+	//
+	//     Services are started by machined along with the built-in services, before kubelet,
+	//     and can be managed with `talosctl service`.
+	//   examples:
+	//     - value: machineServicesExample
+	MachineServices []*ServiceConfig `yaml:"services,omitempty"`
 }
 
 // ClusterConfig represents the cluster-wide config values.
@@ -658,6 +816,15 @@ type InstallConfig struct {
 	//     - value: '"/dev/nvme0"'
 	InstallDisk string `yaml:"disk,omitempty"`
 	//   description: |
+	//     Look up the install disk by the disk properties instead of the device path.
+	//
+	//     All the specified criteria should match, the first matching disk (sorted by the device name) is used.
+	//     Disk selector takes precedence over the install disk path.
+	//     The properties of the disks are reported by the `talosctl disks` command.
+	//   examples:
+	//     - value: installDiskSelectorExample
+	InstallDiskSelector *InstallDiskSelector `yaml:"diskSelector,omitempty"`
+	//   description: |
 	//     Allows for supplying extra kernel args via the bootloader.
 	//   examples:
 	//     - value: '[]string{"talos.platform=metal", "reboot=k"}'
@@ -686,8 +853,164 @@ type InstallConfig struct {
 	//     - false
 	//     - no
 	InstallWipe bool `yaml:"wipe"`
+	//   description: |
+	//     Enables encryption of the system partitions.
+	//
+	//     Encrypted partitions are formatted on the first boot after the installation,
+	//     the volume is opened transparently when the partition is mounted.
+	//     The encryption can't be enabled or disabled for an existing partition.
+	//   examples:
+	//     - value: installSystemDiskEncryptionExample
+	InstallSystemDiskEncryption *SystemDiskEncryptionConfig `yaml:"systemDiskEncryption,omitempty"`
+	//   description: |
+	//     Enables signature verification of the installer, kubelet and etcd images.
+	//
+	//     Before the image is pulled, the signature of the image digest is fetched from the registry
+	//     (cosign signature format) and verified against the trusted public keys.
+	//     Unsigned images and images with invalid signatures are rejected.
+	//   examples:
+	//     - value: installImageVerificationExample
+	InstallImageVerification *ImageVerificationConfig `yaml:"imageVerification,omitempty"`
+	//   description: |
+	//     Enables automatic rollback of the upgrade if the node fails to boot.
+	//
+	//     On the first boot after the upgrade, if the boot sequence doesn't finish and the listed services
+	//     are not healthy within the timeout, the bootloader is reverted to the previous installation and
+	//     the node is rebooted.
+	//     The reason of the rollback is reported as an event on the next boot.
+	//   examples:
+	//     - value: installBootWatchdogExample
+	InstallBootWatchdog *BootWatchdogConfig `yaml:"bootWatchdog,omitempty"`
 }
 
+// InstallDiskSelector represents the criteria to look up the install disk.
+type InstallDiskSelector struct {
+	//   description: |
+	//     Minimum size of the disk: either bytes or human readable representation.
+	//   examples:
+	//     - value: DiskSize(100000000000)
+	SelectorMinSize DiskSize `yaml:"minSize,omitempty"`
+	//   description: |
+	//     Maximum size of the disk: either bytes or human readable representation.
+	//   examples:
+	//     - value: DiskSize(2000000000000)
+	SelectorMaxSize DiskSize `yaml:"maxSize,omitempty"`
+	//   description: |
+	//     Disk model glob pattern.
+	//   examples:
+	//     - value: '"WDC*"'
+	SelectorModel string `yaml:"model,omitempty"`
+	//   description: |
+	//     Disk serial number.
+	SelectorSerial string `yaml:"serial,omitempty"`
+	//   description: |
+	//     The bus the disk is attached to.
+	//   values:
+	//     - ata
+	//     - scsi
+	//     - nvme
+	//     - virtio
+	//     - usb
+	//     - mmc
+	SelectorBus string `yaml:"bus,omitempty"`
+}
+
+// BootWatchdogConfig specifies the boot watchdog settings.
+type BootWatchdogConfig struct {
+	//   description: |
+	//     The time for the node to boot after the upgrade.
+	//     Defaults to 10 minutes.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	WatchdogTimeout time.Duration `yaml:"timeout,omitempty"`
+	//   description: |
+	//     The services which should be healthy for the boot to be successful (e.g. `apid`, `etcd`, `kubelet`).
+	//     Services which don't run on the node are ignored.
+	WatchdogServices []string `yaml:"services,omitempty"`
+}
+
+// ImageVerificationConfig specifies the image signature verification policy.
+type ImageVerificationConfig struct {
+	//   description: |
+	//     PEM-encoded public keys (ECDSA, RSA or Ed25519) trusted to sign the images.
+	//     The image is accepted if it is signed with any of the keys.
+	VerificationPublicKeys []string `yaml:"publicKeys"`
+}
+
+// SystemDiskEncryptionConfig specifies system disk partitions encryption settings.
+type SystemDiskEncryptionConfig struct {
+	//   description: |
+	//     State partition encryption.
+	StatePartition *EncryptionConfig `yaml:"state,omitempty"`
+	//   description: |
+	//     Ephemeral partition encryption.
+	EphemeralPartition *EncryptionConfig `yaml:"ephemeral,omitempty"`
+}
+
+// EncryptionConfig represents partition encryption settings.
+type EncryptionConfig struct {
+	//   description: |
+	//     Encryption provider to use for the encryption.
+	//   values:
+	//     - luks2
+	EncryptionProvider string `yaml:"provider"`
+	//   description: |
+	//     Defines the encryption keys generation and storage method.
+	//     Each key is stored in the separate key slot, any of the keys can be used to open the volume.
+	//   examples:
+	//     - value: installEncryptionKeysExample
+	EncryptionKeys []*EncryptionKey `yaml:"keys"`
+	//   description: |
+	//     Cipher kind to use for the encryption.
+	//     Depends on the encryption provider.
+	//   values:
+	//     - aes-xts-plain64
+	//     - xchacha12,aes-adiantum-plain64
+	//     - xchacha20,aes-adiantum-plain64
+	//   examples:
+	//     - value: '"aes-xts-plain64"'
+	EncryptionCipher string `yaml:"cipher,omitempty"`
+	//   description: |
+	//     Defines the encryption key length.
+	EncryptionKeySize uint `yaml:"keySize,omitempty"`
+	//   description: |
+	//     Defines the encryption sector size.
+	//   examples:
+	//     - value: '4096'
+	EncryptionBlockSize uint64 `yaml:"blockSize,omitempty"`
+	//   description: |
+	//     Additional --perf parameters for the LUKS2 encryption.
+	//   values:
+	//     - no_read_workqueue
+	//     - no_write_workqueue
+	//     - same_cpu_crypt
+	//   examples:
+	//     - value: '[]string{"no_read_workqueue","no_write_workqueue"}'
+	EncryptionPerfOptions []string `yaml:"options,omitempty"`
+}
+
+// EncryptionKey represents configuration for disk encryption key.
+type EncryptionKey struct {
+	//   description: |
+	//     Key which value is stored in the configuration file.
+	KeyStatic *EncryptionKeyStatic `yaml:"static,omitempty"`
+	//   description: |
+	//     Key derived from the node UUID and partition label with the random salt stored in the volume header.
+	KeyNodeID *EncryptionKeyNodeID `yaml:"nodeID,omitempty"`
+	//   description: |
+	//     Key slot number for LUKS2 encryption.
+	KeySlot int `yaml:"slot"`
+}
+
+// EncryptionKeyStatic represents throw away key type.
+type EncryptionKeyStatic struct {
+	//   description: |
+	//     Defines the static passphrase value.
+	KeyData string `yaml:"passphrase,omitempty"`
+}
+
+// EncryptionKeyNodeID represents the key derived from the node UUID and PartitionLabel.
+type EncryptionKeyNodeID struct{}
+
 // TimeConfig represents the options for configuring time on a machine.
 type TimeConfig struct {
 	//   description: |
@@ -697,7 +1020,101 @@ type TimeConfig struct {
 	//   description: |
 	//     Specifies time (NTP) servers to use for setting the system time.
 	//     Defaults to `pool.ntp.org`
-	TimeServers []string `yaml:"servers,omitempty"` // This parameter only supports a single time server.
+	//
+	//     All the servers are queried, and the best source is selected based on stratum and
+	//     root distance, servers which don't agree with the majority are ignored.
+	TimeServers []string `yaml:"servers,omitempty"`
+}
+
+// LoggingConfig represents the options for the log forwarding.
+type LoggingConfig struct {
+	//   description: |
+	//     List of the remote destinations the logs are forwarded to.
+	//
+	//     Logs are buffered on the node, so that the outage of the destination doesn't block the services.
+	LoggingDestinations []*LoggingDestination `yaml:"destinations"`
+}
+
+// LoggingDestination represents the remote destination for the logs.
+type LoggingDestination struct {
+	//   description: |
+	//     Where to send the logs.
+	//     Supported schemes are `udp`, `tcp` and `tls` (TCP with TLS, only for `rfc5424` format).
+	//   examples:
+	//     - value: '"udp://10.5.0.1:514"'
+	//     - value: '"tls://logs.example.com:6514"'
+	LoggingEndpoint *Endpoint `yaml:"endpoint"`
+	//   description: |
+	//     Format of the forwarded logs: syslog messages (RFC5424) or JSON objects separated by newlines.
+	//     `json_lines` format is supported only over `tcp`.
+	//   values:
+	//     - rfc5424
+	//     - json_lines
+	LoggingFormat string `yaml:"format"`
+}
+
+// MetricsConfig represents the options for the Prometheus metrics endpoint.
+type MetricsConfig struct {
+	//   description: |
+	//     Enables the `/metrics` endpoint served by machined over HTTPS.
+	//
+	//     Clients should present a certificate issued by the Talos OS CA, e.g. the one from `talosconfig`.
+	MetricsEnabled bool `yaml:"enabled"`
+	//   description: |
+	//     The port the endpoint listens on, defaults to `50002`.
+	MetricsPort int `yaml:"port,omitempty"`
+}
+
+// ServiceConfig represents the user-defined system service.
+type ServiceConfig struct {
+	//   description: |
+	//     Name of the service, used as the service ID in `talosctl service`.
+	//     Should be a valid DNS label not used by the built-in services.
+	ServiceName string `yaml:"name"`
+	//   description: |
+	//     Container image of the service.
+	ServiceImage string `yaml:"image"`
+	//   description: |
+	//     Arguments of the service process, override the image entrypoint and command.
+	ServiceArgs []string `yaml:"args,omitempty"`
+	//   description: |
+	//     Environment variables of the service.
+	ServiceEnv Env `yaml:"env,omitempty"`
+	//   description: |
+	//     Additional mounts of the service container.
+	ServiceMounts []specs.Mount `yaml:"mounts,omitempty"`
+	//   description: |
+	//     Restart policy of the service, defaults to `always`.
+	//   values:
+	//     - always
+	//     - untilSuccess
+	//     - once
+	ServiceRestart string `yaml:"restart,omitempty"`
+	//   description: |
+	//     List of the services (built-in or user-defined) which should be up before the service is started.
+	ServiceDependsOn []string `yaml:"dependsOn,omitempty"`
+	//   description: |
+	//     Health check of the service.
+	//     Services depending on this service are started when the service is healthy.
+	ServiceHealthCheck *ServiceHealthCheckConfig `yaml:"healthCheck,omitempty"`
+}
+
+// ServiceHealthCheckConfig represents the health check of the user-defined service.
+type ServiceHealthCheckConfig struct {
+	//   description: |
+	//     Address to open TCP connection to, in the form of `<host>:<port>`.
+	HealthCheckTCP string `yaml:"tcp,omitempty"`
+	//   description: |
+	//     URL to send HTTP GET request to, the service is healthy if the response status is 2xx.
+	HealthCheckHTTP string `yaml:"http,omitempty"`
+	//   description: |
+	//     Interval between the health checks, defaults to `5s`.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	HealthCheckInterval time.Duration `yaml:"interval,omitempty"`
+	//   description: |
+	//     Timeout of the single health check, defaults to `1s`.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	HealthCheckTimeout time.Duration `yaml:"timeout,omitempty"`
 }
 
 // RegistriesConfig represents the image pull options.
@@ -923,6 +1340,20 @@ type MachineDisk struct {
 	DeviceName string `yaml:"device,omitempty"`
 	//   description: A list of partitions to create on the disk.
 	DiskPartitions []*DiskPartition `yaml:"partitions,omitempty"`
+	//   description: |
+	//     Wipe and re-partition the disk if the existing partitions don't match the configuration.
+	//
+	//     By default, the disk which already has partitions is left as is.
+	//     If enabled, the existing partitions are compared with the configured partitions (by the number of partitions and the labels),
+	//     and if they don't match, the disk is wiped and partitioned again.
+	//     The contents of the partitions are not compared, so the data on the disk with the matching partitions is never wiped.
+	//     The disk is also wiped before it is partitioned for the first time.
+	//   values:
+	//     - true
+	//     - yes
+	//     - false
+	//     - no
+	DiskWipe bool `yaml:"wipe,omitempty"`
 }
 
 // DiskSize partition size in bytes.
@@ -974,6 +1405,85 @@ type DiskPartition struct {
 	//   description:
 	//     Where to mount the partition.
 	DiskMountPoint string `yaml:"mountpoint,omitempty"`
+	//   description: |
+	//     The filesystem to format the partition with.
+	//     Defaults to `xfs`, `none` leaves the partition unformatted and not mounted (e.g. for CSI drivers or Ceph).
+	//   values:
+	//     - xfs
+	//     - ext4
+	//     - none
+	DiskFilesystem string `yaml:"filesystem,omitempty"`
+	//   description: |
+	//     The label of the partition and the filesystem.
+	//     Filesystem labels are limited to 12 characters for `xfs` and 16 characters for `ext4`.
+	//   examples:
+	//     - value: '"data"'
+	DiskLabel string `yaml:"label,omitempty"`
+	//   description: |
+	//     The options to mount the filesystem with.
+	//     Defaults to `noatime`.
+	//   examples:
+	//     - value: '[]string{"noatime", "nodev", "nosuid"}'
+	DiskMountOptions []string `yaml:"mountOptions,omitempty"`
+}
+
+// MachineVolume represents the md RAID array or the LVM volume group assembled from the machine disks.
+type MachineVolume struct {
+	//   description: |
+	//     The name of the volume.
+	//     The RAID array is available as `/dev/md/<name>`, the LVM volume group and the logical volume are both named `<name>`.
+	VolumeName string `yaml:"name"`
+	//   description: |
+	//     The type of the volume.
+	//   values:
+	//     - raid
+	//     - lvm
+	VolumeType string `yaml:"type"`
+	//   description: |
+	//     The RAID level, only used for the `raid` volumes.
+	//   values:
+	//     - 0
+	//     - 1
+	//     - 10
+	VolumeLevel int `yaml:"level,omitempty"`
+	//   description: |
+	//     The disks to assemble the volume from.
+	//     The disks should be listed in `machine.disks` without partitions.
+	//   examples:
+	//     - value: '[]string{"/dev/sdb", "/dev/sdc"}'
+	VolumeDevices []string `yaml:"devices"`
+	//   description: |
+	//     The filesystem to format the volume with.
+	//     Defaults to `xfs`, `none` leaves the volume unformatted and not mounted.
+	//   values:
+	//     - xfs
+	//     - ext4
+	//     - none
+	VolumeFilesystem string `yaml:"filesystem,omitempty"`
+	//   description: |
+	//     The label of the filesystem.
+	VolumeLabel string `yaml:"label,omitempty"`
+	//   description: |
+	//     Where to mount the volume.
+	//   examples:
+	//     - value: '"/var/mnt/data"'
+	VolumeMountPoint string `yaml:"mountpoint,omitempty"`
+	//   description: |
+	//     The options to mount the filesystem with.
+	//     Defaults to `noatime`.
+	VolumeMountOptions []string `yaml:"mountOptions,omitempty"`
+	//   description: |
+	//     Wipe the member disks which contain data not belonging to the volume.
+	//
+	//     By default, the disk is used as the member only if it is blank (no partition table, no known signatures,
+	//     and the first and the last MiB are zeroed) or already belongs to the volume, otherwise the volume is not assembled.
+	//     If enabled, such disks are wiped and added to the volume.
+	//   values:
+	//     - true
+	//     - yes
+	//     - false
+	//     - no
+	VolumeWipe bool `yaml:"wipe,omitempty"`
 }
 
 // Env represents a set of environment variables.
@@ -1079,12 +1589,72 @@ type Device struct {
 	//   examples:
 	//     - value: networkConfigDHCPOptionsExample
 	DeviceDHCPOptions *DHCPOptions `yaml:"dhcpOptions,omitempty"`
+	//   description: |
+	//     Indicates if IPv6 router advertisements should be accepted on the interface (SLAAC and default route).
+	//     Router advertisements are accepted even if IPv6 forwarding is enabled.
+	//     If not set, kernel defaults are used.
+	//   examples:
+	//     - value: true
+	DeviceAcceptRA *bool `yaml:"acceptRA,omitempty"`
+	//   description: |
+	//     WireGuard specific configuration.
+	//     Interface is created as WireGuard device if this section is set.
+	//   examples:
+	//     - name: wireguard server example
+	//       value: networkConfigWireguardHostExample
+	//     - name: wireguard peer example
+	//       value: networkConfigWireguardPeerExample
+	DeviceWireguardConfig *DeviceWireguardConfig `yaml:"wireguard,omitempty"`
 }
 
 // DHCPOptions contains options for configuring the DHCP settings for a given interface.
 type DHCPOptions struct {
 	//   description: The priority of all routes received via DHCP.
 	DHCPRouteMetric uint32 `yaml:"routeMetric"`
+	//   description: |
+	//     Enables DHCPv4 protocol for the interface.
+	//     Defaults to `true`.
+	DHCPIPv4 *bool `yaml:"ipv4,omitempty"`
+	//   description: |
+	//     Enables DHCPv6 protocol for the interface (address assignment and prefix delegation).
+	//     Defaults to `false`.
+	DHCPIPv6 bool `yaml:"ipv6,omitempty"`
+}
+
+// DeviceWireguardConfig contains settings for configuring WireGuard network interface.
+type DeviceWireguardConfig struct {
+	//   description: |
+	//     Specifies a private key configuration (base64 encoded).
+	//     Can be generated by `wg genkey`.
+	//   examples:
+	//     - value: '"ABCDEF..."'
+	WireguardPrivateKey string `yaml:"privateKey,omitempty"`
+	//   description: Specifies a device's listening port.
+	//   examples:
+	//     - value: 51820
+	WireguardListenPort int `yaml:"listenPort,omitempty"`
+	//   description: Specifies a device's firewall mark.
+	WireguardFirewallMark int `yaml:"firewallMark,omitempty"`
+	//   description: Specifies a list of peer configurations to apply to a device.
+	WireguardPeers []*DeviceWireguardPeer `yaml:"peers,omitempty"`
+}
+
+// DeviceWireguardPeer represents a WireGuard device peer configuration.
+type DeviceWireguardPeer struct {
+	//   description: |
+	//     Specifies the public key of this peer (base64 encoded).
+	//     Can be extracted from private key by running `wg pubkey < private.key > public.key && cat public.key`.
+	WireguardPublicKey string `yaml:"publicKey,omitempty"`
+	//   description: Specifies the endpoint of this peer entry.
+	//   examples:
+	//     - value: '"192.168.1.3:51820"'
+	WireguardEndpoint string `yaml:"endpoint,omitempty"`
+	//   description: |
+	//     Specifies the persistent keepalive interval for this peer.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	WireguardPersistentKeepaliveInterval time.Duration `yaml:"persistentKeepaliveInterval,omitempty"`
+	//   description: AllowedIPs specifies a list of allowed IP addresses in CIDR notation for this peer.
+	WireguardAllowedIPs []string `yaml:"allowedIPs,omitempty"`
 }
 
 // Bond contains the various options for configuring a bonded interface.
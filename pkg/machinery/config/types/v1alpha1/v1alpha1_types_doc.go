@@ -11,39 +11,55 @@ import (
 )
 
 var (
-	ConfigDoc                  encoder.Doc
-	MachineConfigDoc           encoder.Doc
-	ClusterConfigDoc           encoder.Doc
-	KubeletConfigDoc           encoder.Doc
-	NetworkConfigDoc           encoder.Doc
-	InstallConfigDoc           encoder.Doc
-	TimeConfigDoc              encoder.Doc
-	RegistriesConfigDoc        encoder.Doc
-	PodCheckpointerDoc         encoder.Doc
-	CoreDNSDoc                 encoder.Doc
-	EndpointDoc                encoder.Doc
-	ControlPlaneConfigDoc      encoder.Doc
-	APIServerConfigDoc         encoder.Doc
-	ControllerManagerConfigDoc encoder.Doc
-	ProxyConfigDoc             encoder.Doc
-	SchedulerConfigDoc         encoder.Doc
-	EtcdConfigDoc              encoder.Doc
-	ClusterNetworkConfigDoc    encoder.Doc
-	CNIConfigDoc               encoder.Doc
-	AdminKubeconfigConfigDoc   encoder.Doc
-	MachineDiskDoc             encoder.Doc
-	DiskPartitionDoc           encoder.Doc
-	MachineFileDoc             encoder.Doc
-	ExtraHostDoc               encoder.Doc
-	DeviceDoc                  encoder.Doc
-	DHCPOptionsDoc             encoder.Doc
-	BondDoc                    encoder.Doc
-	VlanDoc                    encoder.Doc
-	RouteDoc                   encoder.Doc
-	RegistryMirrorConfigDoc    encoder.Doc
-	RegistryConfigDoc          encoder.Doc
-	RegistryAuthConfigDoc      encoder.Doc
-	RegistryTLSConfigDoc       encoder.Doc
+	ConfigDoc                     encoder.Doc
+	MachineConfigDoc              encoder.Doc
+	ClusterConfigDoc              encoder.Doc
+	KubeletConfigDoc              encoder.Doc
+	NetworkConfigDoc              encoder.Doc
+	InstallConfigDoc              encoder.Doc
+	InstallDiskSelectorDoc        encoder.Doc
+	BootWatchdogConfigDoc         encoder.Doc
+	ImageVerificationConfigDoc    encoder.Doc
+	SystemDiskEncryptionConfigDoc encoder.Doc
+	EncryptionConfigDoc           encoder.Doc
+	EncryptionKeyDoc              encoder.Doc
+	EncryptionKeyStaticDoc        encoder.Doc
+	EncryptionKeyNodeIDDoc        encoder.Doc
+	TimeConfigDoc                 encoder.Doc
+	LoggingConfigDoc              encoder.Doc
+	LoggingDestinationDoc         encoder.Doc
+	MetricsConfigDoc              encoder.Doc
+	ServiceConfigDoc              encoder.Doc
+	ServiceHealthCheckConfigDoc   encoder.Doc
+	RegistriesConfigDoc           encoder.Doc
+	PodCheckpointerDoc            encoder.Doc
+	CoreDNSDoc                    encoder.Doc
+	EndpointDoc                   encoder.Doc
+	ControlPlaneConfigDoc         encoder.Doc
+	APIServerConfigDoc            encoder.Doc
+	ControllerManagerConfigDoc    encoder.Doc
+	ProxyConfigDoc                encoder.Doc
+	SchedulerConfigDoc            encoder.Doc
+	EtcdConfigDoc                 encoder.Doc
+	ClusterNetworkConfigDoc       encoder.Doc
+	CNIConfigDoc                  encoder.Doc
+	AdminKubeconfigConfigDoc      encoder.Doc
+	MachineDiskDoc                encoder.Doc
+	DiskPartitionDoc              encoder.Doc
+	MachineVolumeDoc              encoder.Doc
+	MachineFileDoc                encoder.Doc
+	ExtraHostDoc                  encoder.Doc
+	DeviceDoc                     encoder.Doc
+	DHCPOptionsDoc                encoder.Doc
+	DeviceWireguardConfigDoc      encoder.Doc
+	DeviceWireguardPeerDoc        encoder.Doc
+	BondDoc                       encoder.Doc
+	VlanDoc                       encoder.Doc
+	RouteDoc                      encoder.Doc
+	RegistryMirrorConfigDoc       encoder.Doc
+	RegistryConfigDoc             encoder.Doc
+	RegistryAuthConfigDoc         encoder.Doc
+	RegistryTLSConfigDoc          encoder.Doc
 )
 
 func init() {
@@ -105,7 +121,7 @@ func init() {
 			FieldName: "machine",
 		},
 	}
-	MachineConfigDoc.Fields = make([]encoder.Doc, 13)
+	MachineConfigDoc.Fields = make([]encoder.Doc, 17)
 	MachineConfigDoc.Fields[0].Name = "type"
 	MachineConfigDoc.Fields[0].Type = "string"
 	MachineConfigDoc.Fields[0].Note = ""
@@ -154,63 +170,91 @@ func init() {
 	MachineConfigDoc.Fields[6].Name = "disks"
 	MachineConfigDoc.Fields[6].Type = "[]MachineDisk"
 	MachineConfigDoc.Fields[6].Note = "Note: `size` is in units of bytes.\n"
-	MachineConfigDoc.Fields[6].Description = "Used to partition, format and mount additional disks.\nSince the rootfs is read only with the exception of `/var`, mounts are only valid if they are under `/var`.\nNote that the partitioning and formating is done only once, if and only if no existing partitions are found.\nIf `size:` is omitted, the partition is sized to occupy the full disk."
+	MachineConfigDoc.Fields[6].Description = "Used to partition, format and mount additional disks.\nSince the rootfs is read only with the exception of `/var`, mounts are only valid if they are under `/var`.\nNote that the partitioning and formating is done only once, if and only if no existing partitions are found.\nIf `size:` is omitted, the partition is sized to occupy the full disk.\nDisks without partitions are left as is, so that they can be used as the members of the `volumes`."
 	MachineConfigDoc.Fields[6].Comments[encoder.LineComment] = "Used to partition, format and mount additional disks."
 
 	MachineConfigDoc.Fields[6].AddExample("MachineDisks list example.", machineDisksExample)
-	MachineConfigDoc.Fields[7].Name = "install"
-	MachineConfigDoc.Fields[7].Type = "InstallConfig"
+	MachineConfigDoc.Fields[7].Name = "volumes"
+	MachineConfigDoc.Fields[7].Type = "[]MachineVolume"
 	MachineConfigDoc.Fields[7].Note = ""
-	MachineConfigDoc.Fields[7].Description = "Used to provide instructions for installations."
-	MachineConfigDoc.Fields[7].Comments[encoder.LineComment] = "Used to provide instructions for installations."
-
-	MachineConfigDoc.Fields[7].AddExample("MachineInstall config usage example.", machineInstallExample)
-	MachineConfigDoc.Fields[8].Name = "files"
-	MachineConfigDoc.Fields[8].Type = "[]MachineFile"
-	MachineConfigDoc.Fields[8].Note = "Note: The specified `path` is relative to `/var`.\n"
-	MachineConfigDoc.Fields[8].Description = "Allows the addition of user specified files.\nThe value of `op` can be `create`, `overwrite`, or `append`.\nIn the case of `create`, `path` must not exist.\nIn the case of `overwrite`, and `append`, `path` must be a valid file.\nIf an `op` value of `append` is used, the existing file will be appended.\nNote that the file contents are not required to be base64 encoded."
-	MachineConfigDoc.Fields[8].Comments[encoder.LineComment] = "Allows the addition of user specified files."
-
-	MachineConfigDoc.Fields[8].AddExample("MachineFiles usage example.", machineFilesExample)
-	MachineConfigDoc.Fields[9].Name = "env"
-	MachineConfigDoc.Fields[9].Type = "Env"
-	MachineConfigDoc.Fields[9].Note = ""
-	MachineConfigDoc.Fields[9].Description = "The `env` field allows for the addition of environment variables.\nAll environment variables are set on PID 1 in addition to every service."
-	MachineConfigDoc.Fields[9].Comments[encoder.LineComment] = "The `env` field allows for the addition of environment variables."
+	MachineConfigDoc.Fields[7].Description = "Used to assemble md RAID arrays or LVM volume groups from the `disks` and to format and mount them.\nThe members of the volume should be listed in `disks` without partitions.\nThe volume is created only if all the members are blank, otherwise the existing volume is assembled\n(blank members are added to the existing volume, e.g. to replace the failed disk of the RAID array)."
+	MachineConfigDoc.Fields[7].Comments[encoder.LineComment] = "Used to assemble md RAID arrays or LVM volume groups from the `disks` and to format and mount them."
+
+	MachineConfigDoc.Fields[7].AddExample("MachineVolumes usage example.", machineVolumesExample)
+	MachineConfigDoc.Fields[8].Name = "install"
+	MachineConfigDoc.Fields[8].Type = "InstallConfig"
+	MachineConfigDoc.Fields[8].Note = ""
+	MachineConfigDoc.Fields[8].Description = "Used to provide instructions for installations."
+	MachineConfigDoc.Fields[8].Comments[encoder.LineComment] = "Used to provide instructions for installations."
+
+	MachineConfigDoc.Fields[8].AddExample("MachineInstall config usage example.", machineInstallExample)
+	MachineConfigDoc.Fields[9].Name = "files"
+	MachineConfigDoc.Fields[9].Type = "[]MachineFile"
+	MachineConfigDoc.Fields[9].Note = "Note: The specified `path` is relative to `/var`.\n"
+	MachineConfigDoc.Fields[9].Description = "Allows the addition of user specified files.\nThe value of `op` can be `create`, `overwrite`, or `append`.\nIn the case of `create`, `path` must not exist.\nIn the case of `overwrite`, and `append`, `path` must be a valid file.\nIf an `op` value of `append` is used, the existing file will be appended.\nNote that the file contents are not required to be base64 encoded."
+	MachineConfigDoc.Fields[9].Comments[encoder.LineComment] = "Allows the addition of user specified files."
+
+	MachineConfigDoc.Fields[9].AddExample("MachineFiles usage example.", machineFilesExample)
+	MachineConfigDoc.Fields[10].Name = "env"
+	MachineConfigDoc.Fields[10].Type = "Env"
+	MachineConfigDoc.Fields[10].Note = ""
+	MachineConfigDoc.Fields[10].Description = "The `env` field allows for the addition of environment variables.\nAll environment variables are set on PID 1 in addition to every service."
+	MachineConfigDoc.Fields[10].Comments[encoder.LineComment] = "The `env` field allows for the addition of environment variables."
 
-	MachineConfigDoc.Fields[9].AddExample("Environment variables definition examples.", machineEnvExamples[0])
+	MachineConfigDoc.Fields[10].AddExample("Environment variables definition examples.", machineEnvExamples[0])
 
-	MachineConfigDoc.Fields[9].AddExample("", machineEnvExamples[1])
+	MachineConfigDoc.Fields[10].AddExample("", machineEnvExamples[1])
 
-	MachineConfigDoc.Fields[9].AddExample("", machineEnvExamples[2])
-	MachineConfigDoc.Fields[9].Values = []string{
+	MachineConfigDoc.Fields[10].AddExample("", machineEnvExamples[2])
+	MachineConfigDoc.Fields[10].Values = []string{
 		"`GRPC_GO_LOG_VERBOSITY_LEVEL`",
 		"`GRPC_GO_LOG_SEVERITY_LEVEL`",
 		"`http_proxy`",
 		"`https_proxy`",
 		"`no_proxy`",
 	}
-	MachineConfigDoc.Fields[10].Name = "time"
-	MachineConfigDoc.Fields[10].Type = "TimeConfig"
-	MachineConfigDoc.Fields[10].Note = ""
-	MachineConfigDoc.Fields[10].Description = "Used to configure the machine's time settings."
-	MachineConfigDoc.Fields[10].Comments[encoder.LineComment] = "Used to configure the machine's time settings."
-
-	MachineConfigDoc.Fields[10].AddExample("Example configuration for cloudflare ntp server.", machineTimeExample)
-	MachineConfigDoc.Fields[11].Name = "sysctls"
-	MachineConfigDoc.Fields[11].Type = "map[string]string"
+	MachineConfigDoc.Fields[11].Name = "time"
+	MachineConfigDoc.Fields[11].Type = "TimeConfig"
 	MachineConfigDoc.Fields[11].Note = ""
-	MachineConfigDoc.Fields[11].Description = "Used to configure the machine's sysctls."
-	MachineConfigDoc.Fields[11].Comments[encoder.LineComment] = "Used to configure the machine's sysctls."
+	MachineConfigDoc.Fields[11].Description = "Used to configure the machine's time settings."
+	MachineConfigDoc.Fields[11].Comments[encoder.LineComment] = "Used to configure the machine's time settings."
 
-	MachineConfigDoc.Fields[11].AddExample("MachineSysctls usage example.", machineSysctlsExample)
-	MachineConfigDoc.Fields[12].Name = "registries"
-	MachineConfigDoc.Fields[12].Type = "RegistriesConfig"
+	MachineConfigDoc.Fields[11].AddExample("Example configuration for cloudflare ntp server.", machineTimeExample)
+	MachineConfigDoc.Fields[12].Name = "sysctls"
+	MachineConfigDoc.Fields[12].Type = "map[string]string"
 	MachineConfigDoc.Fields[12].Note = ""
-	MachineConfigDoc.Fields[12].Description = "Used to configure the machine's container image registry mirrors.\n\nAutomatically generates matching CRI configuration for registry mirrors.\n\nThe `mirrors` section allows to redirect requests for images to non-default registry,\nwhich might be local registry or caching mirror.\n\nThe `config` section provides a way to authenticate to the registry with TLS client\nidentity, provide registry CA, or authentication information.\nAuthentication information has same meaning with the corresponding field in `.docker/config.json`.\n\nSee also matching configuration for [CRI containerd plugin](https://github.com/containerd/cri/blob/master/docs/registry.md)."
-	MachineConfigDoc.Fields[12].Comments[encoder.LineComment] = "Used to configure the machine's container image registry mirrors."
-
-	MachineConfigDoc.Fields[12].AddExample("", machineConfigRegistriesExample)
+	MachineConfigDoc.Fields[12].Description = "Used to configure the machine's sysctls."
+	MachineConfigDoc.Fields[12].Comments[encoder.LineComment] = "Used to configure the machine's sysctls."
+
+	MachineConfigDoc.Fields[12].AddExample("MachineSysctls usage example.", machineSysctlsExample)
+	MachineConfigDoc.Fields[13].Name = "registries"
+	MachineConfigDoc.Fields[13].Type = "RegistriesConfig"
+	MachineConfigDoc.Fields[13].Note = ""
+	MachineConfigDoc.Fields[13].Description = "Used to configure the machine's container image registry mirrors.\n\nAutomatically generates matching CRI configuration for registry mirrors.\n\nThe `mirrors` section allows to redirect requests for images to non-default registry,\nwhich might be local registry or caching mirror.\n\nThe `config` section provides a way to authenticate to the registry with TLS client\nidentity, provide registry CA, or authentication information.\nAuthentication information has same meaning with the corresponding field in `.docker/config.json`.\n\nSee also matching configuration for [CRI containerd plugin](https://github.com/containerd/cri/blob/master/docs/registry.md)."
+	MachineConfigDoc.Fields[13].Comments[encoder.LineComment] = "Used to configure the machine's container image registry mirrors."
+
+	MachineConfigDoc.Fields[13].AddExample("", machineConfigRegistriesExample)
+	MachineConfigDoc.Fields[14].Name = "logging"
+	MachineConfigDoc.Fields[14].Type = "LoggingConfig"
+	MachineConfigDoc.Fields[14].Note = ""
+	MachineConfigDoc.Fields[14].Description = "Used to configure forwarding of the service and kernel logs to the remote destinations."
+	MachineConfigDoc.Fields[14].Comments[encoder.LineComment] = "Used to configure forwarding of the service and kernel logs to the remote destinations."
+
+	MachineConfigDoc.Fields[14].AddExample("", machineLoggingExample)
+	MachineConfigDoc.Fields[15].Name = "metrics"
+	MachineConfigDoc.Fields[15].Type = "MetricsConfig"
+	MachineConfigDoc.Fields[15].Note = ""
+	MachineConfigDoc.Fields[15].Description = "Used to configure the Prometheus metrics endpoint of the node."
+	MachineConfigDoc.Fields[15].Comments[encoder.LineComment] = "Used to configure the Prometheus metrics endpoint of the node."
+
+	MachineConfigDoc.Fields[15].AddExample("", machineMetricsExample)
+	MachineConfigDoc.Fields[16].Name = "services"
+	MachineConfigDoc.Fields[16].Type = "[]ServiceConfig"
+	MachineConfigDoc.Fields[16].Note = ""
+	MachineConfigDoc.Fields[16].Description = "Used to run additional containerized system services on the node.\n\nServices are started by machined along with the built-in services, before kubelet,\nand can be managed with `talosctl service`."
+	MachineConfigDoc.Fields[16].Comments[encoder.LineComment] = "Used to run additional containerized system services on the node."
+
+	MachineConfigDoc.Fields[16].AddExample("", machineServicesExample)
 
 	ClusterConfigDoc.Type = "ClusterConfig"
 	ClusterConfigDoc.Comments[encoder.LineComment] = "ClusterConfig represents the cluster-wide config values."
@@ -438,7 +482,7 @@ func init() {
 			FieldName: "install",
 		},
 	}
-	InstallConfigDoc.Fields = make([]encoder.Doc, 5)
+	InstallConfigDoc.Fields = make([]encoder.Doc, 9)
 	InstallConfigDoc.Fields[0].Name = "disk"
 	InstallConfigDoc.Fields[0].Type = "string"
 	InstallConfigDoc.Fields[0].Note = ""
@@ -448,42 +492,307 @@ func init() {
 	InstallConfigDoc.Fields[0].AddExample("", "/dev/sda")
 
 	InstallConfigDoc.Fields[0].AddExample("", "/dev/nvme0")
-	InstallConfigDoc.Fields[1].Name = "extraKernelArgs"
-	InstallConfigDoc.Fields[1].Type = "[]string"
+	InstallConfigDoc.Fields[1].Name = "diskSelector"
+	InstallConfigDoc.Fields[1].Type = "InstallDiskSelector"
 	InstallConfigDoc.Fields[1].Note = ""
-	InstallConfigDoc.Fields[1].Description = "Allows for supplying extra kernel args via the bootloader."
-	InstallConfigDoc.Fields[1].Comments[encoder.LineComment] = "Allows for supplying extra kernel args via the bootloader."
+	InstallConfigDoc.Fields[1].Description = "Look up the install disk by the disk properties instead of the device path.\n\nAll the specified criteria should match, the first matching disk (sorted by the device name) is used.\nDisk selector takes precedence over the install disk path.\nThe properties of the disks are reported by the `talosctl disks` command."
+	InstallConfigDoc.Fields[1].Comments[encoder.LineComment] = "Look up the install disk by the disk properties instead of the device path."
 
-	InstallConfigDoc.Fields[1].AddExample("", []string{"talos.platform=metal", "reboot=k"})
-	InstallConfigDoc.Fields[2].Name = "image"
-	InstallConfigDoc.Fields[2].Type = "string"
+	InstallConfigDoc.Fields[1].AddExample("", installDiskSelectorExample)
+	InstallConfigDoc.Fields[2].Name = "extraKernelArgs"
+	InstallConfigDoc.Fields[2].Type = "[]string"
 	InstallConfigDoc.Fields[2].Note = ""
-	InstallConfigDoc.Fields[2].Description = "Allows for supplying the image used to perform the installation.\nImage reference for each Talos release can be found on\n[GitHub releases page](https://github.com/talos-systems/talos/releases)."
-	InstallConfigDoc.Fields[2].Comments[encoder.LineComment] = "Allows for supplying the image used to perform the installation."
+	InstallConfigDoc.Fields[2].Description = "Allows for supplying extra kernel args via the bootloader."
+	InstallConfigDoc.Fields[2].Comments[encoder.LineComment] = "Allows for supplying extra kernel args via the bootloader."
 
-	InstallConfigDoc.Fields[2].AddExample("", "ghcr.io/talos-systems/installer:latest")
-	InstallConfigDoc.Fields[3].Name = "bootloader"
-	InstallConfigDoc.Fields[3].Type = "bool"
+	InstallConfigDoc.Fields[2].AddExample("", []string{"talos.platform=metal", "reboot=k"})
+	InstallConfigDoc.Fields[3].Name = "image"
+	InstallConfigDoc.Fields[3].Type = "string"
 	InstallConfigDoc.Fields[3].Note = ""
-	InstallConfigDoc.Fields[3].Description = "Indicates if a bootloader should be installed."
-	InstallConfigDoc.Fields[3].Comments[encoder.LineComment] = "Indicates if a bootloader should be installed."
-	InstallConfigDoc.Fields[3].Values = []string{
+	InstallConfigDoc.Fields[3].Description = "Allows for supplying the image used to perform the installation.\nImage reference for each Talos release can be found on\n[GitHub releases page](https://github.com/talos-systems/talos/releases)."
+	InstallConfigDoc.Fields[3].Comments[encoder.LineComment] = "Allows for supplying the image used to perform the installation."
+
+	InstallConfigDoc.Fields[3].AddExample("", "ghcr.io/talos-systems/installer:latest")
+	InstallConfigDoc.Fields[4].Name = "bootloader"
+	InstallConfigDoc.Fields[4].Type = "bool"
+	InstallConfigDoc.Fields[4].Note = ""
+	InstallConfigDoc.Fields[4].Description = "Indicates if a bootloader should be installed."
+	InstallConfigDoc.Fields[4].Comments[encoder.LineComment] = "Indicates if a bootloader should be installed."
+	InstallConfigDoc.Fields[4].Values = []string{
 		"true",
 		"yes",
 		"false",
 		"no",
 	}
-	InstallConfigDoc.Fields[4].Name = "wipe"
-	InstallConfigDoc.Fields[4].Type = "bool"
-	InstallConfigDoc.Fields[4].Note = ""
-	InstallConfigDoc.Fields[4].Description = "Indicates if the installation disk should be wiped at installation time.\nDefaults to `true`."
-	InstallConfigDoc.Fields[4].Comments[encoder.LineComment] = "Indicates if the installation disk should be wiped at installation time."
-	InstallConfigDoc.Fields[4].Values = []string{
+	InstallConfigDoc.Fields[5].Name = "wipe"
+	InstallConfigDoc.Fields[5].Type = "bool"
+	InstallConfigDoc.Fields[5].Note = ""
+	InstallConfigDoc.Fields[5].Description = "Indicates if the installation disk should be wiped at installation time.\nDefaults to `true`."
+	InstallConfigDoc.Fields[5].Comments[encoder.LineComment] = "Indicates if the installation disk should be wiped at installation time."
+	InstallConfigDoc.Fields[5].Values = []string{
 		"true",
 		"yes",
 		"false",
 		"no",
 	}
+	InstallConfigDoc.Fields[6].Name = "systemDiskEncryption"
+	InstallConfigDoc.Fields[6].Type = "SystemDiskEncryptionConfig"
+	InstallConfigDoc.Fields[6].Note = ""
+	InstallConfigDoc.Fields[6].Description = "Enables encryption of the system partitions.\n\nEncrypted partitions are formatted on the first boot after the installation,\nthe volume is opened transparently when the partition is mounted.\nThe encryption can't be enabled or disabled for an existing partition."
+	InstallConfigDoc.Fields[6].Comments[encoder.LineComment] = "Enables encryption of the system partitions."
+
+	InstallConfigDoc.Fields[6].AddExample("", installSystemDiskEncryptionExample)
+	InstallConfigDoc.Fields[7].Name = "imageVerification"
+	InstallConfigDoc.Fields[7].Type = "ImageVerificationConfig"
+	InstallConfigDoc.Fields[7].Note = ""
+	InstallConfigDoc.Fields[7].Description = "Enables signature verification of the installer, kubelet and etcd images.\n\nBefore the image is pulled, the signature of the image digest is fetched from the registry\n(cosign signature format) and verified against the trusted public keys.\nUnsigned images and images with invalid signatures are rejected."
+	InstallConfigDoc.Fields[7].Comments[encoder.LineComment] = "Enables signature verification of the installer, kubelet and etcd images."
+
+	InstallConfigDoc.Fields[7].AddExample("", installImageVerificationExample)
+	InstallConfigDoc.Fields[8].Name = "bootWatchdog"
+	InstallConfigDoc.Fields[8].Type = "BootWatchdogConfig"
+	InstallConfigDoc.Fields[8].Note = ""
+	InstallConfigDoc.Fields[8].Description = "Enables automatic rollback of the upgrade if the node fails to boot.\n\nOn the first boot after the upgrade, if the boot sequence doesn't finish and the listed services\nare not healthy within the timeout, the bootloader is reverted to the previous installation and\nthe node is rebooted.\nThe reason of the rollback is reported as an event on the next boot."
+	InstallConfigDoc.Fields[8].Comments[encoder.LineComment] = "Enables automatic rollback of the upgrade if the node fails to boot."
+
+	InstallConfigDoc.Fields[8].AddExample("", installBootWatchdogExample)
+
+	InstallDiskSelectorDoc.Type = "InstallDiskSelector"
+	InstallDiskSelectorDoc.Comments[encoder.LineComment] = "InstallDiskSelector represents the criteria to look up the install disk."
+	InstallDiskSelectorDoc.Description = "InstallDiskSelector represents the criteria to look up the install disk."
+
+	InstallDiskSelectorDoc.AddExample("", installDiskSelectorExample)
+	InstallDiskSelectorDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "InstallConfig",
+			FieldName: "diskSelector",
+		},
+	}
+	InstallDiskSelectorDoc.Fields = make([]encoder.Doc, 5)
+	InstallDiskSelectorDoc.Fields[0].Name = "minSize"
+	InstallDiskSelectorDoc.Fields[0].Type = "DiskSize"
+	InstallDiskSelectorDoc.Fields[0].Note = ""
+	InstallDiskSelectorDoc.Fields[0].Description = "Minimum size of the disk: either bytes or human readable representation."
+	InstallDiskSelectorDoc.Fields[0].Comments[encoder.LineComment] = "Minimum size of the disk: either bytes or human readable representation."
+
+	InstallDiskSelectorDoc.Fields[0].AddExample("", DiskSize(100000000000))
+	InstallDiskSelectorDoc.Fields[1].Name = "maxSize"
+	InstallDiskSelectorDoc.Fields[1].Type = "DiskSize"
+	InstallDiskSelectorDoc.Fields[1].Note = ""
+	InstallDiskSelectorDoc.Fields[1].Description = "Maximum size of the disk: either bytes or human readable representation."
+	InstallDiskSelectorDoc.Fields[1].Comments[encoder.LineComment] = "Maximum size of the disk: either bytes or human readable representation."
+
+	InstallDiskSelectorDoc.Fields[1].AddExample("", DiskSize(2000000000000))
+	InstallDiskSelectorDoc.Fields[2].Name = "model"
+	InstallDiskSelectorDoc.Fields[2].Type = "string"
+	InstallDiskSelectorDoc.Fields[2].Note = ""
+	InstallDiskSelectorDoc.Fields[2].Description = "Disk model glob pattern."
+	InstallDiskSelectorDoc.Fields[2].Comments[encoder.LineComment] = "Disk model glob pattern."
+
+	InstallDiskSelectorDoc.Fields[2].AddExample("", "WDC*")
+	InstallDiskSelectorDoc.Fields[3].Name = "serial"
+	InstallDiskSelectorDoc.Fields[3].Type = "string"
+	InstallDiskSelectorDoc.Fields[3].Note = ""
+	InstallDiskSelectorDoc.Fields[3].Description = "Disk serial number."
+	InstallDiskSelectorDoc.Fields[3].Comments[encoder.LineComment] = "Disk serial number."
+	InstallDiskSelectorDoc.Fields[4].Name = "bus"
+	InstallDiskSelectorDoc.Fields[4].Type = "string"
+	InstallDiskSelectorDoc.Fields[4].Note = ""
+	InstallDiskSelectorDoc.Fields[4].Description = "The bus the disk is attached to."
+	InstallDiskSelectorDoc.Fields[4].Comments[encoder.LineComment] = "The bus the disk is attached to."
+	InstallDiskSelectorDoc.Fields[4].Values = []string{
+		"ata",
+		"scsi",
+		"nvme",
+		"virtio",
+		"usb",
+		"mmc",
+	}
+
+	BootWatchdogConfigDoc.Type = "BootWatchdogConfig"
+	BootWatchdogConfigDoc.Comments[encoder.LineComment] = "BootWatchdogConfig specifies the boot watchdog settings."
+	BootWatchdogConfigDoc.Description = "BootWatchdogConfig specifies the boot watchdog settings."
+
+	BootWatchdogConfigDoc.AddExample("", installBootWatchdogExample)
+	BootWatchdogConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "InstallConfig",
+			FieldName: "bootWatchdog",
+		},
+	}
+	BootWatchdogConfigDoc.Fields = make([]encoder.Doc, 2)
+	BootWatchdogConfigDoc.Fields[0].Name = "timeout"
+	BootWatchdogConfigDoc.Fields[0].Type = "Duration"
+	BootWatchdogConfigDoc.Fields[0].Note = ""
+	BootWatchdogConfigDoc.Fields[0].Description = "The time for the node to boot after the upgrade.\nDefaults to 10 minutes.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
+	BootWatchdogConfigDoc.Fields[0].Comments[encoder.LineComment] = "The time for the node to boot after the upgrade."
+	BootWatchdogConfigDoc.Fields[1].Name = "services"
+	BootWatchdogConfigDoc.Fields[1].Type = "[]string"
+	BootWatchdogConfigDoc.Fields[1].Note = ""
+	BootWatchdogConfigDoc.Fields[1].Description = "The services which should be healthy for the boot to be successful (e.g. `apid`, `etcd`, `kubelet`).\nServices which don't run on the node are ignored."
+	BootWatchdogConfigDoc.Fields[1].Comments[encoder.LineComment] = "The services which should be healthy for the boot to be successful (e.g. `apid`, `etcd`, `kubelet`)."
+
+	ImageVerificationConfigDoc.Type = "ImageVerificationConfig"
+	ImageVerificationConfigDoc.Comments[encoder.LineComment] = "ImageVerificationConfig specifies the image signature verification policy."
+	ImageVerificationConfigDoc.Description = "ImageVerificationConfig specifies the image signature verification policy."
+
+	ImageVerificationConfigDoc.AddExample("", installImageVerificationExample)
+	ImageVerificationConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "InstallConfig",
+			FieldName: "imageVerification",
+		},
+	}
+	ImageVerificationConfigDoc.Fields = make([]encoder.Doc, 1)
+	ImageVerificationConfigDoc.Fields[0].Name = "publicKeys"
+	ImageVerificationConfigDoc.Fields[0].Type = "[]string"
+	ImageVerificationConfigDoc.Fields[0].Note = ""
+	ImageVerificationConfigDoc.Fields[0].Description = "PEM-encoded public keys (ECDSA, RSA or Ed25519) trusted to sign the images.\nThe image is accepted if it is signed with any of the keys."
+	ImageVerificationConfigDoc.Fields[0].Comments[encoder.LineComment] = "PEM-encoded public keys (ECDSA, RSA or Ed25519) trusted to sign the images."
+
+	SystemDiskEncryptionConfigDoc.Type = "SystemDiskEncryptionConfig"
+	SystemDiskEncryptionConfigDoc.Comments[encoder.LineComment] = "SystemDiskEncryptionConfig specifies system disk partitions encryption settings."
+	SystemDiskEncryptionConfigDoc.Description = "SystemDiskEncryptionConfig specifies system disk partitions encryption settings."
+
+	SystemDiskEncryptionConfigDoc.AddExample("", installSystemDiskEncryptionExample)
+	SystemDiskEncryptionConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "InstallConfig",
+			FieldName: "systemDiskEncryption",
+		},
+	}
+	SystemDiskEncryptionConfigDoc.Fields = make([]encoder.Doc, 2)
+	SystemDiskEncryptionConfigDoc.Fields[0].Name = "state"
+	SystemDiskEncryptionConfigDoc.Fields[0].Type = "EncryptionConfig"
+	SystemDiskEncryptionConfigDoc.Fields[0].Note = ""
+	SystemDiskEncryptionConfigDoc.Fields[0].Description = "State partition encryption."
+	SystemDiskEncryptionConfigDoc.Fields[0].Comments[encoder.LineComment] = "State partition encryption."
+	SystemDiskEncryptionConfigDoc.Fields[1].Name = "ephemeral"
+	SystemDiskEncryptionConfigDoc.Fields[1].Type = "EncryptionConfig"
+	SystemDiskEncryptionConfigDoc.Fields[1].Note = ""
+	SystemDiskEncryptionConfigDoc.Fields[1].Description = "Ephemeral partition encryption."
+	SystemDiskEncryptionConfigDoc.Fields[1].Comments[encoder.LineComment] = "Ephemeral partition encryption."
+
+	EncryptionConfigDoc.Type = "EncryptionConfig"
+	EncryptionConfigDoc.Comments[encoder.LineComment] = "EncryptionConfig represents partition encryption settings."
+	EncryptionConfigDoc.Description = "EncryptionConfig represents partition encryption settings."
+	EncryptionConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "SystemDiskEncryptionConfig",
+			FieldName: "state",
+		},
+		{
+			TypeName:  "SystemDiskEncryptionConfig",
+			FieldName: "ephemeral",
+		},
+	}
+	EncryptionConfigDoc.Fields = make([]encoder.Doc, 6)
+	EncryptionConfigDoc.Fields[0].Name = "provider"
+	EncryptionConfigDoc.Fields[0].Type = "string"
+	EncryptionConfigDoc.Fields[0].Note = ""
+	EncryptionConfigDoc.Fields[0].Description = "Encryption provider to use for the encryption."
+	EncryptionConfigDoc.Fields[0].Comments[encoder.LineComment] = "Encryption provider to use for the encryption."
+	EncryptionConfigDoc.Fields[0].Values = []string{
+		"luks2",
+	}
+	EncryptionConfigDoc.Fields[1].Name = "keys"
+	EncryptionConfigDoc.Fields[1].Type = "[]EncryptionKey"
+	EncryptionConfigDoc.Fields[1].Note = ""
+	EncryptionConfigDoc.Fields[1].Description = "Defines the encryption keys generation and storage method.\nEach key is stored in the separate key slot, any of the keys can be used to open the volume."
+	EncryptionConfigDoc.Fields[1].Comments[encoder.LineComment] = "Defines the encryption keys generation and storage method."
+
+	EncryptionConfigDoc.Fields[1].AddExample("", installEncryptionKeysExample)
+	EncryptionConfigDoc.Fields[2].Name = "cipher"
+	EncryptionConfigDoc.Fields[2].Type = "string"
+	EncryptionConfigDoc.Fields[2].Note = ""
+	EncryptionConfigDoc.Fields[2].Description = "Cipher kind to use for the encryption.\nDepends on the encryption provider."
+	EncryptionConfigDoc.Fields[2].Comments[encoder.LineComment] = "Cipher kind to use for the encryption."
+
+	EncryptionConfigDoc.Fields[2].AddExample("", "aes-xts-plain64")
+	EncryptionConfigDoc.Fields[2].Values = []string{
+		"aes-xts-plain64",
+		"xchacha12,aes-adiantum-plain64",
+		"xchacha20,aes-adiantum-plain64",
+	}
+	EncryptionConfigDoc.Fields[3].Name = "keySize"
+	EncryptionConfigDoc.Fields[3].Type = "uint"
+	EncryptionConfigDoc.Fields[3].Note = ""
+	EncryptionConfigDoc.Fields[3].Description = "Defines the encryption key length."
+	EncryptionConfigDoc.Fields[3].Comments[encoder.LineComment] = "Defines the encryption key length."
+	EncryptionConfigDoc.Fields[4].Name = "blockSize"
+	EncryptionConfigDoc.Fields[4].Type = "uint64"
+	EncryptionConfigDoc.Fields[4].Note = ""
+	EncryptionConfigDoc.Fields[4].Description = "Defines the encryption sector size."
+	EncryptionConfigDoc.Fields[4].Comments[encoder.LineComment] = "Defines the encryption sector size."
+
+	EncryptionConfigDoc.Fields[4].AddExample("", 4096)
+	EncryptionConfigDoc.Fields[5].Name = "options"
+	EncryptionConfigDoc.Fields[5].Type = "[]string"
+	EncryptionConfigDoc.Fields[5].Note = ""
+	EncryptionConfigDoc.Fields[5].Description = "Additional --perf parameters for the LUKS2 encryption."
+	EncryptionConfigDoc.Fields[5].Comments[encoder.LineComment] = "Additional --perf parameters for the LUKS2 encryption."
+
+	EncryptionConfigDoc.Fields[5].AddExample("", []string{"no_read_workqueue", "no_write_workqueue"})
+	EncryptionConfigDoc.Fields[5].Values = []string{
+		"no_read_workqueue",
+		"no_write_workqueue",
+		"same_cpu_crypt",
+	}
+
+	EncryptionKeyDoc.Type = "EncryptionKey"
+	EncryptionKeyDoc.Comments[encoder.LineComment] = "EncryptionKey represents configuration for disk encryption key."
+	EncryptionKeyDoc.Description = "EncryptionKey represents configuration for disk encryption key."
+
+	EncryptionKeyDoc.AddExample("", installEncryptionKeysExample)
+	EncryptionKeyDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EncryptionConfig",
+			FieldName: "keys",
+		},
+	}
+	EncryptionKeyDoc.Fields = make([]encoder.Doc, 3)
+	EncryptionKeyDoc.Fields[0].Name = "static"
+	EncryptionKeyDoc.Fields[0].Type = "EncryptionKeyStatic"
+	EncryptionKeyDoc.Fields[0].Note = ""
+	EncryptionKeyDoc.Fields[0].Description = "Key which value is stored in the configuration file."
+	EncryptionKeyDoc.Fields[0].Comments[encoder.LineComment] = "Key which value is stored in the configuration file."
+	EncryptionKeyDoc.Fields[1].Name = "nodeID"
+	EncryptionKeyDoc.Fields[1].Type = "EncryptionKeyNodeID"
+	EncryptionKeyDoc.Fields[1].Note = ""
+	EncryptionKeyDoc.Fields[1].Description = "Key derived from the node UUID and partition label with the random salt stored in the volume header."
+	EncryptionKeyDoc.Fields[1].Comments[encoder.LineComment] = "Key derived from the node UUID and partition label with the random salt stored in the volume header."
+	EncryptionKeyDoc.Fields[2].Name = "slot"
+	EncryptionKeyDoc.Fields[2].Type = "int"
+	EncryptionKeyDoc.Fields[2].Note = ""
+	EncryptionKeyDoc.Fields[2].Description = "Key slot number for LUKS2 encryption."
+	EncryptionKeyDoc.Fields[2].Comments[encoder.LineComment] = "Key slot number for LUKS2 encryption."
+
+	EncryptionKeyStaticDoc.Type = "EncryptionKeyStatic"
+	EncryptionKeyStaticDoc.Comments[encoder.LineComment] = "EncryptionKeyStatic represents throw away key type."
+	EncryptionKeyStaticDoc.Description = "EncryptionKeyStatic represents throw away key type."
+	EncryptionKeyStaticDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EncryptionKey",
+			FieldName: "static",
+		},
+	}
+	EncryptionKeyStaticDoc.Fields = make([]encoder.Doc, 1)
+	EncryptionKeyStaticDoc.Fields[0].Name = "passphrase"
+	EncryptionKeyStaticDoc.Fields[0].Type = "string"
+	EncryptionKeyStaticDoc.Fields[0].Note = ""
+	EncryptionKeyStaticDoc.Fields[0].Description = "Defines the static passphrase value."
+	EncryptionKeyStaticDoc.Fields[0].Comments[encoder.LineComment] = "Defines the static passphrase value."
+
+	EncryptionKeyNodeIDDoc.Type = "EncryptionKeyNodeID"
+	EncryptionKeyNodeIDDoc.Comments[encoder.LineComment] = "EncryptionKeyNodeID represents the key derived from the node UUID and PartitionLabel."
+	EncryptionKeyNodeIDDoc.Description = "EncryptionKeyNodeID represents the key derived from the node UUID and PartitionLabel."
+	EncryptionKeyNodeIDDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EncryptionKey",
+			FieldName: "nodeID",
+		},
+	}
+	EncryptionKeyNodeIDDoc.Fields = make([]encoder.Doc, 0)
 
 	TimeConfigDoc.Type = "TimeConfig"
 	TimeConfigDoc.Comments[encoder.LineComment] = "TimeConfig represents the options for configuring time on a machine."
@@ -504,10 +813,169 @@ func init() {
 	TimeConfigDoc.Fields[0].Comments[encoder.LineComment] = "Indicates if the time service is disabled for the machine."
 	TimeConfigDoc.Fields[1].Name = "servers"
 	TimeConfigDoc.Fields[1].Type = "[]string"
-	TimeConfigDoc.Fields[1].Note = "This parameter only supports a single time server.\n"
-	TimeConfigDoc.Fields[1].Description = "Specifies time (NTP) servers to use for setting the system time.\nDefaults to `pool.ntp.org`"
+	TimeConfigDoc.Fields[1].Note = ""
+	TimeConfigDoc.Fields[1].Description = "Specifies time (NTP) servers to use for setting the system time.\nDefaults to `pool.ntp.org`\n\nAll the servers are queried, and the best source is selected based on stratum and\nroot distance, servers which don't agree with the majority are ignored."
 	TimeConfigDoc.Fields[1].Comments[encoder.LineComment] = "Specifies time (NTP) servers to use for setting the system time."
 
+	LoggingConfigDoc.Type = "LoggingConfig"
+	LoggingConfigDoc.Comments[encoder.LineComment] = "LoggingConfig represents the options for the log forwarding."
+	LoggingConfigDoc.Description = "LoggingConfig represents the options for the log forwarding."
+
+	LoggingConfigDoc.AddExample("", machineLoggingExample)
+	LoggingConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "logging",
+		},
+	}
+	LoggingConfigDoc.Fields = make([]encoder.Doc, 1)
+	LoggingConfigDoc.Fields[0].Name = "destinations"
+	LoggingConfigDoc.Fields[0].Type = "[]LoggingDestination"
+	LoggingConfigDoc.Fields[0].Note = ""
+	LoggingConfigDoc.Fields[0].Description = "List of the remote destinations the logs are forwarded to.\n\nLogs are buffered on the node, so that the outage of the destination doesn't block the services."
+	LoggingConfigDoc.Fields[0].Comments[encoder.LineComment] = "List of the remote destinations the logs are forwarded to."
+
+	LoggingDestinationDoc.Type = "LoggingDestination"
+	LoggingDestinationDoc.Comments[encoder.LineComment] = "LoggingDestination represents the remote destination for the logs."
+	LoggingDestinationDoc.Description = "LoggingDestination represents the remote destination for the logs."
+	LoggingDestinationDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "LoggingConfig",
+			FieldName: "destinations",
+		},
+	}
+	LoggingDestinationDoc.Fields = make([]encoder.Doc, 2)
+	LoggingDestinationDoc.Fields[0].Name = "endpoint"
+	LoggingDestinationDoc.Fields[0].Type = "Endpoint"
+	LoggingDestinationDoc.Fields[0].Note = ""
+	LoggingDestinationDoc.Fields[0].Description = "Where to send the logs.\nSupported schemes are `udp`, `tcp` and `tls` (TCP with TLS, only for `rfc5424` format)."
+	LoggingDestinationDoc.Fields[0].Comments[encoder.LineComment] = "Where to send the logs."
+
+	LoggingDestinationDoc.Fields[0].AddExample("", "udp://10.5.0.1:514")
+
+	LoggingDestinationDoc.Fields[0].AddExample("", "tls://logs.example.com:6514")
+	LoggingDestinationDoc.Fields[1].Name = "format"
+	LoggingDestinationDoc.Fields[1].Type = "string"
+	LoggingDestinationDoc.Fields[1].Note = ""
+	LoggingDestinationDoc.Fields[1].Description = "Format of the forwarded logs: syslog messages (RFC5424) or JSON objects separated by newlines.\n`json_lines` format is supported only over `tcp`."
+	LoggingDestinationDoc.Fields[1].Comments[encoder.LineComment] = "Format of the forwarded logs: syslog messages (RFC5424) or JSON objects separated by newlines."
+	LoggingDestinationDoc.Fields[1].Values = []string{
+		"rfc5424",
+		"json_lines",
+	}
+
+	MetricsConfigDoc.Type = "MetricsConfig"
+	MetricsConfigDoc.Comments[encoder.LineComment] = "MetricsConfig represents the options for the Prometheus metrics endpoint."
+	MetricsConfigDoc.Description = "MetricsConfig represents the options for the Prometheus metrics endpoint."
+
+	MetricsConfigDoc.AddExample("", machineMetricsExample)
+	MetricsConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "metrics",
+		},
+	}
+	MetricsConfigDoc.Fields = make([]encoder.Doc, 2)
+	MetricsConfigDoc.Fields[0].Name = "enabled"
+	MetricsConfigDoc.Fields[0].Type = "bool"
+	MetricsConfigDoc.Fields[0].Note = ""
+	MetricsConfigDoc.Fields[0].Description = "Enables the `/metrics` endpoint served by machined over HTTPS.\n\nClients should present a certificate issued by the Talos OS CA, e.g. the one from `talosconfig`."
+	MetricsConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enables the `/metrics` endpoint served by machined over HTTPS."
+	MetricsConfigDoc.Fields[1].Name = "port"
+	MetricsConfigDoc.Fields[1].Type = "int"
+	MetricsConfigDoc.Fields[1].Note = ""
+	MetricsConfigDoc.Fields[1].Description = "The port the endpoint listens on, defaults to `50002`."
+	MetricsConfigDoc.Fields[1].Comments[encoder.LineComment] = "The port the endpoint listens on, defaults to `50002`."
+
+	ServiceConfigDoc.Type = "ServiceConfig"
+	ServiceConfigDoc.Comments[encoder.LineComment] = "ServiceConfig represents the user-defined system service."
+	ServiceConfigDoc.Description = "ServiceConfig represents the user-defined system service."
+
+	ServiceConfigDoc.AddExample("", machineServicesExample)
+	ServiceConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "services",
+		},
+	}
+	ServiceConfigDoc.Fields = make([]encoder.Doc, 8)
+	ServiceConfigDoc.Fields[0].Name = "name"
+	ServiceConfigDoc.Fields[0].Type = "string"
+	ServiceConfigDoc.Fields[0].Note = ""
+	ServiceConfigDoc.Fields[0].Description = "Name of the service, used as the service ID in `talosctl service`.\nShould be a valid DNS label not used by the built-in services."
+	ServiceConfigDoc.Fields[0].Comments[encoder.LineComment] = "Name of the service, used as the service ID in `talosctl service`."
+	ServiceConfigDoc.Fields[1].Name = "image"
+	ServiceConfigDoc.Fields[1].Type = "string"
+	ServiceConfigDoc.Fields[1].Note = ""
+	ServiceConfigDoc.Fields[1].Description = "Container image of the service."
+	ServiceConfigDoc.Fields[1].Comments[encoder.LineComment] = "Container image of the service."
+	ServiceConfigDoc.Fields[2].Name = "args"
+	ServiceConfigDoc.Fields[2].Type = "[]string"
+	ServiceConfigDoc.Fields[2].Note = ""
+	ServiceConfigDoc.Fields[2].Description = "Arguments of the service process, override the image entrypoint and command."
+	ServiceConfigDoc.Fields[2].Comments[encoder.LineComment] = "Arguments of the service process, override the image entrypoint and command."
+	ServiceConfigDoc.Fields[3].Name = "env"
+	ServiceConfigDoc.Fields[3].Type = "Env"
+	ServiceConfigDoc.Fields[3].Note = ""
+	ServiceConfigDoc.Fields[3].Description = "Environment variables of the service."
+	ServiceConfigDoc.Fields[3].Comments[encoder.LineComment] = "Environment variables of the service."
+	ServiceConfigDoc.Fields[4].Name = "mounts"
+	ServiceConfigDoc.Fields[4].Type = "[]Mount"
+	ServiceConfigDoc.Fields[4].Note = ""
+	ServiceConfigDoc.Fields[4].Description = "Additional mounts of the service container."
+	ServiceConfigDoc.Fields[4].Comments[encoder.LineComment] = "Additional mounts of the service container."
+	ServiceConfigDoc.Fields[5].Name = "restart"
+	ServiceConfigDoc.Fields[5].Type = "string"
+	ServiceConfigDoc.Fields[5].Note = ""
+	ServiceConfigDoc.Fields[5].Description = "Restart policy of the service, defaults to `always`."
+	ServiceConfigDoc.Fields[5].Comments[encoder.LineComment] = "Restart policy of the service, defaults to `always`."
+	ServiceConfigDoc.Fields[5].Values = []string{
+		"always",
+		"untilSuccess",
+		"once",
+	}
+	ServiceConfigDoc.Fields[6].Name = "dependsOn"
+	ServiceConfigDoc.Fields[6].Type = "[]string"
+	ServiceConfigDoc.Fields[6].Note = ""
+	ServiceConfigDoc.Fields[6].Description = "List of the services (built-in or user-defined) which should be up before the service is started."
+	ServiceConfigDoc.Fields[6].Comments[encoder.LineComment] = "List of the services (built-in or user-defined) which should be up before the service is started."
+	ServiceConfigDoc.Fields[7].Name = "healthCheck"
+	ServiceConfigDoc.Fields[7].Type = "ServiceHealthCheckConfig"
+	ServiceConfigDoc.Fields[7].Note = ""
+	ServiceConfigDoc.Fields[7].Description = "Health check of the service.\nServices depending on this service are started when the service is healthy."
+	ServiceConfigDoc.Fields[7].Comments[encoder.LineComment] = "Health check of the service."
+
+	ServiceHealthCheckConfigDoc.Type = "ServiceHealthCheckConfig"
+	ServiceHealthCheckConfigDoc.Comments[encoder.LineComment] = "ServiceHealthCheckConfig represents the health check of the user-defined service."
+	ServiceHealthCheckConfigDoc.Description = "ServiceHealthCheckConfig represents the health check of the user-defined service."
+	ServiceHealthCheckConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "ServiceConfig",
+			FieldName: "healthCheck",
+		},
+	}
+	ServiceHealthCheckConfigDoc.Fields = make([]encoder.Doc, 4)
+	ServiceHealthCheckConfigDoc.Fields[0].Name = "tcp"
+	ServiceHealthCheckConfigDoc.Fields[0].Type = "string"
+	ServiceHealthCheckConfigDoc.Fields[0].Note = ""
+	ServiceHealthCheckConfigDoc.Fields[0].Description = "Address to open TCP connection to, in the form of `<host>:<port>`."
+	ServiceHealthCheckConfigDoc.Fields[0].Comments[encoder.LineComment] = "Address to open TCP connection to, in the form of `<host>:<port>`."
+	ServiceHealthCheckConfigDoc.Fields[1].Name = "http"
+	ServiceHealthCheckConfigDoc.Fields[1].Type = "string"
+	ServiceHealthCheckConfigDoc.Fields[1].Note = ""
+	ServiceHealthCheckConfigDoc.Fields[1].Description = "URL to send HTTP GET request to, the service is healthy if the response status is 2xx."
+	ServiceHealthCheckConfigDoc.Fields[1].Comments[encoder.LineComment] = "URL to send HTTP GET request to, the service is healthy if the response status is 2xx."
+	ServiceHealthCheckConfigDoc.Fields[2].Name = "interval"
+	ServiceHealthCheckConfigDoc.Fields[2].Type = "Duration"
+	ServiceHealthCheckConfigDoc.Fields[2].Note = ""
+	ServiceHealthCheckConfigDoc.Fields[2].Description = "Interval between the health checks, defaults to `5s`.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
+	ServiceHealthCheckConfigDoc.Fields[2].Comments[encoder.LineComment] = "Interval between the health checks, defaults to `5s`."
+	ServiceHealthCheckConfigDoc.Fields[3].Name = "timeout"
+	ServiceHealthCheckConfigDoc.Fields[3].Type = "Duration"
+	ServiceHealthCheckConfigDoc.Fields[3].Note = ""
+	ServiceHealthCheckConfigDoc.Fields[3].Description = "Timeout of the single health check, defaults to `1s`.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
+	ServiceHealthCheckConfigDoc.Fields[3].Comments[encoder.LineComment] = "Timeout of the single health check, defaults to `1s`."
+
 	RegistriesConfigDoc.Type = "RegistriesConfig"
 	RegistriesConfigDoc.Comments[encoder.LineComment] = "RegistriesConfig represents the image pull options."
 	RegistriesConfigDoc.Description = "RegistriesConfig represents the image pull options."
@@ -575,10 +1043,18 @@ func init() {
 	EndpointDoc.Comments[encoder.LineComment] = "Endpoint represents the endpoint URL parsed out of the machine config."
 	EndpointDoc.Description = "Endpoint represents the endpoint URL parsed out of the machine config."
 
+	EndpointDoc.AddExample("", "udp://10.5.0.1:514")
+
+	EndpointDoc.AddExample("", "tls://logs.example.com:6514")
+
 	EndpointDoc.AddExample("", "https://1.2.3.4:6443")
 
 	EndpointDoc.AddExample("", "https://cluster1.internal:6443")
 	EndpointDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "LoggingDestination",
+			FieldName: "endpoint",
+		},
 		{
 			TypeName:  "ControlPlaneConfig",
 			FieldName: "endpoint",
@@ -838,7 +1314,7 @@ func init() {
 			FieldName: "disks",
 		},
 	}
-	MachineDiskDoc.Fields = make([]encoder.Doc, 2)
+	MachineDiskDoc.Fields = make([]encoder.Doc, 3)
 	MachineDiskDoc.Fields[0].Name = "device"
 	MachineDiskDoc.Fields[0].Type = "string"
 	MachineDiskDoc.Fields[0].Note = ""
@@ -849,6 +1325,17 @@ func init() {
 	MachineDiskDoc.Fields[1].Note = ""
 	MachineDiskDoc.Fields[1].Description = "A list of partitions to create on the disk."
 	MachineDiskDoc.Fields[1].Comments[encoder.LineComment] = "A list of partitions to create on the disk."
+	MachineDiskDoc.Fields[2].Name = "wipe"
+	MachineDiskDoc.Fields[2].Type = "bool"
+	MachineDiskDoc.Fields[2].Note = ""
+	MachineDiskDoc.Fields[2].Description = "Wipe and re-partition the disk if the existing partitions don't match the configuration.\n\nBy default, the disk which already has partitions is left as is.\nIf enabled, the existing partitions are compared with the configured partitions (by the number of partitions and the labels),\nand if they don't match, the disk is wiped and partitioned again.\nThe contents of the partitions are not compared, so the data on the disk with the matching partitions is never wiped.\nThe disk is also wiped before it is partitioned for the first time."
+	MachineDiskDoc.Fields[2].Comments[encoder.LineComment] = "Wipe and re-partition the disk if the existing partitions don't match the configuration."
+	MachineDiskDoc.Fields[2].Values = []string{
+		"true",
+		"yes",
+		"false",
+		"no",
+	}
 
 	DiskPartitionDoc.Type = "DiskPartition"
 	DiskPartitionDoc.Comments[encoder.LineComment] = "DiskPartition represents the options for a disk partition."
@@ -859,7 +1346,7 @@ func init() {
 			FieldName: "partitions",
 		},
 	}
-	DiskPartitionDoc.Fields = make([]encoder.Doc, 2)
+	DiskPartitionDoc.Fields = make([]encoder.Doc, 5)
 	DiskPartitionDoc.Fields[0].Name = "size"
 	DiskPartitionDoc.Fields[0].Type = "DiskSize"
 	DiskPartitionDoc.Fields[0].Note = ""
@@ -874,6 +1361,112 @@ func init() {
 	DiskPartitionDoc.Fields[1].Note = ""
 	DiskPartitionDoc.Fields[1].Description = "Where to mount the partition."
 	DiskPartitionDoc.Fields[1].Comments[encoder.LineComment] = "Where to mount the partition."
+	DiskPartitionDoc.Fields[2].Name = "filesystem"
+	DiskPartitionDoc.Fields[2].Type = "string"
+	DiskPartitionDoc.Fields[2].Note = ""
+	DiskPartitionDoc.Fields[2].Description = "The filesystem to format the partition with.\nDefaults to `xfs`, `none` leaves the partition unformatted and not mounted (e.g. for CSI drivers or Ceph)."
+	DiskPartitionDoc.Fields[2].Comments[encoder.LineComment] = "The filesystem to format the partition with."
+	DiskPartitionDoc.Fields[2].Values = []string{
+		"xfs",
+		"ext4",
+		"none",
+	}
+	DiskPartitionDoc.Fields[3].Name = "label"
+	DiskPartitionDoc.Fields[3].Type = "string"
+	DiskPartitionDoc.Fields[3].Note = ""
+	DiskPartitionDoc.Fields[3].Description = "The label of the partition and the filesystem.\nFilesystem labels are limited to 12 characters for `xfs` and 16 characters for `ext4`."
+	DiskPartitionDoc.Fields[3].Comments[encoder.LineComment] = "The label of the partition and the filesystem."
+
+	DiskPartitionDoc.Fields[3].AddExample("", "data")
+	DiskPartitionDoc.Fields[4].Name = "mountOptions"
+	DiskPartitionDoc.Fields[4].Type = "[]string"
+	DiskPartitionDoc.Fields[4].Note = ""
+	DiskPartitionDoc.Fields[4].Description = "The options to mount the filesystem with.\nDefaults to `noatime`."
+	DiskPartitionDoc.Fields[4].Comments[encoder.LineComment] = "The options to mount the filesystem with."
+
+	DiskPartitionDoc.Fields[4].AddExample("", []string{"noatime", "nodev", "nosuid"})
+
+	MachineVolumeDoc.Type = "MachineVolume"
+	MachineVolumeDoc.Comments[encoder.LineComment] = "MachineVolume represents the md RAID array or the LVM volume group assembled from the machine disks."
+	MachineVolumeDoc.Description = "MachineVolume represents the md RAID array or the LVM volume group assembled from the machine disks."
+
+	MachineVolumeDoc.AddExample("MachineVolumes usage example.", machineVolumesExample)
+	MachineVolumeDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "volumes",
+		},
+	}
+	MachineVolumeDoc.Fields = make([]encoder.Doc, 9)
+	MachineVolumeDoc.Fields[0].Name = "name"
+	MachineVolumeDoc.Fields[0].Type = "string"
+	MachineVolumeDoc.Fields[0].Note = ""
+	MachineVolumeDoc.Fields[0].Description = "The name of the volume.\nThe RAID array is available as `/dev/md/<name>`, the LVM volume group and the logical volume are both named `<name>`."
+	MachineVolumeDoc.Fields[0].Comments[encoder.LineComment] = "The name of the volume."
+	MachineVolumeDoc.Fields[1].Name = "type"
+	MachineVolumeDoc.Fields[1].Type = "string"
+	MachineVolumeDoc.Fields[1].Note = ""
+	MachineVolumeDoc.Fields[1].Description = "The type of the volume."
+	MachineVolumeDoc.Fields[1].Comments[encoder.LineComment] = "The type of the volume."
+	MachineVolumeDoc.Fields[1].Values = []string{
+		"raid",
+		"lvm",
+	}
+	MachineVolumeDoc.Fields[2].Name = "level"
+	MachineVolumeDoc.Fields[2].Type = "int"
+	MachineVolumeDoc.Fields[2].Note = ""
+	MachineVolumeDoc.Fields[2].Description = "The RAID level, only used for the `raid` volumes."
+	MachineVolumeDoc.Fields[2].Comments[encoder.LineComment] = "The RAID level, only used for the `raid` volumes."
+	MachineVolumeDoc.Fields[2].Values = []string{
+		"0",
+		"1",
+		"10",
+	}
+	MachineVolumeDoc.Fields[3].Name = "devices"
+	MachineVolumeDoc.Fields[3].Type = "[]string"
+	MachineVolumeDoc.Fields[3].Note = ""
+	MachineVolumeDoc.Fields[3].Description = "The disks to assemble the volume from.\nThe disks should be listed in `machine.disks` without partitions."
+	MachineVolumeDoc.Fields[3].Comments[encoder.LineComment] = "The disks to assemble the volume from."
+
+	MachineVolumeDoc.Fields[3].AddExample("", []string{"/dev/sdb", "/dev/sdc"})
+	MachineVolumeDoc.Fields[4].Name = "filesystem"
+	MachineVolumeDoc.Fields[4].Type = "string"
+	MachineVolumeDoc.Fields[4].Note = ""
+	MachineVolumeDoc.Fields[4].Description = "The filesystem to format the volume with.\nDefaults to `xfs`, `none` leaves the volume unformatted and not mounted."
+	MachineVolumeDoc.Fields[4].Comments[encoder.LineComment] = "The filesystem to format the volume with."
+	MachineVolumeDoc.Fields[4].Values = []string{
+		"xfs",
+		"ext4",
+		"none",
+	}
+	MachineVolumeDoc.Fields[5].Name = "label"
+	MachineVolumeDoc.Fields[5].Type = "string"
+	MachineVolumeDoc.Fields[5].Note = ""
+	MachineVolumeDoc.Fields[5].Description = "The label of the filesystem."
+	MachineVolumeDoc.Fields[5].Comments[encoder.LineComment] = "The label of the filesystem."
+	MachineVolumeDoc.Fields[6].Name = "mountpoint"
+	MachineVolumeDoc.Fields[6].Type = "string"
+	MachineVolumeDoc.Fields[6].Note = ""
+	MachineVolumeDoc.Fields[6].Description = "Where to mount the volume."
+	MachineVolumeDoc.Fields[6].Comments[encoder.LineComment] = "Where to mount the volume."
+
+	MachineVolumeDoc.Fields[6].AddExample("", "/var/mnt/data")
+	MachineVolumeDoc.Fields[7].Name = "mountOptions"
+	MachineVolumeDoc.Fields[7].Type = "[]string"
+	MachineVolumeDoc.Fields[7].Note = ""
+	MachineVolumeDoc.Fields[7].Description = "The options to mount the filesystem with.\nDefaults to `noatime`."
+	MachineVolumeDoc.Fields[7].Comments[encoder.LineComment] = "The options to mount the filesystem with."
+	MachineVolumeDoc.Fields[8].Name = "wipe"
+	MachineVolumeDoc.Fields[8].Type = "bool"
+	MachineVolumeDoc.Fields[8].Note = ""
+	MachineVolumeDoc.Fields[8].Description = "Wipe the member disks which contain data not belonging to the volume.\n\nBy default, the disk is used as the member only if it is blank (no partition table, no known signatures,\nand the first and the last MiB are zeroed) or already belongs to the volume, otherwise the volume is not assembled.\nIf enabled, such disks are wiped and added to the volume."
+	MachineVolumeDoc.Fields[8].Comments[encoder.LineComment] = "Wipe the member disks which contain data not belonging to the volume."
+	MachineVolumeDoc.Fields[8].Values = []string{
+		"true",
+		"yes",
+		"false",
+		"no",
+	}
 
 	MachineFileDoc.Type = "MachineFile"
 	MachineFileDoc.Comments[encoder.LineComment] = "MachineFile represents a file to write to disk."
@@ -947,7 +1540,7 @@ func init() {
 			FieldName: "interfaces",
 		},
 	}
-	DeviceDoc.Fields = make([]encoder.Doc, 10)
+	DeviceDoc.Fields = make([]encoder.Doc, 12)
 	DeviceDoc.Fields[0].Name = "interface"
 	DeviceDoc.Fields[0].Type = "string"
 	DeviceDoc.Fields[0].Note = ""
@@ -1010,6 +1603,22 @@ func init() {
 	DeviceDoc.Fields[9].Comments[encoder.LineComment] = "DHCP specific options."
 
 	DeviceDoc.Fields[9].AddExample("", networkConfigDHCPOptionsExample)
+	DeviceDoc.Fields[10].Name = "acceptRA"
+	DeviceDoc.Fields[10].Type = "bool"
+	DeviceDoc.Fields[10].Note = ""
+	DeviceDoc.Fields[10].Description = "Indicates if IPv6 router advertisements should be accepted on the interface (SLAAC and default route).\nRouter advertisements are accepted even if IPv6 forwarding is enabled.\nIf not set, kernel defaults are used."
+	DeviceDoc.Fields[10].Comments[encoder.LineComment] = "Indicates if IPv6 router advertisements should be accepted on the interface (SLAAC and default route)."
+
+	DeviceDoc.Fields[10].AddExample("", true)
+	DeviceDoc.Fields[11].Name = "wireguard"
+	DeviceDoc.Fields[11].Type = "DeviceWireguardConfig"
+	DeviceDoc.Fields[11].Note = ""
+	DeviceDoc.Fields[11].Description = "WireGuard specific configuration.\nInterface is created as WireGuard device if this section is set."
+	DeviceDoc.Fields[11].Comments[encoder.LineComment] = "WireGuard specific configuration."
+
+	DeviceDoc.Fields[11].AddExample("wireguard server example", networkConfigWireguardHostExample)
+
+	DeviceDoc.Fields[11].AddExample("wireguard peer example", networkConfigWireguardPeerExample)
 
 	DHCPOptionsDoc.Type = "DHCPOptions"
 	DHCPOptionsDoc.Comments[encoder.LineComment] = "DHCPOptions contains options for configuring the DHCP settings for a given interface."
@@ -1022,12 +1631,94 @@ func init() {
 			FieldName: "dhcpOptions",
 		},
 	}
-	DHCPOptionsDoc.Fields = make([]encoder.Doc, 1)
+	DHCPOptionsDoc.Fields = make([]encoder.Doc, 3)
 	DHCPOptionsDoc.Fields[0].Name = "routeMetric"
 	DHCPOptionsDoc.Fields[0].Type = "uint32"
 	DHCPOptionsDoc.Fields[0].Note = ""
 	DHCPOptionsDoc.Fields[0].Description = "The priority of all routes received via DHCP."
 	DHCPOptionsDoc.Fields[0].Comments[encoder.LineComment] = "The priority of all routes received via DHCP."
+	DHCPOptionsDoc.Fields[1].Name = "ipv4"
+	DHCPOptionsDoc.Fields[1].Type = "bool"
+	DHCPOptionsDoc.Fields[1].Note = ""
+	DHCPOptionsDoc.Fields[1].Description = "Enables DHCPv4 protocol for the interface.\nDefaults to `true`."
+	DHCPOptionsDoc.Fields[1].Comments[encoder.LineComment] = "Enables DHCPv4 protocol for the interface."
+	DHCPOptionsDoc.Fields[2].Name = "ipv6"
+	DHCPOptionsDoc.Fields[2].Type = "bool"
+	DHCPOptionsDoc.Fields[2].Note = ""
+	DHCPOptionsDoc.Fields[2].Description = "Enables DHCPv6 protocol for the interface (address assignment and prefix delegation).\nDefaults to `false`."
+	DHCPOptionsDoc.Fields[2].Comments[encoder.LineComment] = "Enables DHCPv6 protocol for the interface (address assignment and prefix delegation)."
+
+	DeviceWireguardConfigDoc.Type = "DeviceWireguardConfig"
+	DeviceWireguardConfigDoc.Comments[encoder.LineComment] = "DeviceWireguardConfig contains settings for configuring WireGuard network interface."
+	DeviceWireguardConfigDoc.Description = "DeviceWireguardConfig contains settings for configuring WireGuard network interface."
+
+	DeviceWireguardConfigDoc.AddExample("wireguard server example", networkConfigWireguardHostExample)
+
+	DeviceWireguardConfigDoc.AddExample("wireguard peer example", networkConfigWireguardPeerExample)
+	DeviceWireguardConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "wireguard",
+		},
+	}
+	DeviceWireguardConfigDoc.Fields = make([]encoder.Doc, 4)
+	DeviceWireguardConfigDoc.Fields[0].Name = "privateKey"
+	DeviceWireguardConfigDoc.Fields[0].Type = "string"
+	DeviceWireguardConfigDoc.Fields[0].Note = ""
+	DeviceWireguardConfigDoc.Fields[0].Description = "Specifies a private key configuration (base64 encoded).\nCan be generated by `wg genkey`."
+	DeviceWireguardConfigDoc.Fields[0].Comments[encoder.LineComment] = "Specifies a private key configuration (base64 encoded)."
+
+	DeviceWireguardConfigDoc.Fields[0].AddExample("", "ABCDEF...")
+	DeviceWireguardConfigDoc.Fields[1].Name = "listenPort"
+	DeviceWireguardConfigDoc.Fields[1].Type = "int"
+	DeviceWireguardConfigDoc.Fields[1].Note = ""
+	DeviceWireguardConfigDoc.Fields[1].Description = "Specifies a device's listening port."
+	DeviceWireguardConfigDoc.Fields[1].Comments[encoder.LineComment] = "Specifies a device's listening port."
+
+	DeviceWireguardConfigDoc.Fields[1].AddExample("", 51820)
+	DeviceWireguardConfigDoc.Fields[2].Name = "firewallMark"
+	DeviceWireguardConfigDoc.Fields[2].Type = "int"
+	DeviceWireguardConfigDoc.Fields[2].Note = ""
+	DeviceWireguardConfigDoc.Fields[2].Description = "Specifies a device's firewall mark."
+	DeviceWireguardConfigDoc.Fields[2].Comments[encoder.LineComment] = "Specifies a device's firewall mark."
+	DeviceWireguardConfigDoc.Fields[3].Name = "peers"
+	DeviceWireguardConfigDoc.Fields[3].Type = "[]DeviceWireguardPeer"
+	DeviceWireguardConfigDoc.Fields[3].Note = ""
+	DeviceWireguardConfigDoc.Fields[3].Description = "Specifies a list of peer configurations to apply to a device."
+	DeviceWireguardConfigDoc.Fields[3].Comments[encoder.LineComment] = "Specifies a list of peer configurations to apply to a device."
+
+	DeviceWireguardPeerDoc.Type = "DeviceWireguardPeer"
+	DeviceWireguardPeerDoc.Comments[encoder.LineComment] = "DeviceWireguardPeer represents a WireGuard device peer configuration."
+	DeviceWireguardPeerDoc.Description = "DeviceWireguardPeer represents a WireGuard device peer configuration."
+	DeviceWireguardPeerDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "DeviceWireguardConfig",
+			FieldName: "peers",
+		},
+	}
+	DeviceWireguardPeerDoc.Fields = make([]encoder.Doc, 4)
+	DeviceWireguardPeerDoc.Fields[0].Name = "publicKey"
+	DeviceWireguardPeerDoc.Fields[0].Type = "string"
+	DeviceWireguardPeerDoc.Fields[0].Note = ""
+	DeviceWireguardPeerDoc.Fields[0].Description = "Specifies the public key of this peer (base64 encoded).\nCan be extracted from private key by running `wg pubkey < private.key > public.key && cat public.key`."
+	DeviceWireguardPeerDoc.Fields[0].Comments[encoder.LineComment] = "Specifies the public key of this peer (base64 encoded)."
+	DeviceWireguardPeerDoc.Fields[1].Name = "endpoint"
+	DeviceWireguardPeerDoc.Fields[1].Type = "string"
+	DeviceWireguardPeerDoc.Fields[1].Note = ""
+	DeviceWireguardPeerDoc.Fields[1].Description = "Specifies the endpoint of this peer entry."
+	DeviceWireguardPeerDoc.Fields[1].Comments[encoder.LineComment] = "Specifies the endpoint of this peer entry."
+
+	DeviceWireguardPeerDoc.Fields[1].AddExample("", "192.168.1.3:51820")
+	DeviceWireguardPeerDoc.Fields[2].Name = "persistentKeepaliveInterval"
+	DeviceWireguardPeerDoc.Fields[2].Type = "Duration"
+	DeviceWireguardPeerDoc.Fields[2].Note = ""
+	DeviceWireguardPeerDoc.Fields[2].Description = "Specifies the persistent keepalive interval for this peer.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
+	DeviceWireguardPeerDoc.Fields[2].Comments[encoder.LineComment] = "Specifies the persistent keepalive interval for this peer."
+	DeviceWireguardPeerDoc.Fields[3].Name = "allowedIPs"
+	DeviceWireguardPeerDoc.Fields[3].Type = "[]string"
+	DeviceWireguardPeerDoc.Fields[3].Note = ""
+	DeviceWireguardPeerDoc.Fields[3].Description = "AllowedIPs specifies a list of allowed IP addresses in CIDR notation for this peer."
+	DeviceWireguardPeerDoc.Fields[3].Comments[encoder.LineComment] = "AllowedIPs specifies a list of allowed IP addresses in CIDR notation for this peer."
 
 	BondDoc.Type = "Bond"
 	BondDoc.Comments[encoder.LineComment] = "Bond contains the various options for configuring a bonded interface."
@@ -1377,10 +2068,62 @@ func (_ InstallConfig) Doc() *encoder.Doc {
 	return &InstallConfigDoc
 }
 
+func (_ InstallDiskSelector) Doc() *encoder.Doc {
+	return &InstallDiskSelectorDoc
+}
+
+func (_ BootWatchdogConfig) Doc() *encoder.Doc {
+	return &BootWatchdogConfigDoc
+}
+
+func (_ ImageVerificationConfig) Doc() *encoder.Doc {
+	return &ImageVerificationConfigDoc
+}
+
+func (_ SystemDiskEncryptionConfig) Doc() *encoder.Doc {
+	return &SystemDiskEncryptionConfigDoc
+}
+
+func (_ EncryptionConfig) Doc() *encoder.Doc {
+	return &EncryptionConfigDoc
+}
+
+func (_ EncryptionKey) Doc() *encoder.Doc {
+	return &EncryptionKeyDoc
+}
+
+func (_ EncryptionKeyStatic) Doc() *encoder.Doc {
+	return &EncryptionKeyStaticDoc
+}
+
+func (_ EncryptionKeyNodeID) Doc() *encoder.Doc {
+	return &EncryptionKeyNodeIDDoc
+}
+
 func (_ TimeConfig) Doc() *encoder.Doc {
 	return &TimeConfigDoc
 }
 
+func (_ LoggingConfig) Doc() *encoder.Doc {
+	return &LoggingConfigDoc
+}
+
+func (_ LoggingDestination) Doc() *encoder.Doc {
+	return &LoggingDestinationDoc
+}
+
+func (_ MetricsConfig) Doc() *encoder.Doc {
+	return &MetricsConfigDoc
+}
+
+func (_ ServiceConfig) Doc() *encoder.Doc {
+	return &ServiceConfigDoc
+}
+
+func (_ ServiceHealthCheckConfig) Doc() *encoder.Doc {
+	return &ServiceHealthCheckConfigDoc
+}
+
 func (_ RegistriesConfig) Doc() *encoder.Doc {
 	return &RegistriesConfigDoc
 }
@@ -1441,6 +2184,10 @@ func (_ DiskPartition) Doc() *encoder.Doc {
 	return &DiskPartitionDoc
 }
 
+func (_ MachineVolume) Doc() *encoder.Doc {
+	return &MachineVolumeDoc
+}
+
 func (_ MachineFile) Doc() *encoder.Doc {
 	return &MachineFileDoc
 }
@@ -1457,6 +2204,14 @@ func (_ DHCPOptions) Doc() *encoder.Doc {
 	return &DHCPOptionsDoc
 }
 
+func (_ DeviceWireguardConfig) Doc() *encoder.Doc {
+	return &DeviceWireguardConfigDoc
+}
+
+func (_ DeviceWireguardPeer) Doc() *encoder.Doc {
+	return &DeviceWireguardPeerDoc
+}
+
 func (_ Bond) Doc() *encoder.Doc {
 	return &BondDoc
 }
@@ -1497,7 +2252,20 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&KubeletConfigDoc,
 			&NetworkConfigDoc,
 			&InstallConfigDoc,
+			&InstallDiskSelectorDoc,
+			&BootWatchdogConfigDoc,
+			&ImageVerificationConfigDoc,
+			&SystemDiskEncryptionConfigDoc,
+			&EncryptionConfigDoc,
+			&EncryptionKeyDoc,
+			&EncryptionKeyStaticDoc,
+			&EncryptionKeyNodeIDDoc,
 			&TimeConfigDoc,
+			&LoggingConfigDoc,
+			&LoggingDestinationDoc,
+			&MetricsConfigDoc,
+			&ServiceConfigDoc,
+			&ServiceHealthCheckConfigDoc,
 			&RegistriesConfigDoc,
 			&PodCheckpointerDoc,
 			&CoreDNSDoc,
@@ -1513,10 +2281,13 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&AdminKubeconfigConfigDoc,
 			&MachineDiskDoc,
 			&DiskPartitionDoc,
+			&MachineVolumeDoc,
 			&MachineFileDoc,
 			&ExtraHostDoc,
 			&DeviceDoc,
 			&DHCPOptionsDoc,
+			&DeviceWireguardConfigDoc,
+			&DeviceWireguardPeerDoc,
 			&BondDoc,
 			&VlanDoc,
 			&RouteDoc,
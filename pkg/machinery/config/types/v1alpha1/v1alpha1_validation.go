@@ -7,11 +7,18 @@
 package v1alpha1
 
 import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	valid "github.com/asaskevich/govalidator"
 	"github.com/hashicorp/go-multierror"
@@ -51,6 +58,8 @@ var (
 	ErrBadAddressing = errors.New("invalid network device addressing method")
 	// ErrInvalidAddress denotes that a bad address was provided.
 	ErrInvalidAddress = errors.New("invalid network address")
+	// ErrInvalidWireguardKey denotes that a bad WireGuard key was provided.
+	ErrInvalidWireguardKey = errors.New("invalid WireGuard key")
 )
 
 // NetworkDeviceCheck defines the function type for checks.
@@ -59,9 +68,11 @@ type NetworkDeviceCheck func(*Device) error
 
 // Validate implements the Configurator interface.
 //nolint: gocyclo
-func (c *Config) Validate(mode config.RuntimeMode) error {
+func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationOption) error {
 	var result *multierror.Error
 
+	opts := config.NewValidationOptions(options...)
+
 	if c.MachineConfig == nil {
 		result = multierror.Append(result, errors.New("machine instructions are required"))
 	}
@@ -75,12 +86,15 @@ func (c *Config) Validate(mode config.RuntimeMode) error {
 			result = multierror.Append(result, fmt.Errorf("install instructions are required in %q mode", mode))
 		}
 
-		if c.MachineConfig.MachineInstall.InstallDisk == "" {
-			result = multierror.Append(result, fmt.Errorf("an install disk is required in %q mode", mode))
-		}
-
-		if _, err := os.Stat(c.MachineConfig.MachineInstall.InstallDisk); os.IsNotExist(err) {
-			result = multierror.Append(result, fmt.Errorf("specified install disk does not exist: %q", c.MachineConfig.MachineInstall.InstallDisk))
+		switch {
+		case c.MachineConfig.MachineInstall.InstallDiskSelector != nil:
+			// install disk is looked up on the node
+		case c.MachineConfig.MachineInstall.InstallDisk == "":
+			result = multierror.Append(result, fmt.Errorf("an install disk or an install disk selector is required in %q mode", mode))
+		case opts.Local:
+			if _, err := os.Stat(c.MachineConfig.MachineInstall.InstallDisk); os.IsNotExist(err) {
+				result = multierror.Append(result, fmt.Errorf("specified install disk does not exist: %q", c.MachineConfig.MachineInstall.InstallDisk))
+			}
 		}
 	}
 
@@ -99,19 +113,53 @@ func (c *Config) Validate(mode config.RuntimeMode) error {
 
 	if c.MachineConfig.MachineNetwork != nil {
 		for _, device := range c.MachineConfig.MachineNetwork.NetworkInterfaces {
-			if err := ValidateNetworkDevices(device, CheckDeviceInterface, CheckDeviceAddressing); err != nil {
+			if err := ValidateNetworkDevices(device, CheckDeviceInterface, CheckDeviceAddressing, CheckDeviceWireguard); err != nil {
 				result = multierror.Append(result, err)
 			}
 		}
 	}
 
-	if c.MachineConfig.MachineDisks != nil {
-		for _, disk := range c.MachineConfig.MachineDisks {
-			for i, pt := range disk.DiskPartitions {
-				if pt.DiskSize == 0 && i != len(disk.DiskPartitions)-1 {
-					result = multierror.Append(result, fmt.Errorf("partition for disk %q is set to occupy full disk, but it's not the last partition in the list", disk.Device()))
-				}
-			}
+	if err := validateDisksAndVolumes(c.MachineConfig.MachineDisks, c.MachineConfig.MachineVolumes, opts); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if c.MachineConfig.MachineLogging != nil {
+		if err := c.MachineConfig.MachineLogging.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.MachineConfig.MachineMetrics != nil {
+		if port := c.MachineConfig.MachineMetrics.MetricsPort; port < 0 || port > 65535 {
+			result = multierror.Append(result, fmt.Errorf("metrics port %d is out of range", port))
+		}
+	}
+
+	if err := validateServices(c.MachineConfig.MachineServices, loadedServices(c, mode)); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if c.MachineConfig.MachineInstall != nil && c.MachineConfig.MachineInstall.InstallDiskSelector != nil {
+		if err := c.MachineConfig.MachineInstall.InstallDiskSelector.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.MachineConfig.MachineInstall != nil && c.MachineConfig.MachineInstall.InstallSystemDiskEncryption != nil {
+		if err := c.MachineConfig.MachineInstall.InstallSystemDiskEncryption.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.MachineConfig.MachineInstall != nil && c.MachineConfig.MachineInstall.InstallImageVerification != nil {
+		if err := c.MachineConfig.MachineInstall.InstallImageVerification.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.MachineConfig.MachineInstall != nil && c.MachineConfig.MachineInstall.InstallBootWatchdog != nil {
+		if c.MachineConfig.MachineInstall.InstallBootWatchdog.WatchdogTimeout < 0 {
+			result = multierror.Append(result, fmt.Errorf("boot watchdog timeout should not be negative: %s", c.MachineConfig.MachineInstall.InstallBootWatchdog.WatchdogTimeout))
 		}
 	}
 
@@ -122,6 +170,598 @@ func (c *Config) Validate(mode config.RuntimeMode) error {
 	return result.ErrorOrNil()
 }
 
+// Validate validates the logging config.
+func (l *LoggingConfig) Validate() error {
+	var result *multierror.Error
+
+	for i, destination := range l.LoggingDestinations {
+		endpoint := destination.Endpoint()
+		if endpoint == nil {
+			result = multierror.Append(result, fmt.Errorf("logging destination %d: endpoint is required", i))
+
+			continue
+		}
+
+		switch endpoint.Scheme {
+		case "udp", "tcp", "tls":
+		default:
+			result = multierror.Append(result, fmt.Errorf("logging destination %d: unsupported scheme %q", i, endpoint.Scheme))
+		}
+
+		if endpoint.Hostname() == "" || endpoint.Port() == "" {
+			result = multierror.Append(result, fmt.Errorf("logging destination %d: endpoint should be in the form of <scheme>://<host>:<port>", i))
+		}
+
+		switch destination.Format() {
+		case "rfc5424":
+		case "json_lines":
+			if endpoint.Scheme != "tcp" {
+				result = multierror.Append(result, fmt.Errorf("logging destination %d: json_lines format is supported only over tcp", i))
+			}
+		default:
+			result = multierror.Append(result, fmt.Errorf("logging destination %d: unsupported format %q", i, destination.Format()))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// builtinServices are the IDs of the services managed by machined.
+var builtinServices = map[string]struct{}{
+	"apid":       {},
+	"bootkube":   {},
+	"containerd": {},
+	"cri":        {},
+	"etcd":       {},
+	"kubelet":    {},
+	"machined":   {},
+	"metrics":    {},
+	"networkd":   {},
+	"routerd":    {},
+	"timed":      {},
+	"trustd":     {},
+	"udevd":      {},
+}
+
+// loadedServices returns the IDs of the built-in services machined runs on the node.
+func loadedServices(c *Config, mode config.RuntimeMode) map[string]struct{} {
+	loaded := map[string]struct{}{
+		"apid":       {},
+		"containerd": {},
+		"cri":        {},
+		"kubelet":    {},
+		"machined":   {},
+		"networkd":   {},
+		"routerd":    {},
+	}
+
+	// udevd and timed are not started in the container mode.
+	if mode.String() != "container" {
+		loaded["udevd"] = struct{}{}
+
+		if !c.Machine().Time().Disabled() {
+			loaded["timed"] = struct{}{}
+		}
+	}
+
+	if c.Machine().Metrics().Enabled() {
+		loaded["metrics"] = struct{}{}
+	}
+
+	switch c.Machine().Type() {
+	case machine.TypeInit:
+		loaded["trustd"] = struct{}{}
+		loaded["etcd"] = struct{}{}
+		loaded["bootkube"] = struct{}{}
+	case machine.TypeControlPlane:
+		loaded["trustd"] = struct{}{}
+		loaded["etcd"] = struct{}{}
+	case machine.TypeJoin:
+	}
+
+	return loaded
+}
+
+func validateServices(services []*ServiceConfig, loaded map[string]struct{}) error {
+	var result *multierror.Error
+
+	names := map[string]struct{}{}
+
+	for _, svc := range services {
+		if err := svc.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if _, exists := names[svc.ServiceName]; exists {
+			result = multierror.Append(result, fmt.Errorf("service %q is defined more than once", svc.ServiceName))
+		}
+
+		names[svc.ServiceName] = struct{}{}
+	}
+
+	for _, svc := range services {
+		for _, dep := range svc.ServiceDependsOn {
+			_, builtin := builtinServices[dep]
+			_, running := loaded[dep]
+			_, user := names[dep]
+
+			switch {
+			case user || running:
+			case builtin:
+				result = multierror.Append(result, fmt.Errorf("service %q: dependency %q is not running on this node", svc.ServiceName, dep))
+			default:
+				result = multierror.Append(result, fmt.Errorf("service %q: dependency %q is not defined", svc.ServiceName, dep))
+			}
+		}
+	}
+
+	if cycle := findDependencyCycle(services); cycle != nil {
+		result = multierror.Append(result, fmt.Errorf("service dependency cycle: %s", strings.Join(cycle, " -> ")))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// findDependencyCycle returns the first dependency cycle between the user services, if any.
+func findDependencyCycle(services []*ServiceConfig) []string {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	dependsOn := map[string][]string{}
+
+	for _, svc := range services {
+		dependsOn[svc.ServiceName] = svc.ServiceDependsOn
+	}
+
+	state := map[string]int{}
+	path := []string{}
+
+	var visit func(name string) []string
+
+	visit = func(name string) []string {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			for i := range path {
+				if path[i] == name {
+					return append(append([]string{}, path[i:]...), name)
+				}
+			}
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+
+		for _, dep := range dependsOn[name] {
+			// self-dependencies are reported by the service validation
+			if _, user := dependsOn[dep]; !user || dep == name {
+				continue
+			}
+
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[name] = visited
+
+		return nil
+	}
+
+	for _, svc := range services {
+		if cycle := visit(svc.ServiceName); cycle != nil {
+			return cycle
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the user-defined service config.
+//
+//nolint: gocyclo
+func (s *ServiceConfig) Validate() error {
+	var result *multierror.Error
+
+	if !valid.IsDNSName(s.ServiceName) || strings.Contains(s.ServiceName, ".") {
+		result = multierror.Append(result, fmt.Errorf("service name %q should be a valid DNS label", s.ServiceName))
+	}
+
+	if _, builtin := builtinServices[s.ServiceName]; builtin {
+		result = multierror.Append(result, fmt.Errorf("service name %q is reserved for the built-in service", s.ServiceName))
+	}
+
+	if s.ServiceImage == "" {
+		result = multierror.Append(result, fmt.Errorf("service %q: image is required", s.ServiceName))
+	}
+
+	switch s.ServiceRestart {
+	case "", "always", "untilSuccess", "once":
+	default:
+		result = multierror.Append(result, fmt.Errorf("service %q: unsupported restart policy %q", s.ServiceName, s.ServiceRestart))
+	}
+
+	for _, dep := range s.ServiceDependsOn {
+		if dep == s.ServiceName {
+			result = multierror.Append(result, fmt.Errorf("service %q can't depend on itself", s.ServiceName))
+		}
+	}
+
+	if check := s.ServiceHealthCheck; check != nil {
+		switch {
+		case check.HealthCheckTCP != "" && check.HealthCheckHTTP != "":
+			result = multierror.Append(result, fmt.Errorf("service %q: only one of tcp and http health checks should be set", s.ServiceName))
+		case check.HealthCheckTCP != "":
+			if _, _, err := net.SplitHostPort(check.HealthCheckTCP); err != nil {
+				result = multierror.Append(result, fmt.Errorf("service %q: invalid tcp health check address: %w", s.ServiceName, err))
+			}
+		case check.HealthCheckHTTP != "":
+			if u, err := url.Parse(check.HealthCheckHTTP); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				result = multierror.Append(result, fmt.Errorf("service %q: invalid http health check URL %q", s.ServiceName, check.HealthCheckHTTP))
+			}
+		default:
+			result = multierror.Append(result, fmt.Errorf("service %q: health check should set either tcp or http", s.ServiceName))
+		}
+
+		if check.HealthCheckInterval < 0 || check.HealthCheckTimeout < 0 {
+			result = multierror.Append(result, fmt.Errorf("service %q: health check interval and timeout should be positive", s.ServiceName))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates the image verification config.
+func (v *ImageVerificationConfig) Validate() error {
+	var result *multierror.Error
+
+	if len(v.VerificationPublicKeys) == 0 {
+		result = multierror.Append(result, errors.New("image verification: at least one public key should be specified"))
+	}
+
+	for i, key := range v.VerificationPublicKeys {
+		block, _ := pem.Decode([]byte(key))
+		if block == nil {
+			result = multierror.Append(result, fmt.Errorf("image verification: public key %d is not PEM-encoded", i))
+
+			continue
+		}
+
+		if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			result = multierror.Append(result, fmt.Errorf("image verification: public key %d: %w", i, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates the install disk selector.
+func (s *InstallDiskSelector) Validate() error {
+	var result *multierror.Error
+
+	if s.SelectorMinSize == 0 && s.SelectorMaxSize == 0 && s.SelectorModel == "" && s.SelectorSerial == "" && s.SelectorBus == "" {
+		result = multierror.Append(result, errors.New("install disk selector: at least one criterion should be specified"))
+	}
+
+	if s.SelectorMaxSize != 0 && s.SelectorMinSize > s.SelectorMaxSize {
+		result = multierror.Append(result, fmt.Errorf("install disk selector: min size %d is greater than max size %d", s.SelectorMinSize, s.SelectorMaxSize))
+	}
+
+	if s.SelectorModel != "" {
+		if _, err := filepath.Match(s.SelectorModel, ""); err != nil {
+			result = multierror.Append(result, fmt.Errorf("install disk selector: invalid model pattern %q: %w", s.SelectorModel, err))
+		}
+	}
+
+	switch s.SelectorBus {
+	case "", "ata", "scsi", "nvme", "virtio", "usb", "mmc":
+	default:
+		result = multierror.Append(result, fmt.Errorf("install disk selector: unsupported bus %q", s.SelectorBus))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// validateDisksAndVolumes validates the user disks and volumes, and checks that the mountpoints don't overlap
+// and that the volume members are the disks without partitions.
+//
+// When validating on the node, the partitions are checked to fit the disks present on the node.
+//
+// nolint: gocyclo
+func validateDisksAndVolumes(disks []*MachineDisk, volumes []*MachineVolume, opts *config.ValidationOptions) error {
+	var result *multierror.Error
+
+	// mountpoint -> the disk or the volume using it
+	mountPoints := map[string]string{}
+
+	addMountPoint := func(mountPoint, owner string) {
+		if mountPoint == "" || !filepath.IsAbs(mountPoint) {
+			return
+		}
+
+		mountPoint = filepath.Clean(mountPoint)
+
+		for other, otherOwner := range mountPoints {
+			if mountPoint == other || strings.HasPrefix(mountPoint, other+"/") || strings.HasPrefix(other, mountPoint+"/") {
+				result = multierror.Append(result, fmt.Errorf("mountpoint %q of %s overlaps with mountpoint %q of %s", mountPoint, owner, other, otherOwner))
+			}
+		}
+
+		mountPoints[mountPoint] = owner
+	}
+
+	// device -> whether the disk has partitions
+	devices := map[string]bool{}
+
+	for _, disk := range disks {
+		if err := disk.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if opts.Local {
+			if err := disk.validateSize(); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+
+		devices[disk.DeviceName] = len(disk.DiskPartitions) > 0
+
+		for _, pt := range disk.DiskPartitions {
+			addMountPoint(pt.DiskMountPoint, fmt.Sprintf("disk %q", disk.Device()))
+		}
+	}
+
+	// device -> the volume using it
+	members := map[string]string{}
+	names := map[string]struct{}{}
+
+	for _, volume := range volumes {
+		if err := volume.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if _, ok := names[volume.VolumeName]; ok {
+			result = multierror.Append(result, fmt.Errorf("volume %q is defined more than once", volume.VolumeName))
+		}
+
+		names[volume.VolumeName] = struct{}{}
+
+		for _, device := range volume.VolumeDevices {
+			partitioned, ok := devices[device]
+
+			switch {
+			case !ok:
+				result = multierror.Append(result, fmt.Errorf("volume %q: device %q should be listed in the machine disks", volume.VolumeName, device))
+			case partitioned:
+				result = multierror.Append(result, fmt.Errorf("volume %q: device %q can't have partitions", volume.VolumeName, device))
+			}
+
+			if other, ok := members[device]; ok {
+				result = multierror.Append(result, fmt.Errorf("volume %q: device %q is already used by volume %q", volume.VolumeName, device, other))
+			}
+
+			members[device] = volume.VolumeName
+		}
+
+		addMountPoint(volume.VolumeMountPoint, fmt.Sprintf("volume %q", volume.VolumeName))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates the user disk config.
+//
+// Partition sizes are checked to fit the disk only on the node, see validateSize.
+func (d *MachineDisk) Validate() error {
+	var result *multierror.Error
+
+	for i, pt := range d.DiskPartitions {
+		if pt.DiskSize == 0 && i != len(d.DiskPartitions)-1 {
+			result = multierror.Append(result, fmt.Errorf("partition for disk %q is set to occupy full disk, but it's not the last partition in the list", d.Device()))
+		}
+
+		if err := pt.Validate(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("partition %d for disk %q: %w", i+1, d.Device(), err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// validateSize checks that the partitions fit the disk along with the partition table,
+// if the disk is present on the host running the validation.
+func (d *MachineDisk) validateSize() error {
+	diskSize, err := deviceSize(d.DeviceName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return fmt.Errorf("disk %q: %w", d.Device(), err)
+	}
+
+	if requiredSize := config.RequiredDiskSize(d.Partitions()); requiredSize > diskSize {
+		return fmt.Errorf("partitions for disk %q require %d bytes, but the disk size is %d bytes", d.Device(), requiredSize, diskSize)
+	}
+
+	return nil
+}
+
+// deviceSize returns the size of the block device.
+func deviceSize(device string) (uint64, error) {
+	f, err := os.Open(device)
+	if err != nil {
+		return 0, err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+
+	if size <= 0 {
+		return 0, fmt.Errorf("failed to get the size of %q", device)
+	}
+
+	return uint64(size), nil
+}
+
+// Validate validates the user disk partition config.
+func (p *DiskPartition) Validate() error {
+	return validateFilesystem(p.DiskFilesystem, p.DiskLabel, p.DiskMountPoint, p.DiskMountOptions)
+}
+
+// Validate validates the user volume config.
+func (v *MachineVolume) Validate() error {
+	var result *multierror.Error
+
+	if !valid.Matches(v.VolumeName, "^[a-zA-Z0-9_][a-zA-Z0-9_-]*$") {
+		result = multierror.Append(result, fmt.Errorf("volume name %q should consist of alphanumeric characters, '_' and '-'", v.VolumeName))
+	}
+
+	switch v.VolumeType {
+	case VolumeTypeRAID:
+		switch v.VolumeLevel {
+		case 0, 1, 10:
+		default:
+			result = multierror.Append(result, fmt.Errorf("volume %q: unsupported RAID level %d, should be one of [0,1,10]", v.VolumeName, v.VolumeLevel))
+		}
+
+		if len(v.VolumeDevices) < 2 {
+			result = multierror.Append(result, fmt.Errorf("volume %q: RAID requires at least 2 devices", v.VolumeName))
+		}
+	case VolumeTypeLVM:
+		if v.VolumeLevel != 0 {
+			result = multierror.Append(result, fmt.Errorf("volume %q: level can't be set for the LVM volume", v.VolumeName))
+		}
+
+		if len(v.VolumeDevices) == 0 {
+			result = multierror.Append(result, fmt.Errorf("volume %q: at least one device is required", v.VolumeName))
+		}
+	default:
+		result = multierror.Append(result, fmt.Errorf("volume %q: unsupported type %q, should be one of [raid,lvm]", v.VolumeName, v.VolumeType))
+	}
+
+	if err := validateFilesystem(v.VolumeFilesystem, v.VolumeLabel, v.VolumeMountPoint, v.VolumeMountOptions); err != nil {
+		result = multierror.Append(result, fmt.Errorf("volume %q: %w", v.VolumeName, err))
+	}
+
+	return result.ErrorOrNil()
+}
+
+func validateFilesystem(filesystem, label, mountPoint string, mountOptions []string) error {
+	var result *multierror.Error
+
+	labelLimit := 36 // GPT partition name
+
+	switch filesystem {
+	case "", FilesystemXFS:
+		labelLimit = 12
+	case FilesystemExt4:
+		labelLimit = 16
+	case FilesystemNone:
+		if mountPoint != "" {
+			result = multierror.Append(result, fmt.Errorf("mountpoint %q can't be set if the filesystem is %q", mountPoint, filesystem))
+		}
+
+		if len(mountOptions) > 0 {
+			result = multierror.Append(result, fmt.Errorf("mount options can't be set if the filesystem is %q", filesystem))
+		}
+	default:
+		result = multierror.Append(result, fmt.Errorf("unsupported filesystem %q, should be one of [xfs,ext4,none]", filesystem))
+	}
+
+	if len(label) > labelLimit {
+		result = multierror.Append(result, fmt.Errorf("label %q is longer than %d characters", label, labelLimit))
+	}
+
+	if mountPoint != "" && !filepath.IsAbs(mountPoint) {
+		result = multierror.Append(result, fmt.Errorf("mountpoint %q should be an absolute path", mountPoint))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates the system disk encryption config.
+func (e *SystemDiskEncryptionConfig) Validate() error {
+	var result *multierror.Error
+
+	for label, encryption := range map[string]*EncryptionConfig{
+		constants.StatePartitionLabel:     e.StatePartition,
+		constants.EphemeralPartitionLabel: e.EphemeralPartition,
+	} {
+		if encryption == nil {
+			continue
+		}
+
+		if err := encryption.Validate(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("%s partition encryption: %w", label, err))
+		}
+
+		// STATE partition is opened before the machine configuration is loaded with the nodeID key in slot 0
+		if label == constants.StatePartitionLabel {
+			for i, key := range encryption.EncryptionKeys {
+				if key.KeyNodeID == nil {
+					result = multierror.Append(result, fmt.Errorf("%s partition encryption: key %d: only nodeID keys are supported", label, i))
+				}
+
+				if key.KeySlot != 0 {
+					result = multierror.Append(result, fmt.Errorf("%s partition encryption: key %d: only slot 0 is supported", label, i))
+				}
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates the partition encryption config.
+func (e *EncryptionConfig) Validate() error {
+	var result *multierror.Error
+
+	if e.EncryptionProvider != "luks2" {
+		result = multierror.Append(result, fmt.Errorf("unsupported encryption provider %q", e.EncryptionProvider))
+	}
+
+	if len(e.EncryptionKeys) == 0 {
+		result = multierror.Append(result, errors.New("at least one encryption key should be specified"))
+	}
+
+	slots := map[int]struct{}{}
+
+	for i, key := range e.EncryptionKeys {
+		if (key.KeyStatic == nil) == (key.KeyNodeID == nil) {
+			result = multierror.Append(result, fmt.Errorf("key %d: exactly one of static or nodeID should be set", i))
+		}
+
+		if key.KeyStatic != nil && key.KeyStatic.KeyData == "" {
+			result = multierror.Append(result, fmt.Errorf("key %d: static passphrase is empty", i))
+		}
+
+		if _, ok := slots[key.KeySlot]; ok {
+			result = multierror.Append(result, fmt.Errorf("key %d: slot %d is used by several keys", i, key.KeySlot))
+		}
+
+		slots[key.KeySlot] = struct{}{}
+	}
+
+	for _, option := range e.EncryptionPerfOptions {
+		switch option {
+		case "no_read_workqueue", "no_write_workqueue", "same_cpu_crypt":
+		default:
+			result = multierror.Append(result, fmt.Errorf("unsupported encryption option %q", option))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
 // Validate validates the config.
 func (c *ClusterConfig) Validate() error {
 	var result *multierror.Error
@@ -193,6 +833,11 @@ func CheckDeviceAddressing(d *Device) error {
 		result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device", d.DeviceInterface, ErrBadAddressing))
 	}
 
+	// Test for dhcp with both protocols disabled
+	if d.DeviceDHCP && !d.DHCPOptions().IPv4() && !d.DHCPOptions().IPv6() {
+		result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.dhcpOptions", d.DeviceInterface, ErrBadAddressing))
+	}
+
 	// ensure cidr is a valid address
 	if d.DeviceCIDR != "" {
 		if _, _, err := net.ParseCIDR(d.DeviceCIDR); err != nil {
@@ -203,6 +848,57 @@ func CheckDeviceAddressing(d *Device) error {
 	return result.ErrorOrNil()
 }
 
+// CheckDeviceWireguard ensures that the WireGuard configuration is valid.
+//nolint: dupl
+func CheckDeviceWireguard(d *Device) error {
+	var result *multierror.Error
+
+	if d == nil {
+		return fmt.Errorf("empty device")
+	}
+
+	if d.DeviceWireguardConfig == nil {
+		return result.ErrorOrNil()
+	}
+
+	if d.DeviceBond != nil {
+		result = multierror.Append(result, fmt.Errorf("[%s] %q: wireguard and bond are mutually exclusive", "networking.os.device.wireguard", d.DeviceInterface))
+	}
+
+	if !isValidWireguardKey(d.DeviceWireguardConfig.WireguardPrivateKey) {
+		result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.wireguard.privateKey", d.DeviceInterface, ErrInvalidWireguardKey))
+	}
+
+	for idx, peer := range d.DeviceWireguardConfig.WireguardPeers {
+		path := "networking.os.device.wireguard.peers[" + strconv.Itoa(idx) + "]"
+
+		if !isValidWireguardKey(peer.WireguardPublicKey) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+".publicKey", d.DeviceInterface, ErrInvalidWireguardKey))
+		}
+
+		if peer.WireguardEndpoint != "" {
+			if _, _, err := net.SplitHostPort(peer.WireguardEndpoint); err != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+".endpoint", peer.WireguardEndpoint, err))
+			}
+		}
+
+		for _, allowedIP := range peer.WireguardAllowedIPs {
+			if _, _, err := net.ParseCIDR(allowedIP); err != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+".allowedIPs", allowedIP, ErrInvalidAddress))
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// isValidWireguardKey checks that the key is base64 encoded 256-bit key.
+func isValidWireguardKey(key string) bool {
+	b, err := base64.StdEncoding.DecodeString(key)
+
+	return err == nil && len(b) == 32
+}
+
 // CheckDeviceRoutes ensures that the specified routes are valid.
 //nolint: dupl
 func CheckDeviceRoutes(d *Device) error {
@@ -0,0 +1,286 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package v1alpha1
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+type runtimeMode string
+
+func (m runtimeMode) String() string {
+	return string(m)
+}
+
+func (m runtimeMode) RequiresInstall() bool {
+	return m == "metal"
+}
+
+func service(name string, dependsOn ...string) *ServiceConfig {
+	return &ServiceConfig{
+		ServiceName:      name,
+		ServiceImage:     "docker.io/library/" + name,
+		ServiceDependsOn: dependsOn,
+	}
+}
+
+func TestLoadedServices(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		machine  *MachineConfig
+		mode     runtimeMode
+		loaded   []string
+		unloaded []string
+	}{
+		{
+			name:     "init",
+			machine:  &MachineConfig{MachineType: "init"},
+			mode:     "metal",
+			loaded:   []string{"apid", "bootkube", "etcd", "timed", "trustd", "udevd"},
+			unloaded: []string{"metrics"},
+		},
+		{
+			name:     "controlplane",
+			machine:  &MachineConfig{MachineType: "controlplane", MachineMetrics: &MetricsConfig{MetricsEnabled: true}},
+			mode:     "cloud",
+			loaded:   []string{"etcd", "metrics", "trustd"},
+			unloaded: []string{"bootkube"},
+		},
+		{
+			name:     "join",
+			machine:  &MachineConfig{MachineType: "join", MachineTime: &TimeConfig{TimeDisabled: true}},
+			mode:     "metal",
+			loaded:   []string{"kubelet", "udevd"},
+			unloaded: []string{"bootkube", "etcd", "timed", "trustd"},
+		},
+		{
+			name:     "container",
+			machine:  &MachineConfig{MachineType: "join"},
+			mode:     "container",
+			loaded:   []string{"containerd", "cri", "machined", "networkd", "routerd"},
+			unloaded: []string{"timed", "udevd"},
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			loaded := loadedServices(&Config{MachineConfig: tt.machine}, tt.mode)
+
+			for _, id := range tt.loaded {
+				assert.Contains(t, loaded, id)
+			}
+
+			for _, id := range tt.unloaded {
+				assert.NotContains(t, loaded, id)
+			}
+		})
+	}
+}
+
+func TestValidateServices(t *testing.T) {
+	loaded := loadedServices(&Config{MachineConfig: &MachineConfig{MachineType: "join"}}, runtimeMode("metal"))
+
+	for _, tt := range []struct {
+		name          string
+		services      []*ServiceConfig
+		expectedError string
+	}{
+		{
+			name: "valid",
+			services: []*ServiceConfig{
+				service("a", "b", "kubelet"),
+				service("b", "networkd"),
+				service("c", "a", "b"),
+			},
+		},
+		{
+			name:          "undefined",
+			services:      []*ServiceConfig{service("a", "b")},
+			expectedError: `service "a": dependency "b" is not defined`,
+		},
+		{
+			name:          "not running",
+			services:      []*ServiceConfig{service("a", "etcd")},
+			expectedError: `service "a": dependency "etcd" is not running on this node`,
+		},
+		{
+			name: "cycle",
+			services: []*ServiceConfig{
+				service("a", "b"),
+				service("b", "c"),
+				service("c", "a"),
+			},
+			expectedError: "service dependency cycle: a -> b -> c -> a",
+		},
+		{
+			name: "cycle not including the first service",
+			services: []*ServiceConfig{
+				service("a", "b"),
+				service("b", "c"),
+				service("c", "b"),
+			},
+			expectedError: "service dependency cycle: b -> c -> b",
+		},
+		{
+			name:          "self",
+			services:      []*ServiceConfig{service("a", "a")},
+			expectedError: `service "a" can't depend on itself`,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateServices(tt.services, loaded)
+
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+			assert.NotContains(t, err.Error(), "cycle: a -> a")
+		})
+	}
+}
+
+func TestValidateSystemDiskEncryption(t *testing.T) {
+	nodeID := func(slot int) *EncryptionKey {
+		return &EncryptionKey{KeyNodeID: &EncryptionKeyNodeID{}, KeySlot: slot}
+	}
+
+	static := func(slot int) *EncryptionKey {
+		return &EncryptionKey{KeyStatic: &EncryptionKeyStatic{KeyData: "secret"}, KeySlot: slot}
+	}
+
+	for _, tt := range []struct {
+		name          string
+		config        *SystemDiskEncryptionConfig
+		expectedError string
+	}{
+		{
+			name: "valid",
+			config: &SystemDiskEncryptionConfig{
+				StatePartition:     &EncryptionConfig{EncryptionProvider: "luks2", EncryptionKeys: []*EncryptionKey{nodeID(0)}},
+				EphemeralPartition: &EncryptionConfig{EncryptionProvider: "luks2", EncryptionKeys: []*EncryptionKey{nodeID(1), static(3)}},
+			},
+		},
+		{
+			name: "state static key",
+			config: &SystemDiskEncryptionConfig{
+				StatePartition: &EncryptionConfig{EncryptionProvider: "luks2", EncryptionKeys: []*EncryptionKey{static(0)}},
+			},
+			expectedError: "STATE partition encryption: key 0: only nodeID keys are supported",
+		},
+		{
+			name: "state nodeID key slot",
+			config: &SystemDiskEncryptionConfig{
+				StatePartition: &EncryptionConfig{EncryptionProvider: "luks2", EncryptionKeys: []*EncryptionKey{nodeID(2)}},
+			},
+			expectedError: "STATE partition encryption: key 0: only slot 0 is supported",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
+
+func TestValidateDiskSize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "talos")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	device := filepath.Join(dir, "disk")
+	require.NoError(t, ioutil.WriteFile(device, make([]byte, 64*1024*1024), 0o600))
+
+	disk := func(sizes ...DiskSize) *MachineDisk {
+		d := &MachineDisk{DeviceName: device}
+
+		for i, size := range sizes {
+			d.DiskPartitions = append(d.DiskPartitions, &DiskPartition{DiskSize: size, DiskMountPoint: fmt.Sprintf("/var/mnt/data%d", i)})
+		}
+
+		return d
+	}
+
+	for _, tt := range []struct {
+		name          string
+		disk          *MachineDisk
+		local         bool
+		expectedError string
+	}{
+		{
+			name:  "fits",
+			disk:  disk(32*1024*1024, 0),
+			local: true,
+		},
+		{
+			name:          "exact fit without the partition table",
+			disk:          disk(64 * 1024 * 1024),
+			local:         true,
+			expectedError: "require 68182016 bytes, but the disk size is 67108864 bytes",
+		},
+		{
+			name:          "no space for the last partition",
+			disk:          disk(62*1024*1024, 0),
+			local:         true,
+			expectedError: "require 67133440 bytes",
+		},
+		{
+			name: "not local",
+			disk: disk(128 * 1024 * 1024),
+		},
+		{
+			name:  "missing disk",
+			disk:  &MachineDisk{DeviceName: filepath.Join(dir, "missing"), DiskPartitions: []*DiskPartition{{DiskSize: 128 * 1024 * 1024}}},
+			local: true,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []config.ValidationOption
+
+			if tt.local {
+				opts = append(opts, config.WithLocal())
+			}
+
+			err := validateDisksAndVolumes([]*MachineDisk{tt.disk}, nil, config.NewValidationOptions(opts...))
+
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import "github.com/talos-systems/talos/pkg/machinery/constants"
+
+// ValidationOptions additional validation parameters.
+type ValidationOptions struct {
+	// Local enables the checks of the local system (e.g. that the install disk exists),
+	// which only make sense when validating on the node itself.
+	Local bool
+}
+
+// ValidationOption implements additional validation parameters.
+type ValidationOption func(opts *ValidationOptions)
+
+// WithLocal enables the checks of the local system.
+func WithLocal() ValidationOption {
+	return func(opts *ValidationOptions) {
+		opts.Local = true
+	}
+}
+
+// NewValidationOptions initializes validation options from the list of options.
+func NewValidationOptions(options ...ValidationOption) *ValidationOptions {
+	opts := &ValidationOptions{}
+
+	for _, f := range options {
+		f(opts)
+	}
+
+	return opts
+}
+
+// RequiredDiskSize returns the size of the disk required to fit the partitions.
+//
+// The partition table is accounted for: the first partition starts at the alignment (after the primary GPT),
+// the partitions are rounded up to the alignment, and the backup GPT is reserved at the end of the disk.
+// The partition occupying the rest of the disk requires at least the alignment.
+func RequiredDiskSize(partitions []Partition) uint64 {
+	const alignment = constants.PartitionAlignment
+
+	size := uint64(alignment + constants.GPTReservedSize)
+
+	for _, part := range partitions {
+		partSize := part.Size()
+		if partSize == 0 {
+			partSize = alignment
+		}
+
+		size += (partSize + alignment - 1) / alignment * alignment
+	}
+
+	return size
+}
@@ -241,9 +241,24 @@ const (
 	// EtcdDataPath is the path where etcd stores its' data.
 	EtcdDataPath = "/var/lib/etcd"
 
+	// EtcdRecoverySnapshotPath is the path where etcd snapshot is uploaded for recovery.
+	EtcdRecoverySnapshotPath = "/var/lib/etcd.snapshot"
+
 	// ConfigPath is the path to the downloaded config.
 	ConfigPath = StateMountPoint + "/config.yaml"
 
+	// EventLogPath is the path to the persisted runtime events log.
+	EventLogPath = StateMountPoint + "/events/events.log"
+
+	// PstoreMountPoint is the path to the pstore filesystem with the kernel log records of the previous boot.
+	PstoreMountPoint = "/sys/fs/pstore"
+
+	// PreviousBootKernelLogPath is the path to the kernel log of the previous boot saved from the pstore records.
+	PreviousBootKernelLogPath = StateMountPoint + "/pstore/kernel.log"
+
+	// EventLogMaxSize is the maximum size of the runtime events log file, the log keeps one previous file.
+	EventLogMaxSize = 1024 * 1024
+
 	// MetalConfigISOLabel is the volume label for ISO based configuration.
 	MetalConfigISOLabel = "metal-iso"
 
@@ -271,9 +286,46 @@ const (
 	// TrustdPort is the port for the trustd service.
 	TrustdPort = 50001
 
+	// DefaultServiceRestart is the default restart policy of the user-defined services.
+	DefaultServiceRestart = "always"
+
+	// DefaultServiceHealthCheckInterval is the default interval between health checks of the user-defined services.
+	DefaultServiceHealthCheckInterval = 5 * time.Second
+
+	// DefaultServiceHealthCheckTimeout is the default timeout of the health check of the user-defined services.
+	DefaultServiceHealthCheckTimeout = time.Second
+
+	// VolumeMonitorInterval is the interval between the checks of the user volumes state.
+	VolumeMonitorInterval = 30 * time.Second
+
+	// DefaultBootWatchdogTimeout is the default time for the node to boot after the upgrade before the rollback.
+	DefaultBootWatchdogTimeout = 10 * time.Minute
+
+	// DefaultMetricsPort is the default port for the machined metrics endpoint.
+	DefaultMetricsPort = 50002
+
 	// DefaultContainerdVersion is the default container runtime version.
 	DefaultContainerdVersion = "1.4.2"
 
+	// PartitionAlignment is the alignment of the partitions on the user disks in bytes.
+	PartitionAlignment = 1024 * 1024
+
+	// GPTReservedSize is the size of the GPT header and the partition entries (for up to 4096 bytes sectors) in bytes,
+	// reserved both at the start and at the end of the disk.
+	GPTReservedSize = 2*4096 + 128*128
+
+	// CgroupSystem is the cgroup path of the system services, each service is placed into the nested cgroup.
+	CgroupSystem = "/system"
+
+	// EtcdCPUShares is the relative CPU weight of the etcd cgroup.
+	EtcdCPUShares = 2048
+
+	// SystemContainerdCPUShares is the relative CPU weight of the system containerd cgroup.
+	SystemContainerdCPUShares = 512
+
+	// CRICPUShares is the relative CPU weight of the CRI containerd cgroup.
+	CRICPUShares = 1024
+
 	// SystemContainerdNamespace is the Containerd namespace for Talos services.
 	SystemContainerdNamespace = "system"
 
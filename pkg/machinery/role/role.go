@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package role defines Talos API roles.
+//
+// Roles are carried in the Organization field of the client certificate.
+package role
+
+import (
+	"sort"
+	"strings"
+)
+
+// Role is a Talos API role.
+type Role string
+
+// Prefix is a common prefix of all Talos API roles.
+const Prefix = "os:"
+
+// Talos API roles.
+const (
+	// Admin has access to all the API methods.
+	Admin Role = Prefix + "admin"
+	// Operator can perform operational tasks (reboot, service restart, etc.) in addition to Reader.
+	Operator Role = Prefix + "operator"
+	// Reader has read-only access which doesn't expose secrets.
+	Reader Role = Prefix + "reader"
+)
+
+// Set is a set of roles.
+type Set map[Role]struct{}
+
+// MakeSet builds a set of roles.
+func MakeSet(roles ...Role) Set {
+	set := make(Set, len(roles))
+
+	for _, r := range roles {
+		set[r] = struct{}{}
+	}
+
+	return set
+}
+
+// Parse builds a set of roles from strings (e.g. certificate organizations).
+//
+// Strings without the role prefix are ignored, strings with the prefix which don't match
+// any known role are returned as unknown.
+func Parse(str []string) (set Set, unknown []string) {
+	set = Set{}
+
+	for _, s := range str {
+		if !strings.HasPrefix(s, Prefix) {
+			continue
+		}
+
+		switch r := Role(s); r {
+		case Admin, Operator, Reader:
+			set[r] = struct{}{}
+		default:
+			unknown = append(unknown, s)
+		}
+	}
+
+	return set, unknown
+}
+
+// Includes checks if the set contains the role.
+func (set Set) Includes(r Role) bool {
+	_, ok := set[r]
+
+	return ok
+}
+
+// IncludesAny checks if the set contains any of the roles from the other set.
+func (set Set) IncludesAny(other Set) bool {
+	for r := range other {
+		if set.Includes(r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Strings returns sorted list of roles as strings.
+func (set Set) Strings() []string {
+	res := make([]string, 0, len(set))
+
+	for r := range set {
+		res = append(res, string(r))
+	}
+
+	sort.Strings(res)
+
+	return res
+}
@@ -0,0 +1,38 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package role_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+func TestParse(t *testing.T) {
+	set, unknown := role.Parse([]string{"os:reader", "talos", "os:operator", "os:impersonator", "os:reader"})
+
+	assert.Equal(t, role.MakeSet(role.Reader, role.Operator), set)
+	assert.Equal(t, []string{"os:impersonator"}, unknown)
+
+	set, unknown = role.Parse(nil)
+
+	assert.Empty(t, set)
+	assert.Empty(t, unknown)
+}
+
+func TestSet(t *testing.T) {
+	set := role.MakeSet(role.Reader, role.Operator)
+
+	assert.True(t, set.Includes(role.Reader))
+	assert.False(t, set.Includes(role.Admin))
+
+	assert.True(t, set.IncludesAny(role.MakeSet(role.Admin, role.Operator)))
+	assert.False(t, set.IncludesAny(role.MakeSet(role.Admin)))
+	assert.False(t, set.IncludesAny(role.MakeSet()))
+
+	assert.Equal(t, []string{"os:operator", "os:reader"}, set.Strings())
+}
@@ -0,0 +1,51 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package provision
+
+import (
+	"fmt"
+	"net"
+
+	talosnet "github.com/talos-systems/net"
+)
+
+// AllocateIPs picks count free IPs from the cluster network.
+//
+// Allocation starts right after the gateway address (which is the first address in the network),
+// IPs of the existing nodes and reserved IPs are skipped.
+func AllocateIPs(info ClusterInfo, count int, reserved ...net.IP) ([]net.IP, error) {
+	used := map[string]struct{}{}
+
+	for _, ip := range reserved {
+		used[ip.String()] = struct{}{}
+	}
+
+	if info.Network.GatewayAddr != nil {
+		used[info.Network.GatewayAddr.String()] = struct{}{}
+	}
+
+	for _, node := range append(append([]NodeInfo(nil), info.Nodes...), info.ExtraNodes...) {
+		if node.PrivateIP != nil {
+			used[node.PrivateIP.String()] = struct{}{}
+		}
+	}
+
+	ips := make([]net.IP, 0, count)
+
+	for i := 2; len(ips) < count; i++ {
+		ip, err := talosnet.NthIPInNetwork(&info.Network.CIDR, i)
+		if err != nil || !info.Network.CIDR.Contains(ip) {
+			return nil, fmt.Errorf("no free IPs left in the network %s", info.Network.CIDR.String())
+		}
+
+		if _, ok := used[ip.String()]; ok {
+			continue
+		}
+
+		ips = append(ips, ip)
+	}
+
+	return ips, nil
+}
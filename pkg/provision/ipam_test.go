@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package provision_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/provision"
+)
+
+func clusterInfo(t *testing.T, cidr string, nodeIPs ...string) provision.ClusterInfo {
+	_, network, err := net.ParseCIDR(cidr)
+	require.NoError(t, err)
+
+	info := provision.ClusterInfo{
+		Network: provision.NetworkInfo{
+			CIDR:        *network,
+			GatewayAddr: net.ParseIP("10.5.0.1"),
+		},
+	}
+
+	for _, ip := range nodeIPs {
+		info.Nodes = append(info.Nodes, provision.NodeInfo{PrivateIP: net.ParseIP(ip)})
+	}
+
+	return info
+}
+
+func TestAllocateIPs(t *testing.T) {
+	info := clusterInfo(t, "10.5.0.0/24", "10.5.0.2", "10.5.0.3", "10.5.0.5")
+
+	ips, err := provision.AllocateIPs(info, 3, net.ParseIP("10.5.0.6"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"10.5.0.4", "10.5.0.7", "10.5.0.8"}, ipStrings(ips))
+}
+
+func TestAllocateIPsExhausted(t *testing.T) {
+	info := clusterInfo(t, "10.5.0.0/29", "10.5.0.2", "10.5.0.3", "10.5.0.4")
+
+	_, err := provision.AllocateIPs(info, 4)
+	assert.Error(t, err)
+}
+
+func ipStrings(ips []net.IP) []string {
+	result := make([]string, len(ips))
+
+	for i := range ips {
+		result[i] = ips[i].String()
+	}
+
+	return result
+}
@@ -0,0 +1,189 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package docker
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	multierror "github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/provision"
+)
+
+// AddNodes adds containers to the existing cluster.
+//
+// nolint: gocyclo
+func (p *provisioner) AddNodes(ctx context.Context, cluster provision.Cluster, nodeReqs provision.NodeRequests, opts ...provision.Option) (provision.Cluster, error) {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	info := cluster.Info()
+
+	containers, err := p.listNodes(ctx, info.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(containers) == 0 {
+		return nil, fmt.Errorf("cluster %q has no nodes", info.ClusterName)
+	}
+
+	existing := map[string]struct{}{}
+
+	for _, container := range containers {
+		existing[containerName(container)] = struct{}{}
+	}
+
+	var (
+		missingIPs int
+		reserved   []net.IP
+	)
+
+	for i := range nodeReqs {
+		if _, ok := existing[nodeReqs[i].Name]; ok {
+			return nil, fmt.Errorf("node %q already exists in the cluster", nodeReqs[i].Name)
+		}
+
+		if nodeReqs[i].IP == nil {
+			missingIPs++
+		} else {
+			reserved = append(reserved, nodeReqs[i].IP)
+		}
+
+		if nodeReqs[i].Config != nil {
+			continue
+		}
+
+		if nodeReqs[i].Config, err = p.copyNodeConfig(ctx, containers, nodeReqs[i].Type); err != nil {
+			return nil, err
+		}
+	}
+
+	if missingIPs > 0 {
+		var ips []net.IP
+
+		if ips, err = provision.AllocateIPs(info, missingIPs, reserved...); err != nil {
+			return nil, err
+		}
+
+		for i := range nodeReqs {
+			if nodeReqs[i].IP == nil {
+				nodeReqs[i].IP, ips = ips[0], ips[1:]
+			}
+		}
+	}
+
+	request := provision.ClusterRequest{
+		Name: info.ClusterName,
+		Network: provision.NetworkRequest{
+			Name:        info.Network.Name,
+			CIDR:        info.Network.CIDR,
+			GatewayAddr: info.Network.GatewayAddr,
+			MTU:         info.Network.MTU,
+		},
+		// new nodes run the same image as the existing ones
+		Image: containers[0].Image,
+	}
+
+	fmt.Fprintln(options.LogWriter, "creating nodes")
+
+	if _, err = p.createNodes(ctx, request, nodeReqs, &options); err != nil {
+		return nil, err
+	}
+
+	return p.Reflect(ctx, info.ClusterName, "")
+}
+
+// copyNodeConfig decodes the config of the existing container of the specified type.
+func (p *provisioner) copyNodeConfig(ctx context.Context, containers []types.Container, nodeType machine.Type) (config.Provider, error) {
+	for _, container := range containers {
+		if container.Labels["talos.type"] != nodeType.String() {
+			continue
+		}
+
+		info, err := p.client.ContainerInspect(ctx, container.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, env := range info.Config.Env {
+			if !strings.HasPrefix(env, "USERDATA=") {
+				continue
+			}
+
+			cfg, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(env, "USERDATA="))
+			if err != nil {
+				return nil, fmt.Errorf("error decoding config of %q: %w", containerName(container), err)
+			}
+
+			return configloader.NewFromBytes(cfg)
+		}
+	}
+
+	return nil, fmt.Errorf("no existing node of type %q to copy the config from, config should be provided explicitly", nodeType)
+}
+
+// RemoveNodes removes containers with the specified names.
+func (p *provisioner) RemoveNodes(ctx context.Context, cluster provision.Cluster, names []string, opts ...provision.Option) (provision.Cluster, error) {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	containers, err := p.listNodes(ctx, cluster.Info().ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string]types.Container{}
+
+	for _, container := range containers {
+		byName[containerName(container)] = container
+	}
+
+	for _, name := range names {
+		if _, ok := byName[name]; !ok {
+			return nil, fmt.Errorf("node %q not found in the cluster %q", name, cluster.Info().ClusterName)
+		}
+	}
+
+	if len(names) >= len(containers) {
+		return nil, fmt.Errorf("can't remove all the nodes of the cluster, use talosctl cluster destroy")
+	}
+
+	var multiErr *multierror.Error
+
+	for _, name := range names {
+		fmt.Fprintln(options.LogWriter, "destroying node", name)
+
+		multiErr = multierror.Append(multiErr, p.client.ContainerRemove(ctx, byName[name].ID, types.ContainerRemoveOptions{RemoveVolumes: true, Force: true}))
+	}
+
+	if err = multiErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	return p.Reflect(ctx, cluster.Info().ClusterName, "")
+}
+
+func containerName(container types.Container) string {
+	return strings.TrimPrefix(container.Names[0], "/")
+}
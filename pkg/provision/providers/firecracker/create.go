@@ -93,6 +93,8 @@ func (p *provisioner) Create(ctx context.Context, request provision.ClusterReque
 		Nodes: nodeInfo,
 	}
 
+	state.SetRequest(request, &options)
+
 	err = state.Save()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qemu
+
+import (
+	"context"
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/pkg/provision"
+	"github.com/talos-systems/talos/pkg/provision/providers/vm"
+)
+
+// AddNodes adds qemu VMs to the existing cluster.
+func (p *provisioner) AddNodes(ctx context.Context, cluster provision.Cluster, nodeReqs provision.NodeRequests, opts ...provision.Option) (provision.Cluster, error) {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	state, ok := cluster.(*vm.State)
+	if !ok {
+		return nil, fmt.Errorf("error inspecting qemu state, %#+v", cluster)
+	}
+
+	request, err := state.Request(&options)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.PrepareNodes(state, nodeReqs); err != nil {
+		return nil, err
+	}
+
+	var (
+		nodes, pxeNodes provision.NodeRequests
+		multiErr        *multierror.Error
+	)
+
+	for _, nodeReq := range nodeReqs {
+		if nodeReq.PXEBooted {
+			pxeNodes = append(pxeNodes, nodeReq)
+		} else {
+			nodes = append(nodes, nodeReq)
+		}
+	}
+
+	fmt.Fprintln(options.LogWriter, "creating nodes")
+
+	// nodes which were created are recorded in the state even if some of the nodes failed
+	nodeInfo, err := p.createNodes(state, request, nodes, &options)
+	multiErr = multierror.Append(multiErr, err)
+
+	state.ClusterInfo.Nodes = append(state.ClusterInfo.Nodes, nodeInfo...)
+
+	if len(pxeNodes) > 0 {
+		fmt.Fprintln(options.LogWriter, "creating PXE nodes")
+
+		nodeInfo, err = p.createNodes(state, request, pxeNodes, &options)
+		multiErr = multierror.Append(multiErr, err)
+
+		state.ClusterInfo.ExtraNodes = append(state.ClusterInfo.ExtraNodes, nodeInfo...)
+	}
+
+	if len(nodes.MasterNodes()) > 0 {
+		fmt.Fprintln(options.LogWriter, "updating load balancer")
+
+		if err = p.UpdateLoadBalancer(state); err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("error updating load balancer: %w", err))
+		}
+	}
+
+	multiErr = multierror.Append(multiErr, state.Save())
+
+	return state, multiErr.ErrorOrNil()
+}
@@ -106,6 +106,8 @@ func (p *provisioner) Create(ctx context.Context, request provision.ClusterReque
 		ExtraNodes: pxeNodeInfo,
 	}
 
+	state.SetRequest(request, &options)
+
 	err = state.Save()
 	if err != nil {
 		return nil, err
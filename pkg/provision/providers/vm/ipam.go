@@ -6,8 +6,10 @@ package vm
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
 	"path/filepath"
@@ -80,3 +82,61 @@ func LoadIPAMRecords(statePath string) (IPAMDatabase, error) {
 
 	return result, scanner.Err()
 }
+
+// DeleteIPAMRecords removes IPAM records for the specified hostnames from the database.
+func DeleteIPAMRecords(statePath string, hostnames ...string) error {
+	db, err := LoadIPAMRecords(statePath)
+	if err != nil {
+		return err
+	}
+
+	if db == nil {
+		return nil
+	}
+
+	remove := map[string]struct{}{}
+
+	for _, hostname := range hostnames {
+		remove[hostname] = struct{}{}
+	}
+
+	var buf bytes.Buffer
+
+	for _, record := range db {
+		if _, ok := remove[record.Hostname]; ok {
+			continue
+		}
+
+		b, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("error marshaling IPAM record: %w", err)
+		}
+
+		buf.Write(append(b, '\n'))
+	}
+
+	// database is re-read by dhcpd on each request, so replace it atomically
+	tmpPath := filepath.Join(statePath, dbFile+".tmp")
+
+	if err = ioutil.WriteFile(tmpPath, buf.Bytes(), os.ModePerm); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, filepath.Join(statePath, dbFile))
+}
+
+// ReservedIPs returns IPs which are recorded in the IPAM database.
+func ReservedIPs(statePath string) ([]net.IP, error) {
+	db, err := LoadIPAMRecords(statePath)
+	if err != nil {
+		return nil, err
+	}
+
+	ips := make([]net.IP, 0, len(db))
+
+	for _, record := range db {
+		ips = append(ips, record.IP)
+	}
+
+	return ips, nil
+}
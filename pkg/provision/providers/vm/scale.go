@@ -0,0 +1,273 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	multierror "github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/provision"
+)
+
+// PrepareNodes fills in missing IPs and configs of the nodes being added to the cluster.
+//
+// IPs are allocated from the cluster network skipping IPs recorded in the IPAM database,
+// configs are copied from the existing nodes of the same type.
+func (p *Provisioner) PrepareNodes(state *State, nodeReqs provision.NodeRequests) error {
+	existing := map[string]provision.NodeInfo{}
+
+	for _, node := range append(append([]provision.NodeInfo(nil), state.ClusterInfo.Nodes...), state.ClusterInfo.ExtraNodes...) {
+		existing[node.Name] = node
+	}
+
+	var missingIPs int
+
+	for i := range nodeReqs {
+		if _, ok := existing[nodeReqs[i].Name]; ok {
+			return fmt.Errorf("node %q already exists in the cluster", nodeReqs[i].Name)
+		}
+
+		if nodeReqs[i].IP == nil {
+			missingIPs++
+		}
+
+		if nodeReqs[i].Config != nil || nodeReqs[i].PXEBooted {
+			continue
+		}
+
+		var err error
+
+		if nodeReqs[i].Config, err = p.copyNodeConfig(state, nodeReqs[i].Type); err != nil {
+			return err
+		}
+	}
+
+	if missingIPs == 0 {
+		return nil
+	}
+
+	reserved, err := ReservedIPs(state.statePath)
+	if err != nil {
+		return fmt.Errorf("error loading IPAM records: %w", err)
+	}
+
+	for _, nodeReq := range nodeReqs {
+		if nodeReq.IP != nil {
+			reserved = append(reserved, nodeReq.IP)
+		}
+	}
+
+	ips, err := provision.AllocateIPs(state.ClusterInfo, missingIPs, reserved...)
+	if err != nil {
+		return err
+	}
+
+	for i := range nodeReqs {
+		if nodeReqs[i].IP == nil {
+			nodeReqs[i].IP, ips = ips[0], ips[1:]
+		}
+	}
+
+	return nil
+}
+
+// copyNodeConfig loads the config from the launch config of the existing node of the specified type.
+func (p *Provisioner) copyNodeConfig(state *State, nodeType machine.Type) (config.Provider, error) {
+	for _, node := range state.ClusterInfo.Nodes {
+		if node.Type != nodeType {
+			continue
+		}
+
+		cfg, err := readNodeConfig(state, node.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if cfg == "" {
+			continue
+		}
+
+		return configloader.NewFromBytes([]byte(cfg))
+	}
+
+	return nil, fmt.Errorf("no existing node of type %q to copy the config from, config should be provided explicitly", nodeType)
+}
+
+// readNodeConfig reads machine config from the launch config of the node.
+func readNodeConfig(state *State, name string) (string, error) {
+	f, err := os.Open(state.GetRelativePath(fmt.Sprintf("%s.config", name)))
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	var launchConfig struct {
+		Config string
+	}
+
+	if err = json.NewDecoder(f).Decode(&launchConfig); err != nil {
+		return "", fmt.Errorf("error decoding launch config of %q: %w", name, err)
+	}
+
+	return launchConfig.Config, nil
+}
+
+// RemoveNodes stops VMs and removes their state.
+//
+// nolint: gocyclo
+func (p *Provisioner) RemoveNodes(ctx context.Context, cluster provision.Cluster, names []string, opts ...provision.Option) (provision.Cluster, error) {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	state, ok := cluster.(*State)
+	if !ok {
+		return nil, fmt.Errorf("error inspecting %s state, %#+v", p.Name, cluster)
+	}
+
+	remove := map[string]struct{}{}
+
+	for _, name := range names {
+		remove[name] = struct{}{}
+	}
+
+	var (
+		removed           []provision.NodeInfo
+		controlPlaneNodes bool
+	)
+
+	filter := func(nodes []provision.NodeInfo) []provision.NodeInfo {
+		var kept []provision.NodeInfo
+
+		for _, node := range nodes {
+			if _, ok := remove[node.Name]; !ok {
+				kept = append(kept, node)
+
+				continue
+			}
+
+			removed = append(removed, node)
+			delete(remove, node.Name)
+
+			if node.Type == machine.TypeInit || node.Type == machine.TypeControlPlane {
+				controlPlaneNodes = true
+			}
+		}
+
+		return kept
+	}
+
+	nodes := filter(state.ClusterInfo.Nodes)
+	extraNodes := filter(state.ClusterInfo.ExtraNodes)
+
+	for _, name := range names {
+		if _, ok := remove[name]; ok {
+			return nil, fmt.Errorf("node %q not found in the cluster %q", name, state.ClusterInfo.ClusterName)
+		}
+	}
+
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("can't remove all the nodes of the cluster, use talosctl cluster destroy")
+	}
+
+	if err := p.DestroyNodes(provision.ClusterInfo{Nodes: removed}, &options); err != nil {
+		return nil, err
+	}
+
+	var multiErr *multierror.Error
+
+	for _, node := range removed {
+		multiErr = multierror.Append(multiErr, p.removeNodeFiles(state, node.Name))
+	}
+
+	if err := multiErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	removedNames := make([]string, len(removed))
+
+	for i := range removed {
+		removedNames[i] = removed[i].Name
+	}
+
+	if err := DeleteIPAMRecords(state.statePath, removedNames...); err != nil {
+		return nil, fmt.Errorf("error removing IPAM records: %w", err)
+	}
+
+	state.ClusterInfo.Nodes = nodes
+	state.ClusterInfo.ExtraNodes = extraNodes
+
+	if controlPlaneNodes {
+		fmt.Fprintln(options.LogWriter, "updating load balancer")
+
+		if err := p.UpdateLoadBalancer(state); err != nil {
+			return nil, fmt.Errorf("error updating load balancer: %w", err)
+		}
+	}
+
+	if err := state.Save(); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// UpdateLoadBalancer restarts the load balancer with the current list of control plane nodes.
+func (p *Provisioner) UpdateLoadBalancer(state *State) error {
+	if state.ClusterRequest == nil {
+		return fmt.Errorf("cluster %q was created with older version of talosctl, load balancer can't be updated", state.ClusterInfo.ClusterName)
+	}
+
+	request := *state.ClusterRequest
+	request.Nodes = nil
+
+	for _, node := range state.ClusterInfo.Nodes {
+		request.Nodes = append(request.Nodes, provision.NodeRequest{
+			Name: node.Name,
+			Type: node.Type,
+			IP:   node.PrivateIP,
+		})
+	}
+
+	if err := p.DestroyLoadBalancer(state); err != nil {
+		return err
+	}
+
+	return p.CreateLoadBalancer(state, request)
+}
+
+func (p *Provisioner) removeNodeFiles(state *State, name string) error {
+	var paths []string
+
+	for _, pattern := range []string{"%s.*", "%s-*.disk", "%s-flash*.img"} {
+		matches, err := filepath.Glob(state.GetRelativePath(fmt.Sprintf(pattern, name)))
+		if err != nil {
+			return err
+		}
+
+		paths = append(paths, matches...)
+	}
+
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
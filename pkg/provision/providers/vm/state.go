@@ -24,6 +24,14 @@ type State struct {
 
 	VMCNIConfig *libcni.NetworkConfigList
 
+	// ClusterRequest is the request cluster was created with (without the nodes),
+	// it is used to provision nodes added to the cluster later.
+	ClusterRequest *provision.ClusterRequest `yaml:",omitempty"`
+
+	TargetArch        string
+	BootloaderEnabled bool
+	UEFIEnabled       bool
+
 	statePath string
 }
 
@@ -91,6 +99,29 @@ func (s *State) Save() error {
 	return stateFile.Close()
 }
 
+// SetRequest saves cluster-wide settings of the request and options to the state.
+func (s *State) SetRequest(request provision.ClusterRequest, options *provision.Options) {
+	request.Nodes = nil
+
+	s.ClusterRequest = &request
+	s.TargetArch = options.TargetArch
+	s.BootloaderEnabled = options.BootloaderEnabled
+	s.UEFIEnabled = options.UEFIEnabled
+}
+
+// Request returns the request cluster was created with, options are updated to match the state.
+func (s *State) Request(options *provision.Options) (provision.ClusterRequest, error) {
+	if s.ClusterRequest == nil {
+		return provision.ClusterRequest{}, fmt.Errorf("cluster %q was created with older version of talosctl, adding nodes is not supported", s.ClusterInfo.ClusterName)
+	}
+
+	options.TargetArch = s.TargetArch
+	options.BootloaderEnabled = s.BootloaderEnabled
+	options.UEFIEnabled = s.UEFIEnabled
+
+	return *s.ClusterRequest, nil
+}
+
 // GetRelativePath get file path relative to config folder.
 func (s *State) GetRelativePath(path string) string {
 	return filepath.Join(s.statePath, path)
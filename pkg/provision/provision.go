@@ -17,6 +17,16 @@ type Provisioner interface {
 	Create(context.Context, ClusterRequest, ...Option) (Cluster, error)
 	Destroy(context.Context, Cluster, ...Option) error
 
+	// AddNodes adds nodes to the existing cluster.
+	//
+	// If node request doesn't have an IP, it is allocated from the cluster network.
+	// If node request doesn't have a config, config of the existing node of the same type is used.
+	AddNodes(context.Context, Cluster, NodeRequests, ...Option) (Cluster, error)
+	// RemoveNodes removes nodes with the specified names from the cluster.
+	//
+	// Control plane nodes should leave etcd cluster before being removed.
+	RemoveNodes(context.Context, Cluster, []string, ...Option) (Cluster, error)
+
 	CrashDump(context.Context, Cluster, io.Writer)
 
 	Reflect(ctx context.Context, clusterName, stateDirectory string) (Cluster, error)